@@ -0,0 +1,285 @@
+package collections
+
+import (
+	"container/list"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// CacheLoader loads the value for a key which is not present in the cache.
+// A loader may return a *errors.GenericError as error. A nil *errors.GenericError
+// is considered a successful load.
+type CacheLoader func(key string) (interface{}, error)
+
+// CacheOptions contains the settings of a Cache
+type CacheOptions struct {
+	// MaxSize is the maximum number of entries in the cache.
+	// The least recently used entry is evicted when the cache is full.
+	// Zero means the size of the cache is not limited.
+	MaxSize int
+
+	// TTL is the duration after which a loaded or set value expires.
+	// Zero means values never expire.
+	TTL time.Duration
+
+	// NegativeTTL is the duration after which an error returned by the loader expires.
+	// Zero means errors are never cached.
+	NegativeTTL time.Duration
+
+	// Loader is called by GetOrLoad when a key is missing or expired.
+	Loader CacheLoader
+}
+
+// CacheStats contains counters about the usage of a Cache
+type CacheStats struct {
+	Hits      int64
+	Misses    int64
+	Loads     int64
+	Evictions int64
+}
+
+// Cache is an in-memory key/value cache with LRU and TTL eviction.
+// Concurrent misses for the same key result in a single call to the loader.
+// A Cache is safe for concurrent use and should be created with NewCache.
+type Cache struct {
+	mutex   sync.Mutex
+	options CacheOptions
+	entries map[string]*list.Element
+	lru     *list.List // Front is most recently used
+	loads   map[string]*cacheLoad
+	stats   CacheStats
+	now     func() time.Time
+}
+
+type cacheEntry struct {
+	key       string
+	value     interface{}
+	err       error
+	expiresAt time.Time // Zero value means the entry never expires
+}
+
+type cacheLoad struct {
+	done  sync.WaitGroup
+	value interface{}
+	err   error
+}
+
+// NewCache creates a new cache with the provided options
+func NewCache(options CacheOptions) *Cache {
+	return &Cache{
+		options: options,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+		loads:   map[string]*cacheLoad{},
+		now:     time.Now,
+	}
+}
+
+// Get returns the value stored for the key. The boolean is false when the key
+// is not present, is expired or contains a cached loader error.
+// Get never calls the loader.
+func (c *Cache) Get(key string) (interface{}, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, found := c.lookup(key)
+	if !found || entry.err != nil {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	return entry.value, true
+}
+
+// Set stores the value for the key using the TTL of the cache
+func (c *Cache) Set(key string, value interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.store(key, value, nil)
+}
+
+// GetOrLoad returns the value stored for the key. If the key is missing or expired,
+// the loader is called and its result is stored. While a load is in progress,
+// other callers for the same key wait for its result instead of calling the loader again.
+// Errors returned by the loader are cached for the NegativeTTL of the cache.
+//
+// GetOrLoad panics when the cache has no loader. If the loader panics, the panic is propagated
+// and the callers waiting for the same load receive an error. Nothing is cached in that case.
+func (c *Cache) GetOrLoad(key string) (interface{}, error) {
+	c.mutex.Lock()
+
+	// Return cached result
+	entry, found := c.lookup(key)
+	if found {
+		c.stats.Hits++
+		c.mutex.Unlock()
+		return entry.value, entry.err
+	}
+	c.stats.Misses++
+
+	// Wait for running load
+	if load, running := c.loads[key]; running {
+		c.mutex.Unlock()
+		load.done.Wait()
+		return load.value, load.err
+	}
+
+	// Start new load
+	if c.options.Loader == nil {
+		c.mutex.Unlock()
+		panic("collections: GetOrLoad called on a cache without loader")
+	}
+	load := &cacheLoad{}
+	load.done.Add(1)
+	c.loads[key] = load
+	c.stats.Loads++
+	c.mutex.Unlock()
+
+	// Call loader without holding the lock
+	c.load(key, load)
+
+	// Store result, unless the key was invalidated during the load
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.loads[key] == load {
+		delete(c.loads, key)
+		c.store(key, load.value, load.err)
+	}
+	return load.value, load.err
+}
+
+// load calls the loader and releases the waiting callers. If the loader panics,
+// the waiting callers receive an error, the load is removed and the panic is propagated.
+func (c *Cache) load(key string, load *cacheLoad) {
+	completed := false
+	defer func() {
+		if !completed {
+			recovered := recover()
+			load.err = fmt.Errorf("collections: loader panicked: %v", recovered)
+			c.mutex.Lock()
+			if c.loads[key] == load {
+				delete(c.loads, key)
+			}
+			c.mutex.Unlock()
+			load.done.Done()
+			panic(recovered)
+		}
+		load.done.Done()
+	}()
+
+	load.value, load.err = c.options.Loader(key)
+	if isNilError(load.err) {
+		load.err = nil
+	}
+	completed = true
+}
+
+// Invalidate removes the key from the cache.
+// The result of a load in progress for this key won't be stored.
+func (c *Cache) Invalidate(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, found := c.entries[key]; found {
+		c.remove(element)
+	}
+	delete(c.loads, key)
+}
+
+// Purge removes all keys from the cache.
+// The results of loads in progress won't be stored.
+func (c *Cache) Purge() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.entries = map[string]*list.Element{}
+	c.lru.Init()
+	c.loads = map[string]*cacheLoad{}
+}
+
+// Len returns the number of entries in the cache, including expired
+// entries which are not evicted yet.
+func (c *Cache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.Len()
+}
+
+// Stats returns a snapshot of the counters of the cache
+func (c *Cache) Stats() CacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.stats
+}
+
+// lookup returns the entry for the key and marks it as recently used.
+// Expired entries are evicted. Caller must hold the lock.
+func (c *Cache) lookup(key string) (*cacheEntry, bool) {
+	element, found := c.entries[key]
+	if !found {
+		return nil, false
+	}
+
+	entry := element.Value.(*cacheEntry)
+	if !entry.expiresAt.IsZero() && !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		c.stats.Evictions++
+		return nil, false
+	}
+
+	c.lru.MoveToFront(element)
+	return entry, true
+}
+
+// store upserts an entry and evicts the least recently used entries
+// if the cache is full. Caller must hold the lock.
+func (c *Cache) store(key string, value interface{}, err error) {
+	// Derive expiry
+	ttl := c.options.TTL
+	if err != nil {
+		ttl = c.options.NegativeTTL
+		if ttl <= 0 {
+			// Negative caching disabled
+			if element, found := c.entries[key]; found {
+				c.remove(element)
+			}
+			return
+		}
+	}
+	entry := &cacheEntry{key: key, value: value, err: err}
+	if ttl > 0 {
+		entry.expiresAt = c.now().Add(ttl)
+	}
+
+	// Upsert entry
+	if element, found := c.entries[key]; found {
+		element.Value = entry
+		c.lru.MoveToFront(element)
+	} else {
+		c.entries[key] = c.lru.PushFront(entry)
+	}
+
+	// Evict least recently used
+	for c.options.MaxSize > 0 && c.lru.Len() > c.options.MaxSize {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+// remove deletes the element from the cache. Caller must hold the lock.
+func (c *Cache) remove(element *list.Element) {
+	c.lru.Remove(element)
+	delete(c.entries, element.Value.(*cacheEntry).key)
+}
+
+// isNilError checks if the error is nil or a nil pointer wrapped in an error interface.
+// This prevents a nil *errors.GenericError returned by a loader from being treated as an error.
+func isNilError(err error) bool {
+	if err == nil {
+		return true
+	}
+	value := reflect.ValueOf(err)
+	return value.Kind() == reflect.Ptr && value.IsNil()
+}
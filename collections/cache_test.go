@@ -0,0 +1,275 @@
+package collections
+
+import (
+	goErrors "errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCacheError struct{}
+
+func (e *testCacheError) Error() string { return "test-cache-error" }
+
+func testCacheWithClock(options CacheOptions) (*Cache, *time.Time) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewCache(options)
+	cache.now = func() time.Time { return now }
+	return cache, &now
+}
+
+func Test_Cache_GetSet_Success(t *testing.T) {
+	cache := NewCache(CacheOptions{})
+	cache.Set("test-key", "test-value")
+
+	value, found := cache.Get("test-key")
+	assert.True(t, found)
+	assert.Equal(t, "test-value", value)
+
+	value, found = cache.Get("unknown-key")
+	assert.False(t, found)
+	assert.Nil(t, value)
+
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1}, cache.Stats())
+}
+
+func Test_Cache_TTL_Expired(t *testing.T) {
+	cache, now := testCacheWithClock(CacheOptions{TTL: time.Minute})
+	cache.Set("test-key", "test-value")
+
+	*now = now.Add(59 * time.Second)
+	_, found := cache.Get("test-key")
+	assert.True(t, found)
+
+	*now = now.Add(time.Second)
+	_, found = cache.Get("test-key")
+	assert.False(t, found)
+	assert.Equal(t, 0, cache.Len())
+	assert.Equal(t, int64(1), cache.Stats().Evictions)
+}
+
+func Test_Cache_MaxSize_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewCache(CacheOptions{MaxSize: 2})
+	cache.Set("test-key-1", 1)
+	cache.Set("test-key-2", 2)
+	cache.Get("test-key-1") // Mark key 1 as recently used
+	cache.Set("test-key-3", 3)
+
+	_, found1 := cache.Get("test-key-1")
+	_, found2 := cache.Get("test-key-2")
+	_, found3 := cache.Get("test-key-3")
+	assert.True(t, found1)
+	assert.False(t, found2)
+	assert.True(t, found3)
+	assert.Equal(t, 2, cache.Len())
+	assert.Equal(t, int64(1), cache.Stats().Evictions)
+}
+
+func Test_Cache_GetOrLoad_Success(t *testing.T) {
+	calls := 0
+	loader := func(key string) (interface{}, error) {
+		calls++
+		return "loaded-" + key, nil
+	}
+	cache := NewCache(CacheOptions{Loader: loader})
+
+	value, err := cache.GetOrLoad("test-key")
+	require.Nil(t, err)
+	assert.Equal(t, "loaded-test-key", value)
+
+	value, err = cache.GetOrLoad("test-key")
+	require.Nil(t, err)
+	assert.Equal(t, "loaded-test-key", value)
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1, Loads: 1}, cache.Stats())
+}
+
+func Test_Cache_GetOrLoad_TypedNilError_Success(t *testing.T) {
+	loader := func(key string) (interface{}, error) {
+		var err *testCacheError
+		return "test-value", err
+	}
+	cache := NewCache(CacheOptions{Loader: loader})
+
+	value, err := cache.GetOrLoad("test-key")
+	assert.Nil(t, err)
+	assert.Equal(t, "test-value", value)
+}
+
+func Test_Cache_GetOrLoad_Singleflight(t *testing.T) {
+	// Setup blocking loader
+	calls := 0
+	release := make(chan struct{})
+	loader := func(key string) (interface{}, error) {
+		calls++
+		<-release
+		return "test-value", nil
+	}
+	cache := NewCache(CacheOptions{Loader: loader})
+
+	// Start concurrent loads
+	var wg sync.WaitGroup
+	results := make([]interface{}, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = cache.GetOrLoad("test-key")
+		}(i)
+	}
+
+	// Wait until all callers are waiting for the load
+	require.Eventually(t, func() bool {
+		stats := cache.Stats()
+		return stats.Misses+stats.Hits == 10
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// Assert results
+	assert.Equal(t, 1, calls)
+	for _, result := range results {
+		assert.Equal(t, "test-value", result)
+	}
+}
+
+func Test_Cache_GetOrLoad_NegativeCaching(t *testing.T) {
+	calls := 0
+	loader := func(key string) (interface{}, error) {
+		calls++
+		return nil, &testCacheError{}
+	}
+	cache, now := testCacheWithClock(CacheOptions{Loader: loader, NegativeTTL: time.Minute})
+
+	_, err := cache.GetOrLoad("test-key")
+	assert.IsType(t, &testCacheError{}, err)
+	_, err = cache.GetOrLoad("test-key")
+	assert.IsType(t, &testCacheError{}, err)
+	assert.Equal(t, 1, calls)
+
+	// Errors are not visible through Get
+	_, found := cache.Get("test-key")
+	assert.False(t, found)
+
+	// Error expires after NegativeTTL
+	*now = now.Add(time.Minute)
+	cache.GetOrLoad("test-key")
+	assert.Equal(t, 2, calls)
+}
+
+func Test_Cache_GetOrLoad_NegativeCachingDisabled(t *testing.T) {
+	calls := 0
+	loader := func(key string) (interface{}, error) {
+		calls++
+		return nil, goErrors.New("test-error")
+	}
+	cache := NewCache(CacheOptions{Loader: loader})
+
+	cache.GetOrLoad("test-key")
+	cache.GetOrLoad("test-key")
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func Test_Cache_GetOrLoad_NoLoader_Panics(t *testing.T) {
+	cache := NewCache(CacheOptions{})
+	assert.Panics(t, func() {
+		cache.GetOrLoad("test-key")
+	})
+}
+
+func Test_Cache_GetOrLoad_LoaderPanics(t *testing.T) {
+	// Setup loader which panics on the first call
+	started := make(chan bool)
+	release := make(chan bool)
+	calls := 0
+	cache := NewCache(CacheOptions{Loader: func(key string) (interface{}, error) {
+		calls++
+		if calls == 1 {
+			close(started)
+			<-release
+			panic("test-panic")
+		}
+		return "test-value", nil
+	}})
+
+	// Panic is propagated to the loading caller
+	panicked := make(chan interface{})
+	go func() {
+		defer func() { panicked <- recover() }()
+		cache.GetOrLoad("test-key")
+	}()
+
+	// Waiting caller receives an error
+	<-started
+	waiting := make(chan error)
+	go func() {
+		_, err := cache.GetOrLoad("test-key")
+		waiting <- err
+	}()
+	time.Sleep(10 * time.Millisecond) // Let the caller wait for the load
+	close(release)
+	assert.Equal(t, "test-panic", <-panicked)
+	select {
+	case err := <-waiting:
+		assert.EqualError(t, err, "collections: loader panicked: test-panic")
+	case <-time.After(time.Second):
+		require.Fail(t, "Waiting caller is blocked")
+	}
+
+	// Next call loads again
+	value, err := cache.GetOrLoad("test-key")
+	assert.Nil(t, err)
+	assert.Equal(t, "test-value", value)
+}
+
+func Test_Cache_Invalidate(t *testing.T) {
+	cache := NewCache(CacheOptions{})
+	cache.Set("test-key-1", 1)
+	cache.Set("test-key-2", 2)
+
+	cache.Invalidate("test-key-1")
+	_, found1 := cache.Get("test-key-1")
+	_, found2 := cache.Get("test-key-2")
+	assert.False(t, found1)
+	assert.True(t, found2)
+}
+
+func Test_Cache_Invalidate_DuringLoad(t *testing.T) {
+	// Setup blocking loader
+	release := make(chan struct{})
+	loader := func(key string) (interface{}, error) {
+		<-release
+		return "stale-value", nil
+	}
+	cache := NewCache(CacheOptions{Loader: loader})
+
+	// Invalidate while loading
+	done := make(chan interface{})
+	go func() {
+		value, _ := cache.GetOrLoad("test-key")
+		done <- value
+	}()
+	require.Eventually(t, func() bool { return cache.Stats().Loads == 1 }, time.Second, time.Millisecond)
+	cache.Invalidate("test-key")
+	close(release)
+
+	// Caller receives loaded value, but it isn't stored
+	assert.Equal(t, "stale-value", <-done)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func Test_Cache_Purge(t *testing.T) {
+	cache := NewCache(CacheOptions{})
+	cache.Set("test-key-1", 1)
+	cache.Set("test-key-2", 2)
+
+	cache.Purge()
+	assert.Equal(t, 0, cache.Len())
+	_, found := cache.Get("test-key-1")
+	assert.False(t, found)
+}
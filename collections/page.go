@@ -0,0 +1,298 @@
+package collections
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Query parameters used to request a page
+const (
+	PageQueryLimit        = "limit"
+	PageQueryOffset       = "offset"
+	PageQueryCursor       = "cursor"
+	PageQueryIncludeTotal = "include_total"
+)
+
+// PageMode defines how the position of a page is expressed
+type PageMode string
+
+const (
+	// PageModeOffset selects a page by skipping a number of items
+	PageModeOffset PageMode = "offset"
+
+	// PageModeCursor selects a page by continuing after (or before) an opaque cursor
+	PageModeCursor PageMode = "cursor"
+)
+
+// PageOptions defines how page requests are parsed for an endpoint
+type PageOptions struct {
+	// Mode of pagination. Defaults to PageModeOffset.
+	Mode PageMode
+
+	// DefaultLimit is used when no limit is requested
+	DefaultLimit int64
+
+	// MaxLimit is the maximum page size. Larger limits are reduced to MaxLimit.
+	MaxLimit int64
+
+	// CursorField is the unique field used for cursor pagination (e.g. "_id")
+	CursorField string
+
+	// CursorDescending sorts the items on CursorField in descending order
+	CursorDescending bool
+}
+
+// DefaultPageOptions contains sensible defaults for offset pagination
+var DefaultPageOptions = PageOptions{
+	Mode:         PageModeOffset,
+	DefaultLimit: 20,
+	MaxLimit:     100,
+	CursorField:  "_id",
+}
+
+// PageRequest contains the requested page
+type PageRequest struct {
+	Mode             PageMode
+	Limit            int64
+	Offset           int64
+	CursorField      string
+	CursorDescending bool
+	Cursor           *PageCursor // Nil when requesting the first page in cursor mode
+	IncludeTotal     bool
+}
+
+// PageCursorType defines the type of the cursor value in the database
+type PageCursorType string
+
+const (
+	// PageCursorTypeString is used for string values
+	PageCursorTypeString PageCursorType = "string"
+
+	// PageCursorTypeInt is used for integer values
+	PageCursorTypeInt PageCursorType = "int"
+
+	// PageCursorTypeDouble is used for floating point values
+	PageCursorTypeDouble PageCursorType = "double"
+
+	// PageCursorTypeDate is used for time.Time values
+	PageCursorTypeDate PageCursorType = "date"
+
+	// PageCursorTypeObjectID is used for values with a Hex method (e.g. primitive.ObjectID)
+	PageCursorTypeObjectID PageCursorType = "oid"
+)
+
+// PageCursor is the decoded form of an opaque cursor
+type PageCursor struct {
+	// Field on which the cursor is based
+	Field string `json:"f"`
+
+	// Value of Field for the last (or first if Backward) item of the previous page
+	Value string `json:"v"`
+
+	// Type of Value in the database. Empty for cursors created without NewPageCursor.
+	Type PageCursorType `json:"t,omitempty"`
+
+	// Backward indicates the items before Value are requested
+	Backward bool `json:"b,omitempty"`
+}
+
+// NewPageCursor creates a cursor for the value of the cursor field.
+// The type of the value is kept, so the cursor can be converted back with TypedValue.
+// Values of an unsupported type are formatted with fmt.Sprint and handled as a string.
+func NewPageCursor(field string, value interface{}, backward bool) PageCursor {
+	cursor := PageCursor{Field: field, Type: PageCursorTypeString, Backward: backward}
+	switch typed := value.(type) {
+	case string:
+		cursor.Value = typed
+	case time.Time:
+		cursor.Value = typed.UTC().Format(time.RFC3339Nano)
+		cursor.Type = PageCursorTypeDate
+	case interface{ Hex() string }:
+		cursor.Value = typed.Hex()
+		cursor.Type = PageCursorTypeObjectID
+	default:
+		reflected := reflect.ValueOf(value)
+		switch reflected.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			cursor.Value = strconv.FormatInt(reflected.Int(), 10)
+			cursor.Type = PageCursorTypeInt
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			cursor.Value = strconv.FormatUint(reflected.Uint(), 10)
+			cursor.Type = PageCursorTypeInt
+		case reflect.Float32, reflect.Float64:
+			cursor.Value = strconv.FormatFloat(reflected.Float(), 'g', -1, 64)
+			cursor.Type = PageCursorTypeDouble
+		default:
+			cursor.Value = fmt.Sprint(value)
+		}
+	}
+	return cursor
+}
+
+// TypedValue converts Value back to the type of the cursor:
+// string, int64, float64, time.Time or the hex string of an ObjectID.
+// Value is returned as is if Type is empty.
+func (cursor PageCursor) TypedValue() (interface{}, error) {
+	switch cursor.Type {
+	case "", PageCursorTypeString:
+		return cursor.Value, nil
+	case PageCursorTypeInt:
+		return strconv.ParseInt(cursor.Value, 10, 64)
+	case PageCursorTypeDouble:
+		return strconv.ParseFloat(cursor.Value, 64)
+	case PageCursorTypeDate:
+		return time.Parse(time.RFC3339Nano, cursor.Value)
+	case PageCursorTypeObjectID:
+		if decoded, err := hex.DecodeString(cursor.Value); err != nil || len(decoded) != 12 {
+			return nil, fmt.Errorf("invalid object id %q", cursor.Value)
+		}
+		return cursor.Value, nil
+	default:
+		return nil, fmt.Errorf("unsupported cursor type %q", cursor.Type)
+	}
+}
+
+// Encode converts the cursor to an opaque, URL safe string
+func (cursor PageCursor) Encode() string {
+	cursorJSON, _ := json.Marshal(cursor) // Cannot fail for this struct
+	return base64.RawURLEncoding.EncodeToString(cursorJSON)
+}
+
+// DecodePageCursor converts an opaque string created by PageCursor.Encode back to a cursor.
+// An error is returned if the value doesn't match the type of the cursor.
+func DecodePageCursor(encoded string) (*PageCursor, error) {
+	cursorJSON, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	cursor := &PageCursor{}
+	err = json.Unmarshal(cursorJSON, cursor)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := cursor.TypedValue(); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// Page is the standard JSON envelope for a page of items
+type Page struct {
+	Items  interface{} `json:"items"`
+	Limit  int64       `json:"limit"`
+	Offset *int64      `json:"offset,omitempty"`
+	Total  *int64      `json:"total,omitempty"`
+	Links  PageLinks   `json:"links"`
+}
+
+// PageLinks contains the links to navigate between pages
+type PageLinks struct {
+	Self string `json:"self"`
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+}
+
+// PageCursorValue extracts the value of the cursor field from an item.
+// The value should have the same type as in the database (see NewPageCursor for the supported types).
+type PageCursorValue func(item interface{}) interface{}
+
+// NewPage builds the envelope for a page of items.
+//
+// Items should be a slice (or a pointer to a slice) fetched with one item more than
+// the requested limit (see mongo.ApplyPageRequest). This extra item is used to
+// detect if a next page exists and is not included in the page.
+//
+// Total is only set on the envelope if provided. CursorValue is only required in cursor mode.
+func NewPage(request PageRequest, items interface{}, total *int64, requestURL *url.URL, cursorValue PageCursorValue) Page {
+	if requestURL == nil {
+		requestURL = &url.URL{}
+	}
+
+	// Normalise items to a non-nil slice
+	itemsValue := reflect.ValueOf(items)
+	if itemsValue.Kind() == reflect.Ptr {
+		itemsValue = itemsValue.Elem()
+	}
+	if itemsValue.Kind() != reflect.Slice {
+		itemsValue = reflect.ValueOf([]interface{}{})
+	}
+
+	// Drop extra item
+	hasMore := int64(itemsValue.Len()) > request.Limit
+	length := itemsValue.Len()
+	if hasMore {
+		length = int(request.Limit)
+	}
+	pageItems := reflect.MakeSlice(itemsValue.Type(), length, length)
+	reflect.Copy(pageItems, itemsValue)
+
+	// Items are fetched in reverse order when paging backward
+	backward := request.Cursor != nil && request.Cursor.Backward
+	if backward {
+		swap := reflect.Swapper(pageItems.Interface())
+		for i, j := 0, length-1; i < j; i, j = i+1, j-1 {
+			swap(i, j)
+		}
+	}
+
+	// Build page
+	page := Page{
+		Items: pageItems.Interface(),
+		Limit: request.Limit,
+		Total: total,
+		Links: PageLinks{Self: requestURL.String()},
+	}
+
+	// Build links
+	switch request.Mode {
+	case PageModeCursor:
+		if length == 0 {
+			break
+		}
+		if hasMore || backward {
+			next := NewPageCursor(request.CursorField, cursorValue(pageItems.Index(length-1).Interface()), false)
+			page.Links.Next = buildPageLink(requestURL, request, PageQueryCursor, next.Encode())
+		}
+		if request.Cursor != nil && (!backward || hasMore) {
+			prev := NewPageCursor(request.CursorField, cursorValue(pageItems.Index(0).Interface()), true)
+			page.Links.Prev = buildPageLink(requestURL, request, PageQueryCursor, prev.Encode())
+		}
+
+	default:
+		offset := request.Offset
+		page.Offset = &offset
+		if hasMore {
+			next := strconv.FormatInt(offset+request.Limit, 10)
+			page.Links.Next = buildPageLink(requestURL, request, PageQueryOffset, next)
+		}
+		if offset > 0 {
+			prevOffset := offset - request.Limit
+			if prevOffset < 0 {
+				prevOffset = 0
+			}
+			prev := strconv.FormatInt(prevOffset, 10)
+			page.Links.Prev = buildPageLink(requestURL, request, PageQueryOffset, prev)
+		}
+	}
+
+	// Return result
+	return page
+}
+
+// buildPageLink copies the request URL and replaces the pagination query parameters
+func buildPageLink(requestURL *url.URL, request PageRequest, positionKey string, positionValue string) string {
+	link := *requestURL
+	query := link.Query()
+	query.Del(PageQueryOffset)
+	query.Del(PageQueryCursor)
+	query.Set(positionKey, positionValue)
+	query.Set(PageQueryLimit, strconv.FormatInt(request.Limit, 10))
+	link.RawQuery = query.Encode()
+	return link.String()
+}
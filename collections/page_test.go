@@ -0,0 +1,158 @@
+package collections
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPageURL(t *testing.T) *url.URL {
+	requestURL, err := url.Parse("/bookings?status=pending")
+	require.Nil(t, err)
+	return requestURL
+}
+
+func testPageCursorValue(item interface{}) interface{} {
+	return item.(string)
+}
+
+func Test_PageCursor_EncodeDecode_Success(t *testing.T) {
+	cursor := PageCursor{Field: "_id", Value: "test-id", Backward: true}
+	result, err := DecodePageCursor(cursor.Encode())
+	require.Nil(t, err)
+	assert.Equal(t, &cursor, result)
+}
+
+func Test_DecodePageCursor_Invalid_Failure(t *testing.T) {
+	_, err := DecodePageCursor("invalid!")
+	assert.NotNil(t, err)
+
+	_, err = DecodePageCursor("aW52YWxpZA") // "invalid" is not JSON
+	assert.NotNil(t, err)
+
+	invalidValue := PageCursor{Field: "seats", Value: "abc", Type: PageCursorTypeInt}
+	_, err = DecodePageCursor(invalidValue.Encode())
+	assert.NotNil(t, err)
+
+	invalidType := PageCursor{Field: "seats", Value: "1", Type: "unknown"}
+	_, err = DecodePageCursor(invalidType.Encode())
+	assert.NotNil(t, err)
+}
+
+type testObjectID struct{}
+
+func (testObjectID) Hex() string {
+	return "5f1b2c3d4e5f6a7b8c9d0e1f"
+}
+
+func Test_NewPageCursor_Types_Success(t *testing.T) {
+	date := time.Date(2021, 3, 4, 5, 6, 7, 8000000, time.FixedZone("CET", 3600))
+	testCases := []struct {
+		value         interface{}
+		expectedValue string
+		expectedType  PageCursorType
+		typedValue    interface{}
+	}{
+		{"bob", "bob", PageCursorTypeString, "bob"},
+		{42, "42", PageCursorTypeInt, int64(42)},
+		{int32(-7), "-7", PageCursorTypeInt, int64(-7)},
+		{uint8(8), "8", PageCursorTypeInt, int64(8)},
+		{1.5, "1.5", PageCursorTypeDouble, 1.5},
+		{date, "2021-03-04T04:06:07.008Z", PageCursorTypeDate, date.UTC()},
+		{testObjectID{}, "5f1b2c3d4e5f6a7b8c9d0e1f", PageCursorTypeObjectID, "5f1b2c3d4e5f6a7b8c9d0e1f"},
+		{true, "true", PageCursorTypeString, "true"},
+	}
+	for _, testCase := range testCases {
+		cursor := NewPageCursor("field", testCase.value, true)
+		assert.Equal(t, PageCursor{Field: "field", Value: testCase.expectedValue, Type: testCase.expectedType, Backward: true}, cursor)
+
+		decoded, err := DecodePageCursor(cursor.Encode())
+		require.Nil(t, err)
+		typedValue, err := decoded.TypedValue()
+		require.Nil(t, err)
+		assert.Equal(t, testCase.typedValue, typedValue)
+	}
+}
+
+func Test_NewPage_Offset_FirstPage(t *testing.T) {
+	request := PageRequest{Mode: PageModeOffset, Limit: 2}
+	total := int64(5)
+	page := NewPage(request, []string{"a", "b", "c"}, &total, testPageURL(t), nil)
+
+	assert.Equal(t, []string{"a", "b"}, page.Items)
+	assert.Equal(t, int64(0), *page.Offset)
+	assert.Equal(t, &total, page.Total)
+	assert.Equal(t, "/bookings?status=pending", page.Links.Self)
+	assert.Equal(t, "/bookings?limit=2&offset=2&status=pending", page.Links.Next)
+	assert.Equal(t, "", page.Links.Prev)
+}
+
+func Test_NewPage_Offset_LastPage(t *testing.T) {
+	request := PageRequest{Mode: PageModeOffset, Limit: 2, Offset: 3}
+	page := NewPage(request, &[]string{"d"}, nil, testPageURL(t), nil)
+
+	assert.Equal(t, []string{"d"}, page.Items)
+	assert.Nil(t, page.Total)
+	assert.Equal(t, "", page.Links.Next)
+	assert.Equal(t, "/bookings?limit=2&offset=1&status=pending", page.Links.Prev)
+}
+
+func Test_NewPage_NilItems(t *testing.T) {
+	request := PageRequest{Mode: PageModeOffset, Limit: 2}
+	var items []string
+	page := NewPage(request, items, nil, nil, nil)
+
+	assert.Equal(t, []string{}, page.Items)
+	result, err := json.Marshal(page)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"items": [], "limit": 2, "offset": 0, "links": {"self": ""}}`, string(result))
+}
+
+func Test_NewPage_Cursor_FirstPage(t *testing.T) {
+	request := PageRequest{Mode: PageModeCursor, Limit: 2, CursorField: "_id"}
+	page := NewPage(request, []string{"a", "b", "c"}, nil, testPageURL(t), testPageCursorValue)
+
+	assert.Equal(t, []string{"a", "b"}, page.Items)
+	assert.Nil(t, page.Offset)
+	next := PageCursor{Field: "_id", Value: "b", Type: PageCursorTypeString}
+	assert.Equal(t, "/bookings?cursor="+next.Encode()+"&limit=2&status=pending", page.Links.Next)
+	assert.Equal(t, "", page.Links.Prev)
+}
+
+func Test_NewPage_Cursor_Forward(t *testing.T) {
+	cursor := &PageCursor{Field: "_id", Value: "b"}
+	request := PageRequest{Mode: PageModeCursor, Limit: 2, CursorField: "_id", Cursor: cursor}
+	page := NewPage(request, []string{"c", "d"}, nil, testPageURL(t), testPageCursorValue)
+
+	assert.Equal(t, []string{"c", "d"}, page.Items)
+	assert.Equal(t, "", page.Links.Next)
+	prev := PageCursor{Field: "_id", Value: "c", Type: PageCursorTypeString, Backward: true}
+	assert.Equal(t, "/bookings?cursor="+prev.Encode()+"&limit=2&status=pending", page.Links.Prev)
+}
+
+func Test_NewPage_Cursor_Backward(t *testing.T) {
+	// Items are fetched in reverse order
+	cursor := &PageCursor{Field: "_id", Value: "e", Backward: true}
+	request := PageRequest{Mode: PageModeCursor, Limit: 2, CursorField: "_id", Cursor: cursor}
+	page := NewPage(request, []string{"d", "c", "b"}, nil, testPageURL(t), testPageCursorValue)
+
+	assert.Equal(t, []string{"c", "d"}, page.Items)
+	next := PageCursor{Field: "_id", Value: "d", Type: PageCursorTypeString}
+	assert.Equal(t, "/bookings?cursor="+next.Encode()+"&limit=2&status=pending", page.Links.Next)
+	prev := PageCursor{Field: "_id", Value: "c", Type: PageCursorTypeString, Backward: true}
+	assert.Equal(t, "/bookings?cursor="+prev.Encode()+"&limit=2&status=pending", page.Links.Prev)
+}
+
+func Test_NewPage_Cursor_Empty(t *testing.T) {
+	cursor := &PageCursor{Field: "_id", Value: "z"}
+	request := PageRequest{Mode: PageModeCursor, Limit: 2, CursorField: "_id", Cursor: cursor}
+	page := NewPage(request, []string{}, nil, testPageURL(t), testPageCursorValue)
+
+	assert.Equal(t, []string{}, page.Items)
+	assert.Equal(t, "", page.Links.Next)
+	assert.Equal(t, "", page.Links.Prev)
+}
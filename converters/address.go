@@ -0,0 +1,405 @@
+package converters
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// Address is a postal address
+type Address struct {
+	// Street is the name of the street (e.g. Rue de la Loi)
+	Street string `json:"street" bson:"street"`
+
+	// Number is the house number, including suffixes (e.g. 16, 12A, 10 bis)
+	Number string `json:"number" bson:"number"`
+
+	// Box is the box or apartment number. Empty if the address has no box.
+	Box string `json:"box" bson:"box"`
+
+	// PostalCode is the postal code in the national format (e.g. 1012 LG)
+	PostalCode string `json:"postal_code" bson:"postal_code"`
+
+	// City is the name of the city (e.g. Brussels)
+	City string `json:"city" bson:"city"`
+
+	// CountryCode is the ISO 3166-1 alpha-2 code of the country (e.g. BE)
+	CountryCode string `json:"country_code" bson:"country_code"`
+}
+
+// postalCodeRule validates and formats the postal codes of a country.
+// Pattern is applied to the postal code in upper case without spaces and dashes.
+type postalCodeRule struct {
+	pattern  *regexp.Regexp
+	template string
+	prefixes []string
+}
+
+func newPostalCodeRule(pattern string, template string, prefixes ...string) postalCodeRule {
+	return postalCodeRule{pattern: regexp.MustCompile(pattern), template: template, prefixes: prefixes}
+}
+
+// postalCodeRules contains the rules of all EU countries and the other countries in the EEA, Switzerland and the UK.
+// Prefixes are country prefixes which are sometimes added in front of the postal code (e.g. B-1000).
+var postalCodeRules = map[string]postalCodeRule{
+	"AT": newPostalCodeRule(`^(\d{4})$`, "$1", "AT", "A"),
+	"BE": newPostalCodeRule(`^([1-9]\d{3})$`, "$1", "BE", "B"),
+	"BG": newPostalCodeRule(`^(\d{4})$`, "$1", "BG"),
+	"CH": newPostalCodeRule(`^(\d{4})$`, "$1", "CH"),
+	"CY": newPostalCodeRule(`^(\d{4})$`, "$1", "CY"),
+	"CZ": newPostalCodeRule(`^(\d{3})(\d{2})$`, "$1 $2", "CZ"),
+	"DE": newPostalCodeRule(`^(\d{5})$`, "$1", "DE", "D"),
+	"DK": newPostalCodeRule(`^(\d{4})$`, "$1", "DK"),
+	"EE": newPostalCodeRule(`^(\d{5})$`, "$1", "EE"),
+	"ES": newPostalCodeRule(`^((?:0[1-9]|[1-4]\d|5[0-2])\d{3})$`, "$1", "ES", "E"),
+	"FI": newPostalCodeRule(`^(\d{5})$`, "$1", "FI"),
+	"FR": newPostalCodeRule(`^(\d{5})$`, "$1", "FR", "F"),
+	"GB": newPostalCodeRule(`^([A-Z]{1,2}\d[A-Z\d]?)(\d[A-Z]{2})$`, "$1 $2"),
+	"GR": newPostalCodeRule(`^(\d{3})(\d{2})$`, "$1 $2", "GR"),
+	"HR": newPostalCodeRule(`^(\d{5})$`, "$1", "HR"),
+	"HU": newPostalCodeRule(`^(\d{4})$`, "$1", "HU", "H"),
+	"IE": newPostalCodeRule(`^([AC-FHKNPRTV-Y]\d{2}|D6W)([0-9AC-FHKNPRTV-Y]{4})$`, "$1 $2"),
+	"IS": newPostalCodeRule(`^(\d{3})$`, "$1", "IS"),
+	"IT": newPostalCodeRule(`^(\d{5})$`, "$1", "IT", "I"),
+	"LI": newPostalCodeRule(`^(94[89]\d)$`, "$1", "LI", "FL"),
+	"LT": newPostalCodeRule(`^(\d{5})$`, "LT-$1", "LT"),
+	"LU": newPostalCodeRule(`^(\d{4})$`, "L-$1", "LU", "L"),
+	"LV": newPostalCodeRule(`^(\d{4})$`, "LV-$1", "LV"),
+	"MT": newPostalCodeRule(`^([A-Z]{3})(\d{4})$`, "$1 $2"),
+	"NL": newPostalCodeRule(`^([1-9]\d{3})([A-Z]{2})$`, "$1 $2", "NL"),
+	"NO": newPostalCodeRule(`^(\d{4})$`, "$1", "NO", "N"),
+	"PL": newPostalCodeRule(`^(\d{2})(\d{3})$`, "$1-$2", "PL"),
+	"PT": newPostalCodeRule(`^(\d{4})(\d{3})$`, "$1-$2", "PT"),
+	"RO": newPostalCodeRule(`^(\d{6})$`, "$1", "RO"),
+	"SE": newPostalCodeRule(`^(\d{3})(\d{2})$`, "$1 $2", "SE", "S"),
+	"SI": newPostalCodeRule(`^(\d{4})$`, "$1", "SI"),
+	"SK": newPostalCodeRule(`^(\d{3})(\d{2})$`, "$1 $2", "SK"),
+}
+
+// addressLayout defines the postal layout of a country
+type addressLayout struct {
+	// numberFirst is true if the number is written before the street (e.g. 10 rue de Rivoli)
+	numberFirst bool
+
+	// numberSeparator is written between street and number
+	numberSeparator string
+
+	// postalCodeLast is true if the postal code is written on a separate line after the city
+	postalCodeLast bool
+
+	// upperCaseCity is true if the city is written in upper case
+	upperCaseCity bool
+}
+
+var defaultAddressLayout = addressLayout{numberSeparator: " "}
+
+var addressLayouts = map[string]addressLayout{
+	"ES": {numberSeparator: ", "},
+	"FR": {numberFirst: true, numberSeparator: " ", upperCaseCity: true},
+	"GB": {numberFirst: true, numberSeparator: " ", postalCodeLast: true, upperCaseCity: true},
+	"IE": {numberFirst: true, numberSeparator: " ", postalCodeLast: true},
+	"LU": {numberFirst: true, numberSeparator: ", "},
+	"US": {numberFirst: true, numberSeparator: " "},
+}
+
+var boxKeywords = map[string]bool{"bus": true, "bte": true, "boite": true, "boîte": true, "box": true, "b": true}
+var numberSuffixes = map[string]bool{"bis": true, "ter": true, "quater": true}
+var boxNumberRegex = regexp.MustCompile(`^(?i)b(\d+[a-z]?)$`)
+
+// NormalisePostalCode validates the postal code and converts it to the national format
+// of the country (e.g. "1012lg" => "1012 LG" for NL, "l1855" => "L-1855" for LU).
+// Countries without known format only have their postal code trimmed and converted to upper case.
+//
+// Raises
+//
+// - 404/country_not_found: Provided country code was not found
+//
+// - 400/invalid_postal_code: Provided postal code is not valid for the country
+func NormalisePostalCode(postalCode string, countryCode string) (string, *errors.GenericError) {
+	// Validate country
+	country, genErr := CountryByCode(countryCode)
+	if genErr != nil {
+		return "", genErr
+	}
+
+	// Clean postal code
+	clean := strings.ToUpper(strings.TrimSpace(postalCode))
+	clean = strings.NewReplacer(" ", "", "-", "", ".", "").Replace(clean)
+	rule, exists := postalCodeRules[country.Alpha2]
+	if !exists {
+		if clean == "" {
+			return "", invalidPostalCodeError(postalCode, country.Alpha2)
+		}
+		return strings.ToUpper(strings.TrimSpace(postalCode)), nil
+	}
+
+	// Strip country prefix
+	if !rule.pattern.MatchString(clean) {
+		for _, prefix := range rule.prefixes {
+			if stripped := strings.TrimPrefix(clean, prefix); stripped != clean && rule.pattern.MatchString(stripped) {
+				clean = stripped
+				break
+			}
+		}
+	}
+
+	// Validate and format postal code
+	if !rule.pattern.MatchString(clean) || (country.Alpha2 == "NL" && isReservedDutchPostalCode(clean)) {
+		return "", invalidPostalCodeError(postalCode, country.Alpha2)
+	}
+	return rule.pattern.ReplaceAllString(clean, rule.template), nil
+}
+
+// isReservedDutchPostalCode checks if the letters of a Dutch postal code are SA, SD or SS, which are not used
+func isReservedDutchPostalCode(postalCode string) bool {
+	letters := postalCode[len(postalCode)-2:]
+	return letters == "SA" || letters == "SD" || letters == "SS"
+}
+
+func invalidPostalCodeError(postalCode string, countryCode string) *errors.GenericError {
+	meta := map[string]string{"postal_code": postalCode, "country_code": countryCode}
+	return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidPostalCode, meta)
+}
+
+// Normalise trims all fields, converts the country code to alpha-2
+// and the postal code to the national format. See NormalisePostalCode.
+//
+// Raises
+//
+// - 404/country_not_found: Country code was not found
+//
+// - 400/invalid_postal_code: Postal code is not valid for the country
+func (a Address) Normalise() (Address, *errors.GenericError) {
+	// Normalise country
+	country, genErr := CountryByCode(strings.TrimSpace(a.CountryCode))
+	if genErr != nil {
+		return Address{}, genErr
+	}
+
+	// Normalise postal code
+	postalCode, genErr := NormalisePostalCode(a.PostalCode, country.Alpha2)
+	if genErr != nil {
+		return Address{}, genErr
+	}
+
+	// Build result
+	return Address{
+		Street:      strings.Join(strings.Fields(a.Street), " "),
+		Number:      strings.TrimSpace(a.Number),
+		Box:         strings.TrimSpace(a.Box),
+		PostalCode:  postalCode,
+		City:        strings.Join(strings.Fields(a.City), " "),
+		CountryCode: country.Alpha2,
+	}, nil
+}
+
+// Lines formats the address according to the postal layout of its country.
+// If includeCountry is true, the English name of the country is added in upper case on the last line.
+//
+// Example for BE: ["Rue de la Loi 16 box 3", "1000 Brussels", "BELGIUM"]
+//
+// Example for FR: ["10 rue de Rivoli", "75001 PARIS", "FRANCE"]
+//
+// Raises
+//
+// - 404/country_not_found: Country code was not found
+func (a Address) Lines(includeCountry bool) ([]string, *errors.GenericError) {
+	// Fetch layout
+	country, genErr := CountryByCode(a.CountryCode)
+	if genErr != nil {
+		return nil, genErr
+	}
+	layout, exists := addressLayouts[country.Alpha2]
+	if !exists {
+		layout = defaultAddressLayout
+	}
+
+	// Build street line
+	lines := []string{}
+	street := a.Street
+	if a.Number != "" && layout.numberFirst {
+		street = a.Number + layout.numberSeparator + a.Street
+	} else if a.Number != "" {
+		street = a.Street + layout.numberSeparator + a.Number
+	}
+	if a.Box != "" {
+		street += " box " + a.Box
+	}
+	lines = append(lines, strings.TrimSpace(street))
+
+	// Build city line
+	city := a.City
+	if layout.upperCaseCity {
+		city = strings.ToUpper(city)
+	}
+	if layout.postalCodeLast {
+		lines = append(lines, city, a.PostalCode)
+	} else {
+		lines = append(lines, strings.TrimSpace(a.PostalCode+" "+city))
+	}
+
+	// Add country
+	if includeCountry {
+		lines = append(lines, strings.ToUpper(country.CommonName))
+	}
+	return lines, nil
+}
+
+// ParseAddress parses a single line address as commonly written in BE, NL, FR, DE and LU
+// (e.g. "Rue de la Loi 16 bus 3, 1000 Brussels, Belgium" or "10 bis rue de Rivoli, 75001 Paris").
+// If the address doesn't end with a country, the defaultCountryCode is used.
+// The postal code is normalised, see NormalisePostalCode.
+//
+// Raises
+//
+// - 404/country_not_found: Provided default country code was not found
+//
+// - 400/invalid_address: No street or postal code found in the address
+func ParseAddress(input string, defaultCountryCode string) (Address, *errors.GenericError) {
+	// Split in segments
+	segments := []string{}
+	for _, segment := range strings.Split(input, ",") {
+		if segment = strings.Join(strings.Fields(segment), " "); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	// Detect country
+	address := Address{CountryCode: defaultCountryCode}
+	if len(segments) > 1 {
+		if code, genErr := CountryNameToCountryCode(segments[len(segments)-1]); genErr == nil {
+			address.CountryCode = code
+			segments = segments[:len(segments)-1]
+		}
+	}
+	country, genErr := CountryByCode(address.CountryCode)
+	if genErr != nil {
+		return Address{}, genErr
+	}
+	address.CountryCode = country.Alpha2
+
+	// Search postal code and city
+	tokens := strings.Fields(strings.Join(segments, " , "))
+	streetEnd, postalCode, cityStart := findPostalCode(tokens, country.Alpha2)
+	if postalCode == "" || streetEnd == 0 {
+		meta := map[string]string{"address": input}
+		return Address{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidAddress, meta)
+	}
+	address.PostalCode = postalCode
+	address.City = strings.Trim(strings.Join(tokens[cityStart:], " "), " ,")
+
+	// Parse street, number and box
+	streetTokens := []string{}
+	for _, token := range tokens[:streetEnd] {
+		if token = strings.Trim(token, ","); token != "" {
+			streetTokens = append(streetTokens, token)
+		}
+	}
+	address.Street, address.Number, address.Box = parseStreet(streetTokens)
+	if address.Street == "" {
+		meta := map[string]string{"address": input}
+		return Address{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidAddress, meta)
+	}
+	return address, nil
+}
+
+// findPostalCode searches the last valid postal code which is preceded by a street.
+// Postal codes can consist of up to 2 tokens (e.g. 1012 LG).
+// Returns the index of the end of the street, the normalised postal code and the start of the city.
+func findPostalCode(tokens []string, countryCode string) (int, string, int) {
+	for start := len(tokens) - 1; start > 0; start-- {
+		for length := 2; length >= 1; length-- {
+			if start+length > len(tokens) {
+				continue
+			}
+			candidate := strings.Join(tokens[start:start+length], "")
+			if !startsWithDigitOrLetter(candidate) || strings.Contains(candidate, ",") {
+				continue
+			}
+			if postalCode, genErr := NormalisePostalCode(candidate, countryCode); genErr == nil && startsWithPostalCodeDigit(candidate) {
+				return start, postalCode, start + length
+			}
+		}
+	}
+	return 0, "", 0
+}
+
+// parseStreet splits the street tokens into street, number and box.
+// Supports the number before (e.g. 10 bis rue de Rivoli) or after the street (e.g. Kerkstraat 12 bus 3).
+func parseStreet(tokens []string) (string, string, string) {
+	if len(tokens) == 0 {
+		return "", "", ""
+	}
+
+	// Number before street
+	if startsWithDigit(tokens[0]) {
+		number := tokens[0]
+		rest := tokens[1:]
+		if len(rest) > 0 && numberSuffixes[strings.ToLower(rest[0])] {
+			number += " " + rest[0]
+			rest = rest[1:]
+		}
+		return strings.Join(rest, " "), number, ""
+	}
+
+	// Number after street
+	for i := 1; i < len(tokens); i++ {
+		if !startsWithDigit(tokens[i]) {
+			continue
+		}
+		street := strings.Join(tokens[:i], " ")
+		number := tokens[i]
+		box := ""
+		rest := tokens[i+1:]
+
+		// Box inside number (e.g. 12/3)
+		if parts := strings.SplitN(number, "/", 2); len(parts) == 2 {
+			number, box = parts[0], parts[1]
+		}
+
+		// Suffix or box after number (e.g. 12 A, 12 bus 3, 12 b3)
+		switch {
+		case len(rest) >= 2 && boxKeywords[strings.ToLower(strings.TrimSuffix(rest[0], "."))]:
+			box = rest[1]
+		case len(rest) == 1 && boxNumberRegex.MatchString(rest[0]):
+			box = boxNumberRegex.FindStringSubmatch(rest[0])[1]
+		case len(rest) == 1 && len(rest[0]) <= 3 && isLetters(rest[0]):
+			number += strings.ToUpper(rest[0])
+		}
+		return street, number, box
+	}
+	return strings.Join(tokens, " "), "", ""
+}
+
+func startsWithDigit(input string) bool {
+	return input != "" && unicode.IsDigit([]rune(input)[0])
+}
+
+// startsWithPostalCodeDigit checks if the candidate looks like a postal code
+// (starts with a digit or with a country prefix followed by a digit, e.g. L-1855, VLT 1117, D02 X285)
+func startsWithPostalCodeDigit(input string) bool {
+	for _, r := range input {
+		if unicode.IsDigit(r) {
+			return true
+		}
+		if !unicode.IsLetter(r) && r != '-' {
+			return false
+		}
+	}
+	return false
+}
+
+func startsWithDigitOrLetter(input string) bool {
+	return input != "" && (unicode.IsDigit([]rune(input)[0]) || unicode.IsLetter([]rune(input)[0]))
+}
+
+func isLetters(input string) bool {
+	for _, r := range input {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return true
+}
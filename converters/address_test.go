@@ -0,0 +1,182 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NormalisePostalCode_Success(t *testing.T) {
+	tests := []struct {
+		postalCode  string
+		countryCode string
+		expected    string
+	}{
+		{"1000", "BE", "1000"},
+		{"B-1000", "BE", "1000"},
+		{"1012lg", "NL", "1012 LG"},
+		{" 1012 LG ", "NLD", "1012 LG"},
+		{"75001", "FR", "75001"},
+		{"D-10115", "DE", "10115"},
+		{"l1855", "LU", "L-1855"},
+		{"00950", "PL", "00-950"},
+		{"1000001", "PT", "1000-001"},
+		{"11455", "SE", "114 55"},
+		{"vlt1117", "MT", "VLT 1117"},
+		{"d02x285", "IE", "D02 X285"},
+		{"sw1a1aa", "GB", "SW1A 1AA"},
+		{"10001", "US", "10001"}, // No rule => Only trimmed
+	}
+
+	for _, test := range tests {
+		result, genErr := NormalisePostalCode(test.postalCode, test.countryCode)
+		require.Nil(t, genErr, test.postalCode)
+		assert.Equal(t, test.expected, result)
+	}
+}
+
+func Test_NormalisePostalCode_AllEUCountries_Success(t *testing.T) {
+	for _, country := range Countries() {
+		if country.EU {
+			_, exists := postalCodeRules[country.Alpha2]
+			assert.True(t, exists, country.Alpha2)
+		}
+	}
+}
+
+func Test_NormalisePostalCode_InvalidPostalCode_Failure(t *testing.T) {
+	tests := []struct {
+		postalCode  string
+		countryCode string
+	}{
+		{"100", "BE"},
+		{"0999", "BE"},
+		{"1012 SS", "NL"},
+		{"1012", "NL"},
+		{"7500", "FR"},
+		{"53001", "ES"},
+		{"", "US"},
+	}
+
+	for _, test := range tests {
+		_, genErr := NormalisePostalCode(test.postalCode, test.countryCode)
+		meta := map[string]string{"postal_code": test.postalCode, "country_code": test.countryCode}
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidPostalCode, meta)
+	}
+}
+
+func Test_NormalisePostalCode_CountryNotFound_Failure(t *testing.T) {
+	_, genErr := NormalisePostalCode("1000", "XX")
+	errors.AssertGenericError(t, genErr, 404, ErrorCountryNotFound, map[string]string{"code": "XX"})
+}
+
+func Test_Address_Normalise_Success(t *testing.T) {
+	address := Address{Street: " Rue de la  Loi ", Number: "16 ", PostalCode: "b-1000", City: "Brussels ", CountryCode: "bel"}
+	result, genErr := address.Normalise()
+	require.Nil(t, genErr)
+	expected := Address{Street: "Rue de la Loi", Number: "16", PostalCode: "1000", City: "Brussels", CountryCode: "BE"}
+	assert.Equal(t, expected, result)
+}
+
+func Test_Address_Lines_Success(t *testing.T) {
+	tests := []struct {
+		address  Address
+		expected []string
+	}{
+		{
+			Address{Street: "Rue de la Loi", Number: "16", Box: "3", PostalCode: "1000", City: "Brussels", CountryCode: "BE"},
+			[]string{"Rue de la Loi 16 box 3", "1000 Brussels", "BELGIUM"},
+		},
+		{
+			Address{Street: "rue de Rivoli", Number: "10 bis", PostalCode: "75001", City: "Paris", CountryCode: "FR"},
+			[]string{"10 bis rue de Rivoli", "75001 PARIS", "FRANCE"},
+		},
+		{
+			Address{Street: "rue de la Gare", Number: "12", PostalCode: "L-1611", City: "Luxembourg", CountryCode: "LU"},
+			[]string{"12, rue de la Gare", "L-1611 Luxembourg", "LUXEMBOURG"},
+		},
+		{
+			Address{Street: "Baker Street", Number: "221B", PostalCode: "NW1 6XE", City: "London", CountryCode: "GB"},
+			[]string{"221B Baker Street", "LONDON", "NW1 6XE", "UNITED KINGDOM"},
+		},
+	}
+
+	for _, test := range tests {
+		result, genErr := test.address.Lines(true)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.expected, result)
+	}
+}
+
+func Test_Address_Lines_WithoutCountry_Success(t *testing.T) {
+	address := Address{Street: "Hauptstraße", Number: "5", PostalCode: "10115", City: "Berlin", CountryCode: "DE"}
+	result, genErr := address.Lines(false)
+	require.Nil(t, genErr)
+	assert.Equal(t, []string{"Hauptstraße 5", "10115 Berlin"}, result)
+}
+
+func Test_ParseAddress_Success(t *testing.T) {
+	tests := []struct {
+		input       string
+		countryCode string
+		expected    Address
+	}{
+		{
+			"Rue de la Loi 16 bte 3, 1000 Bruxelles",
+			"BE",
+			Address{Street: "Rue de la Loi", Number: "16", Box: "3", PostalCode: "1000", City: "Bruxelles", CountryCode: "BE"},
+		},
+		{
+			"Kerkstraat 12/4 2000 Antwerpen",
+			"BE",
+			Address{Street: "Kerkstraat", Number: "12", Box: "4", PostalCode: "2000", City: "Antwerpen", CountryCode: "BE"},
+		},
+		{
+			"Damrak 1 a, 1012lg Amsterdam, Nederland",
+			"BE",
+			Address{Street: "Damrak", Number: "1A", PostalCode: "1012 LG", City: "Amsterdam", CountryCode: "NL"},
+		},
+		{
+			"10 bis rue de Rivoli, 75001 Paris, France",
+			"",
+			Address{Street: "rue de Rivoli", Number: "10 bis", PostalCode: "75001", City: "Paris", CountryCode: "FR"},
+		},
+		{
+			"Hauptstraße 5, 10115 Berlin",
+			"DE",
+			Address{Street: "Hauptstraße", Number: "5", PostalCode: "10115", City: "Berlin", CountryCode: "DE"},
+		},
+		{
+			"12, rue de la Gare, L-1611 Luxembourg",
+			"LU",
+			Address{Street: "rue de la Gare", Number: "12", PostalCode: "L-1611", City: "Luxembourg", CountryCode: "LU"},
+		},
+	}
+
+	for _, test := range tests {
+		result, genErr := ParseAddress(test.input, test.countryCode)
+		require.Nil(t, genErr, test.input)
+		assert.Equal(t, test.expected, result)
+	}
+}
+
+func Test_ParseAddress_InvalidAddress_Failure(t *testing.T) {
+	inputs := []string{
+		"",
+		"Rue de la Loi 16",
+		"1000 Bruxelles",
+		"Rue de la Loi 16, 100 Bruxelles",
+	}
+
+	for _, input := range inputs {
+		_, genErr := ParseAddress(input, "BE")
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidAddress, map[string]string{"address": input})
+	}
+}
+
+func Test_ParseAddress_CountryNotFound_Failure(t *testing.T) {
+	_, genErr := ParseAddress("Rue de la Loi 16, 1000 Bruxelles", "XX")
+	errors.AssertGenericError(t, genErr, 404, ErrorCountryNotFound, map[string]string{"code": "XX"})
+}
@@ -0,0 +1,269 @@
+package converters
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/skiprco/go-utils/v2/collections"
+)
+
+// DefaultAcronyms contains the acronyms used by the package level case conversion functions.
+// Based on the initialisms of https://github.com/golang/lint
+var DefaultAcronyms = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS", "ID",
+	"IP", "JSON", "JWT", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "TCP",
+	"TLS", "TTL", "UDP", "UI", "UID", "UUID", "URI", "URL", "UTF8", "VAT", "VM", "XML", "XMPP",
+	"XSRF", "XSS",
+}
+
+// caseCacheSize is the maximum number of cached conversions per CaseConverter
+const caseCacheSize = 10000
+
+// CaseConverter converts strings between case styles (snake_case, camelCase, ...).
+// Input is split into words on non-alphanumeric characters and on changes in casing.
+// Converting between styles doesn't lose word boundaries, e.g. ToCamelCase(ToSnakeCase(input)) == ToCamelCase(input).
+//
+// Acronyms are kept together (e.g. "HTTPServer" => "http_server") and are fully capitalised in
+// camelCase, PascalCase and Title Case (e.g. "user_ids" => "userIDs"). The plural of an acronym
+// is supported as well (e.g. "IDs"). Acronyms in mixed case (e.g. "OAuth") are also used for splitting.
+//
+// Results are cached, so a CaseConverter should be reused. It's safe for concurrent use.
+type CaseConverter struct {
+	acronyms      map[string]string
+	mixedAcronyms []string
+	cache         *collections.Cache
+}
+
+// NewCaseConverter creates a new converter with the provided acronyms (e.g. "ID", "OAuth")
+func NewCaseConverter(acronyms []string) *CaseConverter {
+	converter := &CaseConverter{
+		acronyms: map[string]string{},
+		cache:    collections.NewCache(collections.CacheOptions{MaxSize: caseCacheSize}),
+	}
+	for _, acronym := range acronyms {
+		converter.acronyms[strings.ToLower(acronym)] = acronym
+		if strings.ToUpper(acronym) != acronym {
+			converter.mixedAcronyms = append(converter.mixedAcronyms, acronym)
+		}
+	}
+
+	// Longest acronyms first, so "OAuth2" is matched before "OAuth"
+	sort.SliceStable(converter.mixedAcronyms, func(i, j int) bool {
+		return len(converter.mixedAcronyms[i]) > len(converter.mixedAcronyms[j])
+	})
+	return converter
+}
+
+var defaultCaseConverter = NewCaseConverter(DefaultAcronyms)
+
+// ToSnakeCase converts the provided string to snake_case (e.g. "userIDs" => "user_ids")
+func ToSnakeCase(input string) string {
+	return defaultCaseConverter.ToSnakeCase(input)
+}
+
+// ToScreamingSnakeCase converts the provided string to SCREAMING_SNAKE_CASE (e.g. "userIDs" => "USER_IDS")
+func ToScreamingSnakeCase(input string) string {
+	return defaultCaseConverter.ToScreamingSnakeCase(input)
+}
+
+// ToKebabCase converts the provided string to kebab-case (e.g. "userIDs" => "user-ids")
+func ToKebabCase(input string) string {
+	return defaultCaseConverter.ToKebabCase(input)
+}
+
+// ToCamelCase converts the provided string to camelCase (e.g. "user_ids" => "userIDs")
+func ToCamelCase(input string) string {
+	return defaultCaseConverter.ToCamelCase(input)
+}
+
+// ToPascalCase converts the provided string to PascalCase (e.g. "user_ids" => "UserIDs")
+func ToPascalCase(input string) string {
+	return defaultCaseConverter.ToPascalCase(input)
+}
+
+// ToTitleCase converts the provided string to Title Case (e.g. "user_ids" => "User IDs")
+func ToTitleCase(input string) string {
+	return defaultCaseConverter.ToTitleCase(input)
+}
+
+// ToSnakeCase converts the provided string to snake_case (e.g. "userIDs" => "user_ids")
+func (c *CaseConverter) ToSnakeCase(input string) string {
+	return c.convert(input, "snake", func(words []string) string {
+		return strings.ToLower(strings.Join(words, "_"))
+	})
+}
+
+// ToScreamingSnakeCase converts the provided string to SCREAMING_SNAKE_CASE (e.g. "userIDs" => "USER_IDS")
+func (c *CaseConverter) ToScreamingSnakeCase(input string) string {
+	return c.convert(input, "screaming_snake", func(words []string) string {
+		return strings.ToUpper(strings.Join(words, "_"))
+	})
+}
+
+// ToKebabCase converts the provided string to kebab-case (e.g. "userIDs" => "user-ids")
+func (c *CaseConverter) ToKebabCase(input string) string {
+	return c.convert(input, "kebab", func(words []string) string {
+		return strings.ToLower(strings.Join(words, "-"))
+	})
+}
+
+// ToCamelCase converts the provided string to camelCase (e.g. "user_ids" => "userIDs")
+func (c *CaseConverter) ToCamelCase(input string) string {
+	return c.convert(input, "camel", func(words []string) string {
+		for i := range words {
+			if i == 0 {
+				words[i] = strings.ToLower(words[i])
+			} else {
+				words[i] = c.capitalise(words[i])
+			}
+		}
+		return strings.Join(words, "")
+	})
+}
+
+// ToPascalCase converts the provided string to PascalCase (e.g. "user_ids" => "UserIDs")
+func (c *CaseConverter) ToPascalCase(input string) string {
+	return c.convert(input, "pascal", func(words []string) string {
+		for i := range words {
+			words[i] = c.capitalise(words[i])
+		}
+		return strings.Join(words, "")
+	})
+}
+
+// ToTitleCase converts the provided string to Title Case (e.g. "user_ids" => "User IDs")
+func (c *CaseConverter) ToTitleCase(input string) string {
+	return c.convert(input, "title", func(words []string) string {
+		for i := range words {
+			words[i] = c.capitalise(words[i])
+		}
+		return strings.Join(words, " ")
+	})
+}
+
+// convert splits the input in words and joins them with the provided function.
+// The result is cached per style.
+func (c *CaseConverter) convert(input string, style string, join func(words []string) string) string {
+	cacheKey := style + ":" + input
+	if output, exists := c.cache.Get(cacheKey); exists {
+		return output.(string)
+	}
+	output := join(c.splitWords(input))
+	c.cache.Set(cacheKey, output)
+	return output
+}
+
+// capitalise returns the acronym if the word is a known acronym (or its plural).
+// Otherwise the first letter is converted to upper case and the other letters to lower case.
+func (c *CaseConverter) capitalise(word string) string {
+	lower := strings.ToLower(word)
+	if acronym, exists := c.acronyms[lower]; exists {
+		return acronym
+	}
+	if acronym, exists := c.acronyms[strings.TrimSuffix(lower, "s")]; exists && strings.HasSuffix(lower, "s") {
+		return acronym + "s"
+	}
+	runes := []rune(lower)
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
+
+// splitWords splits the input in words. All characters which are not
+// a letter or digit are considered separators and are removed.
+func (c *CaseConverter) splitWords(input string) []string {
+	runes := []rune(input)
+	words := []string{}
+	for i := 0; i < len(runes); {
+		// Skip separators
+		if !isWordRune(runes[i]) {
+			i++
+			continue
+		}
+
+		// Scan word
+		start := i
+		if length := c.matchMixedAcronym(runes[i:]); length > 0 {
+			i += length
+		} else {
+			i = c.scanWord(runes, i)
+		}
+
+		// Append digits and lower case letters (e.g. "sha256sum", "Id0")
+		for i < len(runes) && (unicode.IsDigit(runes[i]) || isLowerRune(runes[i])) {
+			i++
+		}
+		words = append(words, string(runes[start:i]))
+	}
+	return words
+}
+
+// scanWord returns the end of the word which starts at index start
+func (c *CaseConverter) scanWord(runes []rune, start int) int {
+	i := start + 1
+	if isUpperRune(runes[start]) && i < len(runes) && isUpperRune(runes[i]) {
+		// Scan upper case letters (e.g. HTTP)
+		for i < len(runes) && isUpperRune(runes[i]) {
+			i++
+		}
+		switch {
+		case i == len(runes):
+			return i
+		case isLowerRune(runes[i]):
+			// Plural of an acronym (e.g. IDs)
+			if runes[i] == 's' && (i+1 == len(runes) || !isLowerRune(runes[i+1])) {
+				return i + 1
+			}
+			// Last upper case letter starts the next word (e.g. HTTPServer)
+			return i - 1
+		case unicode.IsDigit(runes[i]):
+			// Acronym followed by word with digits (e.g. APIV2)
+			_, fullIsAcronym := c.acronyms[strings.ToLower(string(runes[start:i]))]
+			_, headIsAcronym := c.acronyms[strings.ToLower(string(runes[start:i-1]))]
+			if headIsAcronym && !fullIsAcronym {
+				return i - 1
+			}
+		}
+		return i
+	}
+
+	// Scan lower case letters (e.g. Word)
+	for i < len(runes) && isLowerRune(runes[i]) {
+		i++
+	}
+	return i
+}
+
+// matchMixedAcronym returns the length of the mixed case acronym at the start of runes.
+// Returns 0 if no acronym matches.
+func (c *CaseConverter) matchMixedAcronym(runes []rune) int {
+	for _, acronym := range c.mixedAcronyms {
+		acronymRunes := []rune(acronym)
+		if len(runes) < len(acronymRunes) || string(runes[:len(acronymRunes)]) != acronym {
+			continue
+		}
+		length := len(acronymRunes)
+		if length < len(runes) && runes[length] == 's' {
+			length++ // Plural
+		}
+		if length == len(runes) || !isLowerRune(runes[length]) {
+			return length
+		}
+	}
+	return 0
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+func isUpperRune(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsTitle(r)
+}
+
+// isLowerRune returns true for lower case letters, letters without casing (e.g. 日) and marks
+func isLowerRune(r rune) bool {
+	return (unicode.IsLetter(r) || unicode.IsMark(r)) && !isUpperRune(r)
+}
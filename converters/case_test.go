@@ -0,0 +1,64 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CaseConversion_Success(t *testing.T) {
+	tests := []struct {
+		input          string
+		snake          string
+		screamingSnake string
+		kebab          string
+		camel          string
+		pascal         string
+		title          string
+	}{
+		{"", "", "", "", "", "", ""},
+		{"userIDs", "user_ids", "USER_IDS", "user-ids", "userIDs", "UserIDs", "User IDs"},
+		{"HTTPServer", "http_server", "HTTP_SERVER", "http-server", "httpServer", "HTTPServer", "HTTP Server"},
+		{"user id", "user_id", "USER_ID", "user-id", "userID", "UserID", "User ID"},
+		{"ApiV2", "api_v2", "API_V2", "api-v2", "apiV2", "APIV2", "API V2"},
+		{"sha256sum", "sha256sum", "SHA256SUM", "sha256sum", "sha256sum", "Sha256sum", "Sha256sum"},
+		{"URLsAndIDs", "urls_and_ids", "URLS_AND_IDS", "urls-and-ids", "urlsAndIDs", "URLsAndIDs", "URLs And IDs"},
+		{"__trim--me__", "trim_me", "TRIM_ME", "trim-me", "trimMe", "TrimMe", "Trim Me"},
+		{"ÉcoleÉlève", "école_élève", "ÉCOLE_ÉLÈVE", "école-élève", "écoleÉlève", "ÉcoleÉlève", "École Élève"},
+		{"straße_nummer", "straße_nummer", "STRAßE_NUMMER", "straße-nummer", "straßeNummer", "StraßeNummer", "Straße Nummer"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.snake, ToSnakeCase(test.input), test.input)
+		assert.Equal(t, test.screamingSnake, ToScreamingSnakeCase(test.input), test.input)
+		assert.Equal(t, test.kebab, ToKebabCase(test.input), test.input)
+		assert.Equal(t, test.camel, ToCamelCase(test.input), test.input)
+		assert.Equal(t, test.pascal, ToPascalCase(test.input), test.input)
+		assert.Equal(t, test.title, ToTitleCase(test.input), test.input)
+	}
+}
+
+func Test_CaseConversion_RoundTrip_Success(t *testing.T) {
+	converters := []func(string) string{
+		ToSnakeCase, ToScreamingSnakeCase, ToKebabCase, ToCamelCase, ToPascalCase, ToTitleCase,
+	}
+	inputs := []string{
+		"userIDs", "HTTPServer", "ID0Value", "ApiV2", "batteryLifeValue", "URLsAndIDs", "écoleÉlève", "userId",
+	}
+
+	for _, input := range inputs {
+		for _, from := range converters {
+			for _, to := range converters {
+				assert.Equal(t, to(input), to(from(input)), input)
+			}
+		}
+	}
+}
+
+func Test_CaseConverter_CustomAcronyms_Success(t *testing.T) {
+	converter := NewCaseConverter([]string{"ID", "OAuth"})
+	assert.Equal(t, "oauth_token_ids", converter.ToSnakeCase("OAuthTokenIDs"))
+	assert.Equal(t, "OAuthTokenIDs", converter.ToPascalCase("oauth_token_ids"))
+	assert.Equal(t, "apiKey", converter.ToCamelCase("API_KEY")) // API is not an acronym for this converter
+	assert.Equal(t, "oauthToken", converter.ToCamelCase("OAuthToken"))
+}
@@ -6,260 +6,124 @@ import (
 	"github.com/skiprco/go-utils/v2/errors"
 )
 
-// CountryCodes returns a constant mapping from country code to country name.
+// Country contains the ISO 3166-1 data of a country
+type Country struct {
+	// Alpha2 is the ISO 3166-1 alpha-2 code (e.g. BE)
+	Alpha2 string
+
+	// Alpha3 is the ISO 3166-1 alpha-3 code (e.g. BEL)
+	Alpha3 string
+
+	// Numeric is the ISO 3166-1 numeric code, including leading zeros (e.g. 056)
+	Numeric string
+
+	// CommonName is the short English name (e.g. Belgium)
+	CommonName string
+
+	// OfficialName is the full English name (e.g. Kingdom of Belgium)
+	OfficialName string
+
+	// CallingCode is the international calling code, including plus sign (e.g. +32)
+	CallingCode string
+
+	// Currency is the ISO 4217 code of the main currency (e.g. EUR).
+	// Empty if the country has no currency (e.g. Antarctica).
+	Currency string
+
+	// EU is true if the country is a member state of the European Union
+	EU bool
+
+	// EEA is true if the country is part of the European Economic Area
+	EEA bool
+
+	// Schengen is true if the country is part of the Schengen Area
+	Schengen bool
+
+	// Subdivisions contains the top level ISO 3166-2 subdivisions.
+	// Only available for BE, DE, FR, LU and NL. Nil for other countries.
+	Subdivisions []Subdivision
+}
+
+// Subdivision contains the ISO 3166-2 data of a subdivision of a country
+type Subdivision struct {
+	// Code is the ISO 3166-2 code (e.g. BE-VAN)
+	Code string
+
+	// Name is the name in the local language (e.g. Antwerpen)
+	Name string
+
+	// Category is the kind of subdivision (e.g. region, province, state, canton)
+	Category string
+
+	// Parent is the code of the parent subdivision. Empty if the subdivision has no parent.
+	Parent string
+}
+
+// Indexes are built once on init. The country data should never be modified afterwards.
+var countriesByAlpha2 = map[string]*Country{}
+var countriesByCode = map[string]*Country{}
+var subdivisionsByCode = map[string]Subdivision{}
+
+func init() {
+	for i := range countries {
+		country := &countries[i]
+		countriesByAlpha2[country.Alpha2] = country
+		countriesByCode[country.Alpha2] = country
+		countriesByCode[country.Alpha3] = country
+		countriesByCode[country.Numeric] = country
+		for _, subdivision := range country.Subdivisions {
+			subdivisionsByCode[subdivision.Code] = subdivision
+		}
+	}
+}
+
+// Countries returns a copy of all countries, sorted by alpha-2 code
+func Countries() []Country {
+	result := make([]Country, len(countries))
+	copy(result, countries)
+	return result
+}
+
+// CountryByCode returns the country for an ISO 3166-1 alpha-2 (e.g. BE),
+// alpha-3 (e.g. BEL) or numeric (e.g. 056) code, ignoring casing.
+//
+// Raises
+//
+// - 404/country_not_found: Provided country code was not found
+func CountryByCode(code string) (Country, *errors.GenericError) {
+	country, exists := countriesByCode[strings.ToUpper(code)]
+	if !exists {
+		meta := map[string]string{"code": code}
+		return Country{}, errors.NewGenericError(404, errorDomain, errorSubDomain, ErrorCountryNotFound, meta)
+	}
+	return *country, nil
+}
+
+// SubdivisionByCode returns the subdivision for an ISO 3166-2 code (e.g. BE-VAN), ignoring casing.
+// See Country.Subdivisions for the supported countries.
+//
+// Raises
+//
+// - 404/subdivision_not_found: Provided subdivision code was not found
+func SubdivisionByCode(code string) (Subdivision, *errors.GenericError) {
+	subdivision, exists := subdivisionsByCode[strings.ToUpper(code)]
+	if !exists {
+		meta := map[string]string{"code": code}
+		return Subdivision{}, errors.NewGenericError(404, errorDomain, errorSubDomain, ErrorSubdivisionNotFound, meta)
+	}
+	return subdivision, nil
+}
+
+// CountryCodes returns a mapping from country code to country name.
+// A new map is created on each call. Use CountryByCode or CountryCodeToCountryName
+// if you only need a single country.
 // Based on https://en.wikipedia.org/wiki/ISO_3166-2
 func CountryCodes() map[string]string {
-	return map[string]string{
-		"AD": "Andorra",
-		"AE": "United Arab Emirates",
-		"AF": "Afghanistan",
-		"AG": "Antigua and Barbuda",
-		"AI": "Anguilla",
-		"AL": "Albania",
-		"AM": "Armenia",
-		"AO": "Angola",
-		"AQ": "Antarctica",
-		"AR": "Argentina",
-		"AS": "American Samoa",
-		"AT": "Austria",
-		"AU": "Australia",
-		"AW": "Aruba",
-		"AX": "Aland Islands",
-		"AZ": "Azerbaijan",
-		"BA": "Bosnia and Herzegovina",
-		"BB": "Barbados",
-		"BD": "Bangladesh",
-		"BE": "Belgium",
-		"BF": "Burkina Faso",
-		"BG": "Bulgaria",
-		"BH": "Bahrain",
-		"BI": "Burundi",
-		"BJ": "Benin",
-		"BL": "Saint Barthelemy",
-		"BM": "Bermuda",
-		"BN": "Brunei",
-		"BO": "Bolivia",
-		"BQ": "Bonaire, Sint Eustatius and Saba",
-		"BR": "Brazil",
-		"BS": "The Bahamas",
-		"BT": "Bhutan",
-		"BV": "Bouvet Island",
-		"BW": "Botswana",
-		"BY": "Belarus",
-		"BZ": "Belize",
-		"CA": "Canada",
-		"CC": "Cocos (Keeling) Islands",
-		"CD": "Democratic Republic of the Congo",
-		"CF": "Central African Republic",
-		"CG": "Congo",
-		"CH": "Switzerland",
-		"CI": "Ivory Coast",
-		"CK": "Cook Islands",
-		"CL": "Chile",
-		"CM": "Cameroon",
-		"CN": "China",
-		"CO": "Colombia",
-		"CR": "Costa Rica",
-		"CU": "Cuba",
-		"CV": "Cabo Verde",
-		"CW": "Curacao",
-		"CX": "Christmas Island",
-		"CY": "Cyprus",
-		"CZ": "Czech Republic",
-		"DE": "Germany",
-		"DJ": "Djibouti",
-		"DK": "Denmark",
-		"DM": "Dominica",
-		"DO": "Dominican Republic",
-		"DZ": "Algeria",
-		"EC": "Ecuador",
-		"EE": "Estonia",
-		"EG": "Egypt",
-		"EH": "Western Sahara",
-		"ER": "Eritrea",
-		"ES": "Spain",
-		"ET": "Ethiopia",
-		"FI": "Finland",
-		"FJ": "Fiji",
-		"FK": "Falkland Islands",
-		"FM": "Federated States of Micronesia",
-		"FO": "Faroe Islands",
-		"FR": "France",
-		"GA": "Gabon",
-		"GB": "United Kingdom",
-		"GD": "Grenada",
-		"GE": "Georgia",
-		"GF": "French Guiana",
-		"GG": "Bailiwick of Guernsey",
-		"GH": "Ghana",
-		"GI": "Gibraltar",
-		"GL": "Greenland",
-		"GM": "The Gambia",
-		"GN": "Guinea",
-		"GP": "Guadeloupe",
-		"GQ": "Equatorial Guinea",
-		"GR": "Greece",
-		"GS": "South Georgia and the South Sandwich Islands",
-		"GT": "Guatemala",
-		"GU": "Guam",
-		"GW": "Guinea-Bissau",
-		"GY": "Guyana",
-		"HK": "Hong Kong",
-		"HM": "Heard Island and McDonald Islands",
-		"HN": "Honduras",
-		"HR": "Croatia",
-		"HT": "Haiti",
-		"HU": "Hungary",
-		"ID": "Indonesia",
-		"IE": "Republic of Ireland",
-		"IL": "Israel",
-		"IM": "Isle of Man",
-		"IN": "India",
-		"IO": "British Indian Ocean Territory",
-		"IQ": "Iraq",
-		"IR": "Iran",
-		"IS": "Iceland",
-		"IT": "Italy",
-		"JE": "Jersey",
-		"JM": "Jamaica",
-		"JO": "Jordan",
-		"JP": "Japan",
-		"KE": "Kenya",
-		"KG": "Kyrgyzstan",
-		"KH": "Cambodia",
-		"KI": "Kiribati",
-		"KM": "Comoros",
-		"KN": "Saint Kitts and Nevis",
-		"KP": "North Korea",
-		"KR": "South Korea",
-		"KW": "Kuwait",
-		"KY": "Cayman Islands",
-		"KZ": "Kazakhstan",
-		"LA": "Laos",
-		"LB": "Lebanon",
-		"LC": "Saint Lucia",
-		"LI": "Liechtenstein",
-		"LK": "Sri Lanka",
-		"LR": "Liberia",
-		"LS": "Lesotho",
-		"LT": "Lithuania",
-		"LU": "Luxembourg",
-		"LV": "Latvia",
-		"LY": "Libya",
-		"MA": "Morocco",
-		"MC": "Monaco",
-		"MD": "Moldova",
-		"ME": "Montenegro",
-		"MF": "Saint Martin",
-		"MG": "Madagascar",
-		"MH": "Marshall Islands",
-		"MK": "North Macedonia",
-		"ML": "Mali",
-		"MM": "Myanmar",
-		"MN": "Mongolia",
-		"MO": "Macau",
-		"MP": "Northern Mariana Islands",
-		"MQ": "Martinique",
-		"MR": "Mauritania",
-		"MS": "Montserrat",
-		"MT": "Malta",
-		"MU": "Mauritius",
-		"MV": "Maldives",
-		"MW": "Malawi",
-		"MX": "Mexico",
-		"MY": "Malaysia",
-		"MZ": "Mozambique",
-		"NA": "Namibia",
-		"NC": "New Caledonia",
-		"NE": "Niger",
-		"NF": "Norfolk Island",
-		"NG": "Nigeria",
-		"NI": "Nicaragua",
-		"NL": "Netherlands",
-		"NO": "Norway",
-		"NP": "Nepal",
-		"NR": "Nauru",
-		"NU": "Niue",
-		"NZ": "New Zealand",
-		"OM": "Oman",
-		"PA": "Panama",
-		"PE": "Peru",
-		"PF": "French Polynesia",
-		"PG": "Papua New Guinea",
-		"PH": "Philippines",
-		"PK": "Pakistan",
-		"PL": "Poland",
-		"PM": "Saint Pierre and Miquelon",
-		"PN": "Pitcairn Islands",
-		"PR": "Puerto Rico",
-		"PS": "Palestine",
-		"PT": "Portugal",
-		"PW": "Palau",
-		"PY": "Paraguay",
-		"QA": "Qatar",
-		"RE": "Reunion",
-		"RO": "Romania",
-		"RS": "Serbia",
-		"RU": "Russia",
-		"RW": "Rwanda",
-		"SA": "Saudi Arabia",
-		"SB": "Solomon Islands",
-		"SC": "Seychelles",
-		"SD": "Sudan",
-		"SE": "Sweden",
-		"SG": "Singapore",
-		"SH": "Saint Helena, Ascension and Tristan da Cunha",
-		"SI": "Slovenia",
-		"SJ": "Svalbard and Jan Mayen",
-		"SK": "Slovakia",
-		"SL": "Sierra Leone",
-		"SM": "San Marino",
-		"SN": "Senegal",
-		"SO": "Somalia",
-		"SR": "Suriname",
-		"SS": "South Sudan",
-		"ST": "Sao Tome and Principe",
-		"SV": "El Salvador",
-		"SX": "Sint Maarten",
-		"SY": "Syria",
-		"SZ": "Eswatini",
-		"TC": "Turks and Caicos Islands",
-		"TD": "Chad",
-		"TF": "French Southern and Antarctic Lands",
-		"TG": "Togo",
-		"TH": "Thailand",
-		"TJ": "Tajikistan",
-		"TK": "Tokelau",
-		"TL": "East Timor",
-		"TM": "Turkmenistan",
-		"TN": "Tunisia",
-		"TO": "Tonga",
-		"TR": "Turkey",
-		"TT": "Trinidad and Tobago",
-		"TV": "Tuvalu",
-		"TW": "Taiwan",
-		"TZ": "Tanzania",
-		"UA": "Ukraine",
-		"UG": "Uganda",
-		"UM": "United States Minor Outlying Islands",
-		"US": "United States",
-		"UY": "Uruguay",
-		"UZ": "Uzbekistan",
-		"VA": "Vatican City",
-		"VC": "Saint Vincent and the Grenadines",
-		"VE": "Venezuela",
-		"VG": "British Virgin Islands",
-		"VI": "United States Virgin Islands",
-		"VN": "Vietnam",
-		"VU": "Vanuatu",
-		"WF": "Wallis and Futuna",
-		"WS": "Samoa",
-		"YE": "Yemen",
-		"YT": "Mayotte",
-		"ZA": "South Africa",
-		"ZM": "Zambia",
-		"ZW": "Zimbabwe",
+	result := make(map[string]string, len(countries))
+	for _, country := range countries {
+		result[country.Alpha2] = country.CommonName
 	}
+	return result
 }
 
 // CountryCodeToCountryName converts a country code to a country's name
@@ -268,15 +132,17 @@ func CountryCodes() map[string]string {
 //
 // - 404/country_not_found: Provided country code was not found
 func CountryCodeToCountryName(countryCode string) (string, *errors.GenericError) {
-	name, exists := CountryCodes()[countryCode]
+	country, exists := countriesByAlpha2[countryCode]
 	if !exists {
 		meta := map[string]string{"code": countryCode}
 		return "", errors.NewGenericError(404, errorDomain, errorSubDomain, ErrorCountryNotFound, meta)
 	}
-	return name, nil
+	return country.CommonName, nil
 }
 
-// CountryNameToCountryCode converts a country name to a country's code, ignoring casing and accents
+// CountryNameToCountryCode converts a country name to a country's code, ignoring casing, accents and punctuation.
+// The name is matched against the names in all SupportedCountryNameLanguages and common aliases (e.g. UK, USA).
+// See CountryNameToCountryCodeFuzzy to allow typos.
 //
 // Raises
 //
@@ -285,18 +151,16 @@ func CountryCodeToCountryName(countryCode string) (string, *errors.GenericError)
 // - 404/country_not_found: Provided country was not found
 func CountryNameToCountryCode(countryName string) (string, *errors.GenericError) {
 	// Clean input name
-	cleanName, genErr := NormaliseString(countryName)
+	cleanName, genErr := normaliseCountryName(countryName)
 	if genErr != nil {
 		return "", genErr
 	}
-	cleanName = strings.ToLower(cleanName)
 
 	// Search for match
-	for code, name := range CountryCodes() {
-		if strings.ToLower(name) == cleanName {
-			return code, nil
-		}
+	code, exists := lookupCountryName(cleanName)
+	if !exists {
+		meta := map[string]string{"name": countryName}
+		return "", errors.NewGenericError(404, errorDomain, errorSubDomain, ErrorCountryNotFound, meta)
 	}
-	meta := map[string]string{"name": countryName}
-	return "", errors.NewGenericError(404, errorDomain, errorSubDomain, ErrorCountryNotFound, meta)
+	return code, nil
 }
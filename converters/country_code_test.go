@@ -5,6 +5,7 @@ import (
 
 	"github.com/skiprco/go-utils/v2/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_CountryCodeToCountryName_Success(t *testing.T) {
@@ -36,3 +37,78 @@ func Test_CountryNameToCountryCode_Failure(t *testing.T) {
 	assert.Equal(t, "", result)
 	errors.AssertGenericError(t, genErr, 404, "country_not_found", nil)
 }
+
+func Test_Countries_Consistent(t *testing.T) {
+	result := Countries()
+	assert.Len(t, result, 249)
+
+	codes := map[string]bool{}
+	for _, country := range result {
+		assert.Len(t, country.Alpha2, 2)
+		assert.Len(t, country.Alpha3, 3)
+		assert.Len(t, country.Numeric, 3)
+		assert.Regexp(t, `^\+\d+$`, country.CallingCode)
+		assert.True(t, !country.EU || country.EEA, "EU member should be part of EEA")
+		for _, code := range []string{country.Alpha2, country.Alpha3, country.Numeric} {
+			assert.False(t, codes[code], "code should be unique")
+			codes[code] = true
+		}
+	}
+}
+
+func Test_CountryByCode_Success(t *testing.T) {
+	for _, code := range []string{"BE", "be", "BEL", "056"} {
+		result, genErr := CountryByCode(code)
+		require.Nil(t, genErr)
+		assert.Equal(t, "BE", result.Alpha2)
+		assert.Equal(t, "BEL", result.Alpha3)
+		assert.Equal(t, "056", result.Numeric)
+		assert.Equal(t, "Belgium", result.CommonName)
+		assert.Equal(t, "Kingdom of Belgium", result.OfficialName)
+		assert.Equal(t, "+32", result.CallingCode)
+		assert.Equal(t, "EUR", result.Currency)
+		assert.True(t, result.EU)
+		assert.True(t, result.EEA)
+		assert.True(t, result.Schengen)
+		assert.Len(t, result.Subdivisions, 13)
+	}
+}
+
+func Test_CountryByCode_Memberships(t *testing.T) {
+	tests := []struct {
+		code     string
+		eu       bool
+		eea      bool
+		schengen bool
+	}{
+		{"IE", true, true, false},
+		{"NO", false, true, true},
+		{"CH", false, false, true},
+		{"GB", false, false, false},
+	}
+
+	for _, test := range tests {
+		result, genErr := CountryByCode(test.code)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.eu, result.EU, test.code)
+		assert.Equal(t, test.eea, result.EEA, test.code)
+		assert.Equal(t, test.schengen, result.Schengen, test.code)
+	}
+}
+
+func Test_CountryByCode_Failure(t *testing.T) {
+	_, genErr := CountryByCode("XXX")
+	errors.AssertGenericError(t, genErr, 404, ErrorCountryNotFound, map[string]string{"code": "XXX"})
+}
+
+func Test_SubdivisionByCode_Success(t *testing.T) {
+	result, genErr := SubdivisionByCode("be-van")
+	require.Nil(t, genErr)
+	expected := Subdivision{Code: "BE-VAN", Name: "Antwerpen", Category: "province", Parent: "BE-VLG"}
+	assert.Equal(t, expected, result)
+}
+
+func Test_SubdivisionByCode_Failure(t *testing.T) {
+	_, genErr := SubdivisionByCode("BE-XXX")
+	errors.AssertGenericError(t, genErr, 404, ErrorSubdivisionNotFound, nil)
+}
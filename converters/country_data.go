@@ -0,0 +1,345 @@
+package converters
+
+// countries contains all countries defined in ISO 3166-1.
+// Based on https://en.wikipedia.org/wiki/ISO_3166-1 and https://en.wikipedia.org/wiki/ISO_4217
+//
+// Calling codes are the country calling codes defined in ITU-T E.164. Countries
+// in the North American Numbering Plan share calling code +1.
+var countries = []Country{
+	{Alpha2: "AD", Alpha3: "AND", Numeric: "020", CommonName: "Andorra", OfficialName: "Principality of Andorra", CallingCode: "+376", Currency: "EUR"},
+	{Alpha2: "AE", Alpha3: "ARE", Numeric: "784", CommonName: "United Arab Emirates", OfficialName: "United Arab Emirates", CallingCode: "+971", Currency: "AED"},
+	{Alpha2: "AF", Alpha3: "AFG", Numeric: "004", CommonName: "Afghanistan", OfficialName: "Islamic Republic of Afghanistan", CallingCode: "+93", Currency: "AFN"},
+	{Alpha2: "AG", Alpha3: "ATG", Numeric: "028", CommonName: "Antigua and Barbuda", OfficialName: "Antigua and Barbuda", CallingCode: "+1", Currency: "XCD"},
+	{Alpha2: "AI", Alpha3: "AIA", Numeric: "660", CommonName: "Anguilla", OfficialName: "Anguilla", CallingCode: "+1", Currency: "XCD"},
+	{Alpha2: "AL", Alpha3: "ALB", Numeric: "008", CommonName: "Albania", OfficialName: "Republic of Albania", CallingCode: "+355", Currency: "ALL"},
+	{Alpha2: "AM", Alpha3: "ARM", Numeric: "051", CommonName: "Armenia", OfficialName: "Republic of Armenia", CallingCode: "+374", Currency: "AMD"},
+	{Alpha2: "AO", Alpha3: "AGO", Numeric: "024", CommonName: "Angola", OfficialName: "Republic of Angola", CallingCode: "+244", Currency: "AOA"},
+	{Alpha2: "AQ", Alpha3: "ATA", Numeric: "010", CommonName: "Antarctica", OfficialName: "Antarctica", CallingCode: "+672", Currency: ""},
+	{Alpha2: "AR", Alpha3: "ARG", Numeric: "032", CommonName: "Argentina", OfficialName: "Argentine Republic", CallingCode: "+54", Currency: "ARS"},
+	{Alpha2: "AS", Alpha3: "ASM", Numeric: "016", CommonName: "American Samoa", OfficialName: "American Samoa", CallingCode: "+1", Currency: "USD"},
+	{Alpha2: "AT", Alpha3: "AUT", Numeric: "040", CommonName: "Austria", OfficialName: "Republic of Austria", CallingCode: "+43", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "AU", Alpha3: "AUS", Numeric: "036", CommonName: "Australia", OfficialName: "Commonwealth of Australia", CallingCode: "+61", Currency: "AUD"},
+	{Alpha2: "AW", Alpha3: "ABW", Numeric: "533", CommonName: "Aruba", OfficialName: "Aruba", CallingCode: "+297", Currency: "AWG"},
+	{Alpha2: "AX", Alpha3: "ALA", Numeric: "248", CommonName: "Aland Islands", OfficialName: "Åland Islands", CallingCode: "+358", Currency: "EUR"},
+	{Alpha2: "AZ", Alpha3: "AZE", Numeric: "031", CommonName: "Azerbaijan", OfficialName: "Republic of Azerbaijan", CallingCode: "+994", Currency: "AZN"},
+	{Alpha2: "BA", Alpha3: "BIH", Numeric: "070", CommonName: "Bosnia and Herzegovina", OfficialName: "Bosnia and Herzegovina", CallingCode: "+387", Currency: "BAM"},
+	{Alpha2: "BB", Alpha3: "BRB", Numeric: "052", CommonName: "Barbados", OfficialName: "Barbados", CallingCode: "+1", Currency: "BBD"},
+	{Alpha2: "BD", Alpha3: "BGD", Numeric: "050", CommonName: "Bangladesh", OfficialName: "People's Republic of Bangladesh", CallingCode: "+880", Currency: "BDT"},
+	{Alpha2: "BE", Alpha3: "BEL", Numeric: "056", CommonName: "Belgium", OfficialName: "Kingdom of Belgium", CallingCode: "+32", Currency: "EUR", EU: true, EEA: true, Schengen: true, Subdivisions: subdivisionsBE},
+	{Alpha2: "BF", Alpha3: "BFA", Numeric: "854", CommonName: "Burkina Faso", OfficialName: "Burkina Faso", CallingCode: "+226", Currency: "XOF"},
+	{Alpha2: "BG", Alpha3: "BGR", Numeric: "100", CommonName: "Bulgaria", OfficialName: "Republic of Bulgaria", CallingCode: "+359", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "BH", Alpha3: "BHR", Numeric: "048", CommonName: "Bahrain", OfficialName: "Kingdom of Bahrain", CallingCode: "+973", Currency: "BHD"},
+	{Alpha2: "BI", Alpha3: "BDI", Numeric: "108", CommonName: "Burundi", OfficialName: "Republic of Burundi", CallingCode: "+257", Currency: "BIF"},
+	{Alpha2: "BJ", Alpha3: "BEN", Numeric: "204", CommonName: "Benin", OfficialName: "Republic of Benin", CallingCode: "+229", Currency: "XOF"},
+	{Alpha2: "BL", Alpha3: "BLM", Numeric: "652", CommonName: "Saint Barthelemy", OfficialName: "Saint Barthélemy", CallingCode: "+590", Currency: "EUR"},
+	{Alpha2: "BM", Alpha3: "BMU", Numeric: "060", CommonName: "Bermuda", OfficialName: "Bermuda", CallingCode: "+1", Currency: "BMD"},
+	{Alpha2: "BN", Alpha3: "BRN", Numeric: "096", CommonName: "Brunei", OfficialName: "Brunei Darussalam", CallingCode: "+673", Currency: "BND"},
+	{Alpha2: "BO", Alpha3: "BOL", Numeric: "068", CommonName: "Bolivia", OfficialName: "Plurinational State of Bolivia", CallingCode: "+591", Currency: "BOB"},
+	{Alpha2: "BQ", Alpha3: "BES", Numeric: "535", CommonName: "Bonaire, Sint Eustatius and Saba", OfficialName: "Bonaire, Sint Eustatius and Saba", CallingCode: "+599", Currency: "USD"},
+	{Alpha2: "BR", Alpha3: "BRA", Numeric: "076", CommonName: "Brazil", OfficialName: "Federative Republic of Brazil", CallingCode: "+55", Currency: "BRL"},
+	{Alpha2: "BS", Alpha3: "BHS", Numeric: "044", CommonName: "The Bahamas", OfficialName: "Commonwealth of the Bahamas", CallingCode: "+1", Currency: "BSD"},
+	{Alpha2: "BT", Alpha3: "BTN", Numeric: "064", CommonName: "Bhutan", OfficialName: "Kingdom of Bhutan", CallingCode: "+975", Currency: "BTN"},
+	{Alpha2: "BV", Alpha3: "BVT", Numeric: "074", CommonName: "Bouvet Island", OfficialName: "Bouvet Island", CallingCode: "+47", Currency: "NOK"},
+	{Alpha2: "BW", Alpha3: "BWA", Numeric: "072", CommonName: "Botswana", OfficialName: "Republic of Botswana", CallingCode: "+267", Currency: "BWP"},
+	{Alpha2: "BY", Alpha3: "BLR", Numeric: "112", CommonName: "Belarus", OfficialName: "Republic of Belarus", CallingCode: "+375", Currency: "BYN"},
+	{Alpha2: "BZ", Alpha3: "BLZ", Numeric: "084", CommonName: "Belize", OfficialName: "Belize", CallingCode: "+501", Currency: "BZD"},
+	{Alpha2: "CA", Alpha3: "CAN", Numeric: "124", CommonName: "Canada", OfficialName: "Canada", CallingCode: "+1", Currency: "CAD"},
+	{Alpha2: "CC", Alpha3: "CCK", Numeric: "166", CommonName: "Cocos (Keeling) Islands", OfficialName: "Territory of Cocos (Keeling) Islands", CallingCode: "+61", Currency: "AUD"},
+	{Alpha2: "CD", Alpha3: "COD", Numeric: "180", CommonName: "Democratic Republic of the Congo", OfficialName: "Democratic Republic of the Congo", CallingCode: "+243", Currency: "CDF"},
+	{Alpha2: "CF", Alpha3: "CAF", Numeric: "140", CommonName: "Central African Republic", OfficialName: "Central African Republic", CallingCode: "+236", Currency: "XAF"},
+	{Alpha2: "CG", Alpha3: "COG", Numeric: "178", CommonName: "Congo", OfficialName: "Republic of the Congo", CallingCode: "+242", Currency: "XAF"},
+	{Alpha2: "CH", Alpha3: "CHE", Numeric: "756", CommonName: "Switzerland", OfficialName: "Swiss Confederation", CallingCode: "+41", Currency: "CHF", Schengen: true},
+	{Alpha2: "CI", Alpha3: "CIV", Numeric: "384", CommonName: "Ivory Coast", OfficialName: "Republic of Côte d'Ivoire", CallingCode: "+225", Currency: "XOF"},
+	{Alpha2: "CK", Alpha3: "COK", Numeric: "184", CommonName: "Cook Islands", OfficialName: "Cook Islands", CallingCode: "+682", Currency: "NZD"},
+	{Alpha2: "CL", Alpha3: "CHL", Numeric: "152", CommonName: "Chile", OfficialName: "Republic of Chile", CallingCode: "+56", Currency: "CLP"},
+	{Alpha2: "CM", Alpha3: "CMR", Numeric: "120", CommonName: "Cameroon", OfficialName: "Republic of Cameroon", CallingCode: "+237", Currency: "XAF"},
+	{Alpha2: "CN", Alpha3: "CHN", Numeric: "156", CommonName: "China", OfficialName: "People's Republic of China", CallingCode: "+86", Currency: "CNY"},
+	{Alpha2: "CO", Alpha3: "COL", Numeric: "170", CommonName: "Colombia", OfficialName: "Republic of Colombia", CallingCode: "+57", Currency: "COP"},
+	{Alpha2: "CR", Alpha3: "CRI", Numeric: "188", CommonName: "Costa Rica", OfficialName: "Republic of Costa Rica", CallingCode: "+506", Currency: "CRC"},
+	{Alpha2: "CU", Alpha3: "CUB", Numeric: "192", CommonName: "Cuba", OfficialName: "Republic of Cuba", CallingCode: "+53", Currency: "CUP"},
+	{Alpha2: "CV", Alpha3: "CPV", Numeric: "132", CommonName: "Cabo Verde", OfficialName: "Republic of Cabo Verde", CallingCode: "+238", Currency: "CVE"},
+	{Alpha2: "CW", Alpha3: "CUW", Numeric: "531", CommonName: "Curacao", OfficialName: "Country of Curaçao", CallingCode: "+599", Currency: "XCG"},
+	{Alpha2: "CX", Alpha3: "CXR", Numeric: "162", CommonName: "Christmas Island", OfficialName: "Territory of Christmas Island", CallingCode: "+61", Currency: "AUD"},
+	{Alpha2: "CY", Alpha3: "CYP", Numeric: "196", CommonName: "Cyprus", OfficialName: "Republic of Cyprus", CallingCode: "+357", Currency: "EUR", EU: true, EEA: true},
+	{Alpha2: "CZ", Alpha3: "CZE", Numeric: "203", CommonName: "Czech Republic", OfficialName: "Czech Republic", CallingCode: "+420", Currency: "CZK", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "DE", Alpha3: "DEU", Numeric: "276", CommonName: "Germany", OfficialName: "Federal Republic of Germany", CallingCode: "+49", Currency: "EUR", EU: true, EEA: true, Schengen: true, Subdivisions: subdivisionsDE},
+	{Alpha2: "DJ", Alpha3: "DJI", Numeric: "262", CommonName: "Djibouti", OfficialName: "Republic of Djibouti", CallingCode: "+253", Currency: "DJF"},
+	{Alpha2: "DK", Alpha3: "DNK", Numeric: "208", CommonName: "Denmark", OfficialName: "Kingdom of Denmark", CallingCode: "+45", Currency: "DKK", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "DM", Alpha3: "DMA", Numeric: "212", CommonName: "Dominica", OfficialName: "Commonwealth of Dominica", CallingCode: "+1", Currency: "XCD"},
+	{Alpha2: "DO", Alpha3: "DOM", Numeric: "214", CommonName: "Dominican Republic", OfficialName: "Dominican Republic", CallingCode: "+1", Currency: "DOP"},
+	{Alpha2: "DZ", Alpha3: "DZA", Numeric: "012", CommonName: "Algeria", OfficialName: "People's Democratic Republic of Algeria", CallingCode: "+213", Currency: "DZD"},
+	{Alpha2: "EC", Alpha3: "ECU", Numeric: "218", CommonName: "Ecuador", OfficialName: "Republic of Ecuador", CallingCode: "+593", Currency: "USD"},
+	{Alpha2: "EE", Alpha3: "EST", Numeric: "233", CommonName: "Estonia", OfficialName: "Republic of Estonia", CallingCode: "+372", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "EG", Alpha3: "EGY", Numeric: "818", CommonName: "Egypt", OfficialName: "Arab Republic of Egypt", CallingCode: "+20", Currency: "EGP"},
+	{Alpha2: "EH", Alpha3: "ESH", Numeric: "732", CommonName: "Western Sahara", OfficialName: "Western Sahara", CallingCode: "+212", Currency: "MAD"},
+	{Alpha2: "ER", Alpha3: "ERI", Numeric: "232", CommonName: "Eritrea", OfficialName: "State of Eritrea", CallingCode: "+291", Currency: "ERN"},
+	{Alpha2: "ES", Alpha3: "ESP", Numeric: "724", CommonName: "Spain", OfficialName: "Kingdom of Spain", CallingCode: "+34", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "ET", Alpha3: "ETH", Numeric: "231", CommonName: "Ethiopia", OfficialName: "Federal Democratic Republic of Ethiopia", CallingCode: "+251", Currency: "ETB"},
+	{Alpha2: "FI", Alpha3: "FIN", Numeric: "246", CommonName: "Finland", OfficialName: "Republic of Finland", CallingCode: "+358", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "FJ", Alpha3: "FJI", Numeric: "242", CommonName: "Fiji", OfficialName: "Republic of Fiji", CallingCode: "+679", Currency: "FJD"},
+	{Alpha2: "FK", Alpha3: "FLK", Numeric: "238", CommonName: "Falkland Islands", OfficialName: "Falkland Islands (Malvinas)", CallingCode: "+500", Currency: "FKP"},
+	{Alpha2: "FM", Alpha3: "FSM", Numeric: "583", CommonName: "Federated States of Micronesia", OfficialName: "Federated States of Micronesia", CallingCode: "+691", Currency: "USD"},
+	{Alpha2: "FO", Alpha3: "FRO", Numeric: "234", CommonName: "Faroe Islands", OfficialName: "Faroe Islands", CallingCode: "+298", Currency: "DKK"},
+	{Alpha2: "FR", Alpha3: "FRA", Numeric: "250", CommonName: "France", OfficialName: "French Republic", CallingCode: "+33", Currency: "EUR", EU: true, EEA: true, Schengen: true, Subdivisions: subdivisionsFR},
+	{Alpha2: "GA", Alpha3: "GAB", Numeric: "266", CommonName: "Gabon", OfficialName: "Gabonese Republic", CallingCode: "+241", Currency: "XAF"},
+	{Alpha2: "GB", Alpha3: "GBR", Numeric: "826", CommonName: "United Kingdom", OfficialName: "United Kingdom of Great Britain and Northern Ireland", CallingCode: "+44", Currency: "GBP"},
+	{Alpha2: "GD", Alpha3: "GRD", Numeric: "308", CommonName: "Grenada", OfficialName: "Grenada", CallingCode: "+1", Currency: "XCD"},
+	{Alpha2: "GE", Alpha3: "GEO", Numeric: "268", CommonName: "Georgia", OfficialName: "Georgia", CallingCode: "+995", Currency: "GEL"},
+	{Alpha2: "GF", Alpha3: "GUF", Numeric: "254", CommonName: "French Guiana", OfficialName: "French Guiana", CallingCode: "+594", Currency: "EUR"},
+	{Alpha2: "GG", Alpha3: "GGY", Numeric: "831", CommonName: "Bailiwick of Guernsey", OfficialName: "Bailiwick of Guernsey", CallingCode: "+44", Currency: "GBP"},
+	{Alpha2: "GH", Alpha3: "GHA", Numeric: "288", CommonName: "Ghana", OfficialName: "Republic of Ghana", CallingCode: "+233", Currency: "GHS"},
+	{Alpha2: "GI", Alpha3: "GIB", Numeric: "292", CommonName: "Gibraltar", OfficialName: "Gibraltar", CallingCode: "+350", Currency: "GIP"},
+	{Alpha2: "GL", Alpha3: "GRL", Numeric: "304", CommonName: "Greenland", OfficialName: "Greenland", CallingCode: "+299", Currency: "DKK"},
+	{Alpha2: "GM", Alpha3: "GMB", Numeric: "270", CommonName: "The Gambia", OfficialName: "Republic of the Gambia", CallingCode: "+220", Currency: "GMD"},
+	{Alpha2: "GN", Alpha3: "GIN", Numeric: "324", CommonName: "Guinea", OfficialName: "Republic of Guinea", CallingCode: "+224", Currency: "GNF"},
+	{Alpha2: "GP", Alpha3: "GLP", Numeric: "312", CommonName: "Guadeloupe", OfficialName: "Guadeloupe", CallingCode: "+590", Currency: "EUR"},
+	{Alpha2: "GQ", Alpha3: "GNQ", Numeric: "226", CommonName: "Equatorial Guinea", OfficialName: "Republic of Equatorial Guinea", CallingCode: "+240", Currency: "XAF"},
+	{Alpha2: "GR", Alpha3: "GRC", Numeric: "300", CommonName: "Greece", OfficialName: "Hellenic Republic", CallingCode: "+30", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "GS", Alpha3: "SGS", Numeric: "239", CommonName: "South Georgia and the South Sandwich Islands", OfficialName: "South Georgia and the South Sandwich Islands", CallingCode: "+500", Currency: "GBP"},
+	{Alpha2: "GT", Alpha3: "GTM", Numeric: "320", CommonName: "Guatemala", OfficialName: "Republic of Guatemala", CallingCode: "+502", Currency: "GTQ"},
+	{Alpha2: "GU", Alpha3: "GUM", Numeric: "316", CommonName: "Guam", OfficialName: "Guam", CallingCode: "+1", Currency: "USD"},
+	{Alpha2: "GW", Alpha3: "GNB", Numeric: "624", CommonName: "Guinea-Bissau", OfficialName: "Republic of Guinea-Bissau", CallingCode: "+245", Currency: "XOF"},
+	{Alpha2: "GY", Alpha3: "GUY", Numeric: "328", CommonName: "Guyana", OfficialName: "Co-operative Republic of Guyana", CallingCode: "+592", Currency: "GYD"},
+	{Alpha2: "HK", Alpha3: "HKG", Numeric: "344", CommonName: "Hong Kong", OfficialName: "Hong Kong Special Administrative Region of China", CallingCode: "+852", Currency: "HKD"},
+	{Alpha2: "HM", Alpha3: "HMD", Numeric: "334", CommonName: "Heard Island and McDonald Islands", OfficialName: "Heard Island and McDonald Islands", CallingCode: "+672", Currency: "AUD"},
+	{Alpha2: "HN", Alpha3: "HND", Numeric: "340", CommonName: "Honduras", OfficialName: "Republic of Honduras", CallingCode: "+504", Currency: "HNL"},
+	{Alpha2: "HR", Alpha3: "HRV", Numeric: "191", CommonName: "Croatia", OfficialName: "Republic of Croatia", CallingCode: "+385", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "HT", Alpha3: "HTI", Numeric: "332", CommonName: "Haiti", OfficialName: "Republic of Haiti", CallingCode: "+509", Currency: "HTG"},
+	{Alpha2: "HU", Alpha3: "HUN", Numeric: "348", CommonName: "Hungary", OfficialName: "Hungary", CallingCode: "+36", Currency: "HUF", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "ID", Alpha3: "IDN", Numeric: "360", CommonName: "Indonesia", OfficialName: "Republic of Indonesia", CallingCode: "+62", Currency: "IDR"},
+	{Alpha2: "IE", Alpha3: "IRL", Numeric: "372", CommonName: "Republic of Ireland", OfficialName: "Ireland", CallingCode: "+353", Currency: "EUR", EU: true, EEA: true},
+	{Alpha2: "IL", Alpha3: "ISR", Numeric: "376", CommonName: "Israel", OfficialName: "State of Israel", CallingCode: "+972", Currency: "ILS"},
+	{Alpha2: "IM", Alpha3: "IMN", Numeric: "833", CommonName: "Isle of Man", OfficialName: "Isle of Man", CallingCode: "+44", Currency: "GBP"},
+	{Alpha2: "IN", Alpha3: "IND", Numeric: "356", CommonName: "India", OfficialName: "Republic of India", CallingCode: "+91", Currency: "INR"},
+	{Alpha2: "IO", Alpha3: "IOT", Numeric: "086", CommonName: "British Indian Ocean Territory", OfficialName: "British Indian Ocean Territory", CallingCode: "+246", Currency: "USD"},
+	{Alpha2: "IQ", Alpha3: "IRQ", Numeric: "368", CommonName: "Iraq", OfficialName: "Republic of Iraq", CallingCode: "+964", Currency: "IQD"},
+	{Alpha2: "IR", Alpha3: "IRN", Numeric: "364", CommonName: "Iran", OfficialName: "Islamic Republic of Iran", CallingCode: "+98", Currency: "IRR"},
+	{Alpha2: "IS", Alpha3: "ISL", Numeric: "352", CommonName: "Iceland", OfficialName: "Iceland", CallingCode: "+354", Currency: "ISK", EEA: true, Schengen: true},
+	{Alpha2: "IT", Alpha3: "ITA", Numeric: "380", CommonName: "Italy", OfficialName: "Italian Republic", CallingCode: "+39", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "JE", Alpha3: "JEY", Numeric: "832", CommonName: "Jersey", OfficialName: "Bailiwick of Jersey", CallingCode: "+44", Currency: "GBP"},
+	{Alpha2: "JM", Alpha3: "JAM", Numeric: "388", CommonName: "Jamaica", OfficialName: "Jamaica", CallingCode: "+1", Currency: "JMD"},
+	{Alpha2: "JO", Alpha3: "JOR", Numeric: "400", CommonName: "Jordan", OfficialName: "Hashemite Kingdom of Jordan", CallingCode: "+962", Currency: "JOD"},
+	{Alpha2: "JP", Alpha3: "JPN", Numeric: "392", CommonName: "Japan", OfficialName: "Japan", CallingCode: "+81", Currency: "JPY"},
+	{Alpha2: "KE", Alpha3: "KEN", Numeric: "404", CommonName: "Kenya", OfficialName: "Republic of Kenya", CallingCode: "+254", Currency: "KES"},
+	{Alpha2: "KG", Alpha3: "KGZ", Numeric: "417", CommonName: "Kyrgyzstan", OfficialName: "Kyrgyz Republic", CallingCode: "+996", Currency: "KGS"},
+	{Alpha2: "KH", Alpha3: "KHM", Numeric: "116", CommonName: "Cambodia", OfficialName: "Kingdom of Cambodia", CallingCode: "+855", Currency: "KHR"},
+	{Alpha2: "KI", Alpha3: "KIR", Numeric: "296", CommonName: "Kiribati", OfficialName: "Republic of Kiribati", CallingCode: "+686", Currency: "AUD"},
+	{Alpha2: "KM", Alpha3: "COM", Numeric: "174", CommonName: "Comoros", OfficialName: "Union of the Comoros", CallingCode: "+269", Currency: "KMF"},
+	{Alpha2: "KN", Alpha3: "KNA", Numeric: "659", CommonName: "Saint Kitts and Nevis", OfficialName: "Federation of Saint Kitts and Nevis", CallingCode: "+1", Currency: "XCD"},
+	{Alpha2: "KP", Alpha3: "PRK", Numeric: "408", CommonName: "North Korea", OfficialName: "Democratic People's Republic of Korea", CallingCode: "+850", Currency: "KPW"},
+	{Alpha2: "KR", Alpha3: "KOR", Numeric: "410", CommonName: "South Korea", OfficialName: "Republic of Korea", CallingCode: "+82", Currency: "KRW"},
+	{Alpha2: "KW", Alpha3: "KWT", Numeric: "414", CommonName: "Kuwait", OfficialName: "State of Kuwait", CallingCode: "+965", Currency: "KWD"},
+	{Alpha2: "KY", Alpha3: "CYM", Numeric: "136", CommonName: "Cayman Islands", OfficialName: "Cayman Islands", CallingCode: "+1", Currency: "KYD"},
+	{Alpha2: "KZ", Alpha3: "KAZ", Numeric: "398", CommonName: "Kazakhstan", OfficialName: "Republic of Kazakhstan", CallingCode: "+7", Currency: "KZT"},
+	{Alpha2: "LA", Alpha3: "LAO", Numeric: "418", CommonName: "Laos", OfficialName: "Lao People's Democratic Republic", CallingCode: "+856", Currency: "LAK"},
+	{Alpha2: "LB", Alpha3: "LBN", Numeric: "422", CommonName: "Lebanon", OfficialName: "Lebanese Republic", CallingCode: "+961", Currency: "LBP"},
+	{Alpha2: "LC", Alpha3: "LCA", Numeric: "662", CommonName: "Saint Lucia", OfficialName: "Saint Lucia", CallingCode: "+1", Currency: "XCD"},
+	{Alpha2: "LI", Alpha3: "LIE", Numeric: "438", CommonName: "Liechtenstein", OfficialName: "Principality of Liechtenstein", CallingCode: "+423", Currency: "CHF", EEA: true, Schengen: true},
+	{Alpha2: "LK", Alpha3: "LKA", Numeric: "144", CommonName: "Sri Lanka", OfficialName: "Democratic Socialist Republic of Sri Lanka", CallingCode: "+94", Currency: "LKR"},
+	{Alpha2: "LR", Alpha3: "LBR", Numeric: "430", CommonName: "Liberia", OfficialName: "Republic of Liberia", CallingCode: "+231", Currency: "LRD"},
+	{Alpha2: "LS", Alpha3: "LSO", Numeric: "426", CommonName: "Lesotho", OfficialName: "Kingdom of Lesotho", CallingCode: "+266", Currency: "LSL"},
+	{Alpha2: "LT", Alpha3: "LTU", Numeric: "440", CommonName: "Lithuania", OfficialName: "Republic of Lithuania", CallingCode: "+370", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "LU", Alpha3: "LUX", Numeric: "442", CommonName: "Luxembourg", OfficialName: "Grand Duchy of Luxembourg", CallingCode: "+352", Currency: "EUR", EU: true, EEA: true, Schengen: true, Subdivisions: subdivisionsLU},
+	{Alpha2: "LV", Alpha3: "LVA", Numeric: "428", CommonName: "Latvia", OfficialName: "Republic of Latvia", CallingCode: "+371", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "LY", Alpha3: "LBY", Numeric: "434", CommonName: "Libya", OfficialName: "State of Libya", CallingCode: "+218", Currency: "LYD"},
+	{Alpha2: "MA", Alpha3: "MAR", Numeric: "504", CommonName: "Morocco", OfficialName: "Kingdom of Morocco", CallingCode: "+212", Currency: "MAD"},
+	{Alpha2: "MC", Alpha3: "MCO", Numeric: "492", CommonName: "Monaco", OfficialName: "Principality of Monaco", CallingCode: "+377", Currency: "EUR"},
+	{Alpha2: "MD", Alpha3: "MDA", Numeric: "498", CommonName: "Moldova", OfficialName: "Republic of Moldova", CallingCode: "+373", Currency: "MDL"},
+	{Alpha2: "ME", Alpha3: "MNE", Numeric: "499", CommonName: "Montenegro", OfficialName: "Montenegro", CallingCode: "+382", Currency: "EUR"},
+	{Alpha2: "MF", Alpha3: "MAF", Numeric: "663", CommonName: "Saint Martin", OfficialName: "Collectivity of Saint Martin", CallingCode: "+590", Currency: "EUR"},
+	{Alpha2: "MG", Alpha3: "MDG", Numeric: "450", CommonName: "Madagascar", OfficialName: "Republic of Madagascar", CallingCode: "+261", Currency: "MGA"},
+	{Alpha2: "MH", Alpha3: "MHL", Numeric: "584", CommonName: "Marshall Islands", OfficialName: "Republic of the Marshall Islands", CallingCode: "+692", Currency: "USD"},
+	{Alpha2: "MK", Alpha3: "MKD", Numeric: "807", CommonName: "North Macedonia", OfficialName: "Republic of North Macedonia", CallingCode: "+389", Currency: "MKD"},
+	{Alpha2: "ML", Alpha3: "MLI", Numeric: "466", CommonName: "Mali", OfficialName: "Republic of Mali", CallingCode: "+223", Currency: "XOF"},
+	{Alpha2: "MM", Alpha3: "MMR", Numeric: "104", CommonName: "Myanmar", OfficialName: "Republic of the Union of Myanmar", CallingCode: "+95", Currency: "MMK"},
+	{Alpha2: "MN", Alpha3: "MNG", Numeric: "496", CommonName: "Mongolia", OfficialName: "Mongolia", CallingCode: "+976", Currency: "MNT"},
+	{Alpha2: "MO", Alpha3: "MAC", Numeric: "446", CommonName: "Macau", OfficialName: "Macao Special Administrative Region of China", CallingCode: "+853", Currency: "MOP"},
+	{Alpha2: "MP", Alpha3: "MNP", Numeric: "580", CommonName: "Northern Mariana Islands", OfficialName: "Commonwealth of the Northern Mariana Islands", CallingCode: "+1", Currency: "USD"},
+	{Alpha2: "MQ", Alpha3: "MTQ", Numeric: "474", CommonName: "Martinique", OfficialName: "Martinique", CallingCode: "+596", Currency: "EUR"},
+	{Alpha2: "MR", Alpha3: "MRT", Numeric: "478", CommonName: "Mauritania", OfficialName: "Islamic Republic of Mauritania", CallingCode: "+222", Currency: "MRU"},
+	{Alpha2: "MS", Alpha3: "MSR", Numeric: "500", CommonName: "Montserrat", OfficialName: "Montserrat", CallingCode: "+1", Currency: "XCD"},
+	{Alpha2: "MT", Alpha3: "MLT", Numeric: "470", CommonName: "Malta", OfficialName: "Republic of Malta", CallingCode: "+356", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "MU", Alpha3: "MUS", Numeric: "480", CommonName: "Mauritius", OfficialName: "Republic of Mauritius", CallingCode: "+230", Currency: "MUR"},
+	{Alpha2: "MV", Alpha3: "MDV", Numeric: "462", CommonName: "Maldives", OfficialName: "Republic of Maldives", CallingCode: "+960", Currency: "MVR"},
+	{Alpha2: "MW", Alpha3: "MWI", Numeric: "454", CommonName: "Malawi", OfficialName: "Republic of Malawi", CallingCode: "+265", Currency: "MWK"},
+	{Alpha2: "MX", Alpha3: "MEX", Numeric: "484", CommonName: "Mexico", OfficialName: "United Mexican States", CallingCode: "+52", Currency: "MXN"},
+	{Alpha2: "MY", Alpha3: "MYS", Numeric: "458", CommonName: "Malaysia", OfficialName: "Malaysia", CallingCode: "+60", Currency: "MYR"},
+	{Alpha2: "MZ", Alpha3: "MOZ", Numeric: "508", CommonName: "Mozambique", OfficialName: "Republic of Mozambique", CallingCode: "+258", Currency: "MZN"},
+	{Alpha2: "NA", Alpha3: "NAM", Numeric: "516", CommonName: "Namibia", OfficialName: "Republic of Namibia", CallingCode: "+264", Currency: "NAD"},
+	{Alpha2: "NC", Alpha3: "NCL", Numeric: "540", CommonName: "New Caledonia", OfficialName: "New Caledonia", CallingCode: "+687", Currency: "XPF"},
+	{Alpha2: "NE", Alpha3: "NER", Numeric: "562", CommonName: "Niger", OfficialName: "Republic of the Niger", CallingCode: "+227", Currency: "XOF"},
+	{Alpha2: "NF", Alpha3: "NFK", Numeric: "574", CommonName: "Norfolk Island", OfficialName: "Territory of Norfolk Island", CallingCode: "+672", Currency: "AUD"},
+	{Alpha2: "NG", Alpha3: "NGA", Numeric: "566", CommonName: "Nigeria", OfficialName: "Federal Republic of Nigeria", CallingCode: "+234", Currency: "NGN"},
+	{Alpha2: "NI", Alpha3: "NIC", Numeric: "558", CommonName: "Nicaragua", OfficialName: "Republic of Nicaragua", CallingCode: "+505", Currency: "NIO"},
+	{Alpha2: "NL", Alpha3: "NLD", Numeric: "528", CommonName: "Netherlands", OfficialName: "Kingdom of the Netherlands", CallingCode: "+31", Currency: "EUR", EU: true, EEA: true, Schengen: true, Subdivisions: subdivisionsNL},
+	{Alpha2: "NO", Alpha3: "NOR", Numeric: "578", CommonName: "Norway", OfficialName: "Kingdom of Norway", CallingCode: "+47", Currency: "NOK", EEA: true, Schengen: true},
+	{Alpha2: "NP", Alpha3: "NPL", Numeric: "524", CommonName: "Nepal", OfficialName: "Federal Democratic Republic of Nepal", CallingCode: "+977", Currency: "NPR"},
+	{Alpha2: "NR", Alpha3: "NRU", Numeric: "520", CommonName: "Nauru", OfficialName: "Republic of Nauru", CallingCode: "+674", Currency: "AUD"},
+	{Alpha2: "NU", Alpha3: "NIU", Numeric: "570", CommonName: "Niue", OfficialName: "Niue", CallingCode: "+683", Currency: "NZD"},
+	{Alpha2: "NZ", Alpha3: "NZL", Numeric: "554", CommonName: "New Zealand", OfficialName: "New Zealand", CallingCode: "+64", Currency: "NZD"},
+	{Alpha2: "OM", Alpha3: "OMN", Numeric: "512", CommonName: "Oman", OfficialName: "Sultanate of Oman", CallingCode: "+968", Currency: "OMR"},
+	{Alpha2: "PA", Alpha3: "PAN", Numeric: "591", CommonName: "Panama", OfficialName: "Republic of Panama", CallingCode: "+507", Currency: "PAB"},
+	{Alpha2: "PE", Alpha3: "PER", Numeric: "604", CommonName: "Peru", OfficialName: "Republic of Peru", CallingCode: "+51", Currency: "PEN"},
+	{Alpha2: "PF", Alpha3: "PYF", Numeric: "258", CommonName: "French Polynesia", OfficialName: "French Polynesia", CallingCode: "+689", Currency: "XPF"},
+	{Alpha2: "PG", Alpha3: "PNG", Numeric: "598", CommonName: "Papua New Guinea", OfficialName: "Independent State of Papua New Guinea", CallingCode: "+675", Currency: "PGK"},
+	{Alpha2: "PH", Alpha3: "PHL", Numeric: "608", CommonName: "Philippines", OfficialName: "Republic of the Philippines", CallingCode: "+63", Currency: "PHP"},
+	{Alpha2: "PK", Alpha3: "PAK", Numeric: "586", CommonName: "Pakistan", OfficialName: "Islamic Republic of Pakistan", CallingCode: "+92", Currency: "PKR"},
+	{Alpha2: "PL", Alpha3: "POL", Numeric: "616", CommonName: "Poland", OfficialName: "Republic of Poland", CallingCode: "+48", Currency: "PLN", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "PM", Alpha3: "SPM", Numeric: "666", CommonName: "Saint Pierre and Miquelon", OfficialName: "Territorial Collectivity of Saint Pierre and Miquelon", CallingCode: "+508", Currency: "EUR"},
+	{Alpha2: "PN", Alpha3: "PCN", Numeric: "612", CommonName: "Pitcairn Islands", OfficialName: "Pitcairn, Henderson, Ducie and Oeno Islands", CallingCode: "+64", Currency: "NZD"},
+	{Alpha2: "PR", Alpha3: "PRI", Numeric: "630", CommonName: "Puerto Rico", OfficialName: "Commonwealth of Puerto Rico", CallingCode: "+1", Currency: "USD"},
+	{Alpha2: "PS", Alpha3: "PSE", Numeric: "275", CommonName: "Palestine", OfficialName: "State of Palestine", CallingCode: "+970", Currency: "ILS"},
+	{Alpha2: "PT", Alpha3: "PRT", Numeric: "620", CommonName: "Portugal", OfficialName: "Portuguese Republic", CallingCode: "+351", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "PW", Alpha3: "PLW", Numeric: "585", CommonName: "Palau", OfficialName: "Republic of Palau", CallingCode: "+680", Currency: "USD"},
+	{Alpha2: "PY", Alpha3: "PRY", Numeric: "600", CommonName: "Paraguay", OfficialName: "Republic of Paraguay", CallingCode: "+595", Currency: "PYG"},
+	{Alpha2: "QA", Alpha3: "QAT", Numeric: "634", CommonName: "Qatar", OfficialName: "State of Qatar", CallingCode: "+974", Currency: "QAR"},
+	{Alpha2: "RE", Alpha3: "REU", Numeric: "638", CommonName: "Reunion", OfficialName: "Réunion", CallingCode: "+262", Currency: "EUR"},
+	{Alpha2: "RO", Alpha3: "ROU", Numeric: "642", CommonName: "Romania", OfficialName: "Romania", CallingCode: "+40", Currency: "RON", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "RS", Alpha3: "SRB", Numeric: "688", CommonName: "Serbia", OfficialName: "Republic of Serbia", CallingCode: "+381", Currency: "RSD"},
+	{Alpha2: "RU", Alpha3: "RUS", Numeric: "643", CommonName: "Russia", OfficialName: "Russian Federation", CallingCode: "+7", Currency: "RUB"},
+	{Alpha2: "RW", Alpha3: "RWA", Numeric: "646", CommonName: "Rwanda", OfficialName: "Republic of Rwanda", CallingCode: "+250", Currency: "RWF"},
+	{Alpha2: "SA", Alpha3: "SAU", Numeric: "682", CommonName: "Saudi Arabia", OfficialName: "Kingdom of Saudi Arabia", CallingCode: "+966", Currency: "SAR"},
+	{Alpha2: "SB", Alpha3: "SLB", Numeric: "090", CommonName: "Solomon Islands", OfficialName: "Solomon Islands", CallingCode: "+677", Currency: "SBD"},
+	{Alpha2: "SC", Alpha3: "SYC", Numeric: "690", CommonName: "Seychelles", OfficialName: "Republic of Seychelles", CallingCode: "+248", Currency: "SCR"},
+	{Alpha2: "SD", Alpha3: "SDN", Numeric: "729", CommonName: "Sudan", OfficialName: "Republic of the Sudan", CallingCode: "+249", Currency: "SDG"},
+	{Alpha2: "SE", Alpha3: "SWE", Numeric: "752", CommonName: "Sweden", OfficialName: "Kingdom of Sweden", CallingCode: "+46", Currency: "SEK", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "SG", Alpha3: "SGP", Numeric: "702", CommonName: "Singapore", OfficialName: "Republic of Singapore", CallingCode: "+65", Currency: "SGD"},
+	{Alpha2: "SH", Alpha3: "SHN", Numeric: "654", CommonName: "Saint Helena, Ascension and Tristan da Cunha", OfficialName: "Saint Helena, Ascension and Tristan da Cunha", CallingCode: "+290", Currency: "SHP"},
+	{Alpha2: "SI", Alpha3: "SVN", Numeric: "705", CommonName: "Slovenia", OfficialName: "Republic of Slovenia", CallingCode: "+386", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "SJ", Alpha3: "SJM", Numeric: "744", CommonName: "Svalbard and Jan Mayen", OfficialName: "Svalbard and Jan Mayen", CallingCode: "+47", Currency: "NOK"},
+	{Alpha2: "SK", Alpha3: "SVK", Numeric: "703", CommonName: "Slovakia", OfficialName: "Slovak Republic", CallingCode: "+421", Currency: "EUR", EU: true, EEA: true, Schengen: true},
+	{Alpha2: "SL", Alpha3: "SLE", Numeric: "694", CommonName: "Sierra Leone", OfficialName: "Republic of Sierra Leone", CallingCode: "+232", Currency: "SLE"},
+	{Alpha2: "SM", Alpha3: "SMR", Numeric: "674", CommonName: "San Marino", OfficialName: "Republic of San Marino", CallingCode: "+378", Currency: "EUR"},
+	{Alpha2: "SN", Alpha3: "SEN", Numeric: "686", CommonName: "Senegal", OfficialName: "Republic of Senegal", CallingCode: "+221", Currency: "XOF"},
+	{Alpha2: "SO", Alpha3: "SOM", Numeric: "706", CommonName: "Somalia", OfficialName: "Federal Republic of Somalia", CallingCode: "+252", Currency: "SOS"},
+	{Alpha2: "SR", Alpha3: "SUR", Numeric: "740", CommonName: "Suriname", OfficialName: "Republic of Suriname", CallingCode: "+597", Currency: "SRD"},
+	{Alpha2: "SS", Alpha3: "SSD", Numeric: "728", CommonName: "South Sudan", OfficialName: "Republic of South Sudan", CallingCode: "+211", Currency: "SSP"},
+	{Alpha2: "ST", Alpha3: "STP", Numeric: "678", CommonName: "Sao Tome and Principe", OfficialName: "Democratic Republic of São Tomé and Príncipe", CallingCode: "+239", Currency: "STN"},
+	{Alpha2: "SV", Alpha3: "SLV", Numeric: "222", CommonName: "El Salvador", OfficialName: "Republic of El Salvador", CallingCode: "+503", Currency: "USD"},
+	{Alpha2: "SX", Alpha3: "SXM", Numeric: "534", CommonName: "Sint Maarten", OfficialName: "Sint Maarten", CallingCode: "+1", Currency: "XCG"},
+	{Alpha2: "SY", Alpha3: "SYR", Numeric: "760", CommonName: "Syria", OfficialName: "Syrian Arab Republic", CallingCode: "+963", Currency: "SYP"},
+	{Alpha2: "SZ", Alpha3: "SWZ", Numeric: "748", CommonName: "Eswatini", OfficialName: "Kingdom of Eswatini", CallingCode: "+268", Currency: "SZL"},
+	{Alpha2: "TC", Alpha3: "TCA", Numeric: "796", CommonName: "Turks and Caicos Islands", OfficialName: "Turks and Caicos Islands", CallingCode: "+1", Currency: "USD"},
+	{Alpha2: "TD", Alpha3: "TCD", Numeric: "148", CommonName: "Chad", OfficialName: "Republic of Chad", CallingCode: "+235", Currency: "XAF"},
+	{Alpha2: "TF", Alpha3: "ATF", Numeric: "260", CommonName: "French Southern and Antarctic Lands", OfficialName: "French Southern and Antarctic Lands", CallingCode: "+262", Currency: "EUR"},
+	{Alpha2: "TG", Alpha3: "TGO", Numeric: "768", CommonName: "Togo", OfficialName: "Togolese Republic", CallingCode: "+228", Currency: "XOF"},
+	{Alpha2: "TH", Alpha3: "THA", Numeric: "764", CommonName: "Thailand", OfficialName: "Kingdom of Thailand", CallingCode: "+66", Currency: "THB"},
+	{Alpha2: "TJ", Alpha3: "TJK", Numeric: "762", CommonName: "Tajikistan", OfficialName: "Republic of Tajikistan", CallingCode: "+992", Currency: "TJS"},
+	{Alpha2: "TK", Alpha3: "TKL", Numeric: "772", CommonName: "Tokelau", OfficialName: "Tokelau", CallingCode: "+690", Currency: "NZD"},
+	{Alpha2: "TL", Alpha3: "TLS", Numeric: "626", CommonName: "East Timor", OfficialName: "Democratic Republic of Timor-Leste", CallingCode: "+670", Currency: "USD"},
+	{Alpha2: "TM", Alpha3: "TKM", Numeric: "795", CommonName: "Turkmenistan", OfficialName: "Turkmenistan", CallingCode: "+993", Currency: "TMT"},
+	{Alpha2: "TN", Alpha3: "TUN", Numeric: "788", CommonName: "Tunisia", OfficialName: "Republic of Tunisia", CallingCode: "+216", Currency: "TND"},
+	{Alpha2: "TO", Alpha3: "TON", Numeric: "776", CommonName: "Tonga", OfficialName: "Kingdom of Tonga", CallingCode: "+676", Currency: "TOP"},
+	{Alpha2: "TR", Alpha3: "TUR", Numeric: "792", CommonName: "Turkey", OfficialName: "Republic of Türkiye", CallingCode: "+90", Currency: "TRY"},
+	{Alpha2: "TT", Alpha3: "TTO", Numeric: "780", CommonName: "Trinidad and Tobago", OfficialName: "Republic of Trinidad and Tobago", CallingCode: "+1", Currency: "TTD"},
+	{Alpha2: "TV", Alpha3: "TUV", Numeric: "798", CommonName: "Tuvalu", OfficialName: "Tuvalu", CallingCode: "+688", Currency: "AUD"},
+	{Alpha2: "TW", Alpha3: "TWN", Numeric: "158", CommonName: "Taiwan", OfficialName: "Taiwan", CallingCode: "+886", Currency: "TWD"},
+	{Alpha2: "TZ", Alpha3: "TZA", Numeric: "834", CommonName: "Tanzania", OfficialName: "United Republic of Tanzania", CallingCode: "+255", Currency: "TZS"},
+	{Alpha2: "UA", Alpha3: "UKR", Numeric: "804", CommonName: "Ukraine", OfficialName: "Ukraine", CallingCode: "+380", Currency: "UAH"},
+	{Alpha2: "UG", Alpha3: "UGA", Numeric: "800", CommonName: "Uganda", OfficialName: "Republic of Uganda", CallingCode: "+256", Currency: "UGX"},
+	{Alpha2: "UM", Alpha3: "UMI", Numeric: "581", CommonName: "United States Minor Outlying Islands", OfficialName: "United States Minor Outlying Islands", CallingCode: "+1", Currency: "USD"},
+	{Alpha2: "US", Alpha3: "USA", Numeric: "840", CommonName: "United States", OfficialName: "United States of America", CallingCode: "+1", Currency: "USD"},
+	{Alpha2: "UY", Alpha3: "URY", Numeric: "858", CommonName: "Uruguay", OfficialName: "Oriental Republic of Uruguay", CallingCode: "+598", Currency: "UYU"},
+	{Alpha2: "UZ", Alpha3: "UZB", Numeric: "860", CommonName: "Uzbekistan", OfficialName: "Republic of Uzbekistan", CallingCode: "+998", Currency: "UZS"},
+	{Alpha2: "VA", Alpha3: "VAT", Numeric: "336", CommonName: "Vatican City", OfficialName: "Vatican City State", CallingCode: "+39", Currency: "EUR"},
+	{Alpha2: "VC", Alpha3: "VCT", Numeric: "670", CommonName: "Saint Vincent and the Grenadines", OfficialName: "Saint Vincent and the Grenadines", CallingCode: "+1", Currency: "XCD"},
+	{Alpha2: "VE", Alpha3: "VEN", Numeric: "862", CommonName: "Venezuela", OfficialName: "Bolivarian Republic of Venezuela", CallingCode: "+58", Currency: "VES"},
+	{Alpha2: "VG", Alpha3: "VGB", Numeric: "092", CommonName: "British Virgin Islands", OfficialName: "Virgin Islands", CallingCode: "+1", Currency: "USD"},
+	{Alpha2: "VI", Alpha3: "VIR", Numeric: "850", CommonName: "United States Virgin Islands", OfficialName: "Virgin Islands of the United States", CallingCode: "+1", Currency: "USD"},
+	{Alpha2: "VN", Alpha3: "VNM", Numeric: "704", CommonName: "Vietnam", OfficialName: "Socialist Republic of Viet Nam", CallingCode: "+84", Currency: "VND"},
+	{Alpha2: "VU", Alpha3: "VUT", Numeric: "548", CommonName: "Vanuatu", OfficialName: "Republic of Vanuatu", CallingCode: "+678", Currency: "VUV"},
+	{Alpha2: "WF", Alpha3: "WLF", Numeric: "876", CommonName: "Wallis and Futuna", OfficialName: "Territory of the Wallis and Futuna Islands", CallingCode: "+681", Currency: "XPF"},
+	{Alpha2: "WS", Alpha3: "WSM", Numeric: "882", CommonName: "Samoa", OfficialName: "Independent State of Samoa", CallingCode: "+685", Currency: "WST"},
+	{Alpha2: "YE", Alpha3: "YEM", Numeric: "887", CommonName: "Yemen", OfficialName: "Republic of Yemen", CallingCode: "+967", Currency: "YER"},
+	{Alpha2: "YT", Alpha3: "MYT", Numeric: "175", CommonName: "Mayotte", OfficialName: "Department of Mayotte", CallingCode: "+262", Currency: "EUR"},
+	{Alpha2: "ZA", Alpha3: "ZAF", Numeric: "710", CommonName: "South Africa", OfficialName: "Republic of South Africa", CallingCode: "+27", Currency: "ZAR"},
+	{Alpha2: "ZM", Alpha3: "ZMB", Numeric: "894", CommonName: "Zambia", OfficialName: "Republic of Zambia", CallingCode: "+260", Currency: "ZMW"},
+	{Alpha2: "ZW", Alpha3: "ZWE", Numeric: "716", CommonName: "Zimbabwe", OfficialName: "Republic of Zimbabwe", CallingCode: "+263", Currency: "ZWG"},
+}
+
+// =====================================
+// =            SUBDIVISIONS           =
+// =====================================
+
+// Based on https://en.wikipedia.org/wiki/ISO_3166-2
+
+var subdivisionsBE = []Subdivision{
+	{Code: "BE-BRU", Name: "Brussels-Capital Region", Category: "region"},
+	{Code: "BE-VLG", Name: "Flemish Region", Category: "region"},
+	{Code: "BE-WAL", Name: "Walloon Region", Category: "region"},
+	{Code: "BE-VAN", Name: "Antwerpen", Category: "province", Parent: "BE-VLG"},
+	{Code: "BE-VBR", Name: "Vlaams-Brabant", Category: "province", Parent: "BE-VLG"},
+	{Code: "BE-VLI", Name: "Limburg", Category: "province", Parent: "BE-VLG"},
+	{Code: "BE-VOV", Name: "Oost-Vlaanderen", Category: "province", Parent: "BE-VLG"},
+	{Code: "BE-VWV", Name: "West-Vlaanderen", Category: "province", Parent: "BE-VLG"},
+	{Code: "BE-WBR", Name: "Brabant wallon", Category: "province", Parent: "BE-WAL"},
+	{Code: "BE-WHT", Name: "Hainaut", Category: "province", Parent: "BE-WAL"},
+	{Code: "BE-WLG", Name: "Liège", Category: "province", Parent: "BE-WAL"},
+	{Code: "BE-WLX", Name: "Luxembourg", Category: "province", Parent: "BE-WAL"},
+	{Code: "BE-WNA", Name: "Namur", Category: "province", Parent: "BE-WAL"},
+}
+
+var subdivisionsDE = []Subdivision{
+	{Code: "DE-BW", Name: "Baden-Württemberg", Category: "state"},
+	{Code: "DE-BY", Name: "Bayern", Category: "state"},
+	{Code: "DE-BE", Name: "Berlin", Category: "state"},
+	{Code: "DE-BB", Name: "Brandenburg", Category: "state"},
+	{Code: "DE-HB", Name: "Bremen", Category: "state"},
+	{Code: "DE-HH", Name: "Hamburg", Category: "state"},
+	{Code: "DE-HE", Name: "Hessen", Category: "state"},
+	{Code: "DE-MV", Name: "Mecklenburg-Vorpommern", Category: "state"},
+	{Code: "DE-NI", Name: "Niedersachsen", Category: "state"},
+	{Code: "DE-NW", Name: "Nordrhein-Westfalen", Category: "state"},
+	{Code: "DE-RP", Name: "Rheinland-Pfalz", Category: "state"},
+	{Code: "DE-SL", Name: "Saarland", Category: "state"},
+	{Code: "DE-SN", Name: "Sachsen", Category: "state"},
+	{Code: "DE-ST", Name: "Sachsen-Anhalt", Category: "state"},
+	{Code: "DE-SH", Name: "Schleswig-Holstein", Category: "state"},
+	{Code: "DE-TH", Name: "Thüringen", Category: "state"},
+}
+
+var subdivisionsFR = []Subdivision{
+	{Code: "FR-ARA", Name: "Auvergne-Rhône-Alpes", Category: "region"},
+	{Code: "FR-BFC", Name: "Bourgogne-Franche-Comté", Category: "region"},
+	{Code: "FR-BRE", Name: "Bretagne", Category: "region"},
+	{Code: "FR-CVL", Name: "Centre-Val de Loire", Category: "region"},
+	{Code: "FR-20R", Name: "Corse", Category: "region"},
+	{Code: "FR-GES", Name: "Grand-Est", Category: "region"},
+	{Code: "FR-HDF", Name: "Hauts-de-France", Category: "region"},
+	{Code: "FR-IDF", Name: "Île-de-France", Category: "region"},
+	{Code: "FR-NOR", Name: "Normandie", Category: "region"},
+	{Code: "FR-NAQ", Name: "Nouvelle-Aquitaine", Category: "region"},
+	{Code: "FR-OCC", Name: "Occitanie", Category: "region"},
+	{Code: "FR-PDL", Name: "Pays-de-la-Loire", Category: "region"},
+	{Code: "FR-PAC", Name: "Provence-Alpes-Côte-d'Azur", Category: "region"},
+}
+
+var subdivisionsLU = []Subdivision{
+	{Code: "LU-CA", Name: "Capellen", Category: "canton"},
+	{Code: "LU-CL", Name: "Clervaux", Category: "canton"},
+	{Code: "LU-DI", Name: "Diekirch", Category: "canton"},
+	{Code: "LU-EC", Name: "Echternach", Category: "canton"},
+	{Code: "LU-ES", Name: "Esch-sur-Alzette", Category: "canton"},
+	{Code: "LU-GR", Name: "Grevenmacher", Category: "canton"},
+	{Code: "LU-LU", Name: "Luxembourg", Category: "canton"},
+	{Code: "LU-ME", Name: "Mersch", Category: "canton"},
+	{Code: "LU-RD", Name: "Redange", Category: "canton"},
+	{Code: "LU-RM", Name: "Remich", Category: "canton"},
+	{Code: "LU-VD", Name: "Vianden", Category: "canton"},
+	{Code: "LU-WI", Name: "Wiltz", Category: "canton"},
+}
+
+var subdivisionsNL = []Subdivision{
+	{Code: "NL-DR", Name: "Drenthe", Category: "province"},
+	{Code: "NL-FL", Name: "Flevoland", Category: "province"},
+	{Code: "NL-FR", Name: "Fryslân", Category: "province"},
+	{Code: "NL-GE", Name: "Gelderland", Category: "province"},
+	{Code: "NL-GR", Name: "Groningen", Category: "province"},
+	{Code: "NL-LI", Name: "Limburg", Category: "province"},
+	{Code: "NL-NB", Name: "Noord-Brabant", Category: "province"},
+	{Code: "NL-NH", Name: "Noord-Holland", Category: "province"},
+	{Code: "NL-OV", Name: "Overijssel", Category: "province"},
+	{Code: "NL-UT", Name: "Utrecht", Category: "province"},
+	{Code: "NL-ZE", Name: "Zeeland", Category: "province"},
+	{Code: "NL-ZH", Name: "Zuid-Holland", Category: "province"},
+}
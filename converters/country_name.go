@@ -0,0 +1,234 @@
+package converters
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// SupportedCountryNameLanguages contains the languages in which country names are resolved
+var SupportedCountryNameLanguages = []string{"en", "fr", "nl", "de", "es", "it"}
+
+// countryNameAliases contains common names which are not covered by the localized names
+var countryNameAliases = map[string][]string{
+	"AE": {"UAE", "Emirates"},
+	"BA": {"Bosnia"},
+	"CD": {"DRC", "DR Congo", "Congo-Kinshasa"},
+	"CG": {"Congo-Brazzaville"},
+	"CI": {"Cote d'Ivoire"},
+	"CV": {"Cape Verde"},
+	"CZ": {"Czechia"},
+	"GB": {"UK", "U.K.", "Great Britain", "Britain", "England", "Scotland", "Wales", "Northern Ireland"},
+	"IE": {"Ireland", "Eire"},
+	"KP": {"DPRK"},
+	"KR": {"Korea"},
+	"LU": {"Letzebuerg"},
+	"MK": {"Macedonia", "FYROM"},
+	"MM": {"Burma"},
+	"NL": {"Holland", "The Netherlands"},
+	"SZ": {"Swaziland"},
+	"TR": {"Turkiye"},
+	"US": {"USA", "U.S.A.", "US", "U.S.", "America"},
+	"VA": {"Vatican", "Holy See"},
+}
+
+// countryNameIndex maps normalised names to alpha-2 codes.
+// Built on first use, since building requires the localized names of all countries.
+var countryNameIndex map[string]string
+var countryNameKeys []string
+var countryNameIndexOnce sync.Once
+
+func buildCountryNameIndex() {
+	countryNameIndex = map[string]string{}
+	add := func(name string, code string) {
+		for _, variant := range countryNameVariants(name) {
+			key, genErr := normaliseCountryName(variant)
+			if _, exists := countryNameIndex[key]; genErr == nil && key != "" && !exists {
+				// First match wins => Aliases and English names have priority
+				countryNameIndex[key] = code
+				countryNameKeys = append(countryNameKeys, key)
+			}
+		}
+	}
+
+	// Add aliases and English names
+	for _, country := range countries {
+		for _, alias := range countryNameAliases[country.Alpha2] {
+			add(alias, country.Alpha2)
+		}
+	}
+	for _, country := range countries {
+		add(country.CommonName, country.Alpha2)
+		add(country.OfficialName, country.Alpha2)
+	}
+
+	// Add localized names
+	for _, lang := range SupportedCountryNameLanguages {
+		namer := display.Regions(language.MustParse(lang))
+		for _, country := range countries {
+			add(namer.Name(language.MustParseRegion(country.Alpha2)), country.Alpha2)
+		}
+	}
+}
+
+// countryNameVariants returns the name and, if the name contains a qualifier like
+// "Myanmar (Burma)" or "Congo - Kinshasa", the parts of the name as well.
+func countryNameVariants(name string) []string {
+	variants := []string{name}
+	for _, separator := range []string{" (", " - "} {
+		if parts := strings.SplitN(name, separator, 2); len(parts) == 2 {
+			variants = append(variants, parts[0], strings.TrimSuffix(parts[1], ")"))
+		}
+	}
+	return variants
+}
+
+// normaliseCountryName removes accents, casing and punctuation from a country name
+//
+// Raises
+//
+// - 400/failed_to_normalise_string: Provided country name could not be normalised
+func normaliseCountryName(name string) (string, *errors.GenericError) {
+	normalised, genErr := NormaliseString(name)
+	if genErr != nil {
+		return "", genErr
+	}
+	words := strings.FieldsFunc(strings.ToLower(normalised), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " "), nil
+}
+
+// lookupCountryName searches the code for a normalised country name
+func lookupCountryName(normalisedName string) (string, bool) {
+	countryNameIndexOnce.Do(buildCountryNameIndex)
+	code, exists := countryNameIndex[normalisedName]
+	return code, exists
+}
+
+// CountryCodeToCountryNameLocalized converts a country code to a country's name in the provided language.
+// For English, the result is equal to CountryCodeToCountryName.
+// See SupportedCountryNameLanguages for the supported languages.
+//
+// Raises
+//
+// - 400/unsupported_language: Provided language is not supported
+//
+// - 404/country_not_found: Provided country code was not found
+func CountryCodeToCountryNameLocalized(countryCode string, lang string) (string, *errors.GenericError) {
+	// Validate language
+	tag, err := language.Parse(lang)
+	base, _ := tag.Base()
+	if err != nil || !isSupportedCountryNameLanguage(base.String()) {
+		meta := map[string]string{"language": lang}
+		return "", errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorUnsupportedLanguage, meta)
+	}
+
+	// Fetch country
+	name, genErr := CountryCodeToCountryName(countryCode)
+	if genErr != nil || base.String() == "en" {
+		return name, genErr
+	}
+
+	// Translate name
+	return display.Regions(language.Make(base.String())).Name(language.MustParseRegion(countryCode)), nil
+}
+
+// CountryNameToCountryCodeFuzzy converts a country name to a country's code, allowing typos.
+// The name is matched against the names in all SupportedCountryNameLanguages and common aliases.
+// Returns the best match together with a confidence between 0 and 1 (1 being an exact match).
+//
+// Raises
+//
+// - 400/failed_to_normalise_string: Provided country name could not be normalised
+//
+// - 404/country_not_found: No country found with a confidence of at least minConfidence
+func CountryNameToCountryCodeFuzzy(countryName string, minConfidence float64) (string, float64, *errors.GenericError) {
+	// Check for exact match
+	cleanName, genErr := normaliseCountryName(countryName)
+	if genErr != nil {
+		return "", 0, genErr
+	}
+	if code, exists := lookupCountryName(cleanName); exists {
+		return code, 1, nil
+	}
+
+	// Search closest match
+	bestKey := ""
+	bestConfidence := 0.0
+	if cleanName != "" {
+		for _, key := range countryNameKeys {
+			confidence := stringSimilarity(cleanName, key)
+			if confidence > bestConfidence {
+				bestKey = key
+				bestConfidence = confidence
+			}
+		}
+	}
+
+	// Validate confidence
+	if bestKey == "" || bestConfidence < minConfidence {
+		meta := map[string]string{"name": countryName}
+		return "", bestConfidence, errors.NewGenericError(404, errorDomain, errorSubDomain, ErrorCountryNotFound, meta)
+	}
+	return countryNameIndex[bestKey], bestConfidence, nil
+}
+
+func isSupportedCountryNameLanguage(lang string) bool {
+	for _, supported := range SupportedCountryNameLanguages {
+		if lang == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// stringSimilarity returns 1 minus the Levenshtein distance relative to the longest string
+func stringSimilarity(a string, b string) float64 {
+	runesA := []rune(a)
+	runesB := []rune(b)
+	maxLength := len(runesA)
+	if len(runesB) > maxLength {
+		maxLength = len(runesB)
+	}
+	if maxLength == 0 {
+		return 1
+	}
+	return 1 - float64(levenshteinDistance(runesA, runesB))/float64(maxLength)
+}
+
+// levenshteinDistance returns the minimum number of single character
+// insertions, deletions and substitutions to change a into b.
+func levenshteinDistance(a []rune, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+	return result
+}
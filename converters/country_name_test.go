@@ -0,0 +1,88 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CountryNameToCountryCode_Localized_Success(t *testing.T) {
+	tests := map[string]string{
+		"Belgique":      "BE",
+		"België":        "BE",
+		"Belgien":       "BE",
+		"Bélgica":       "BE",
+		"Duitsland":     "DE",
+		"Allemagne":     "DE",
+		"Pays-Bas":      "NL",
+		"UK":            "GB",
+		"U.S.A.":        "US",
+		"Holland":       "NL",
+		"Cote d'Ivoire": "CI",
+		"Côte d’Ivoire": "CI",
+	}
+
+	for name, expected := range tests {
+		result, genErr := CountryNameToCountryCode(name)
+		require.Nil(t, genErr, name)
+		assert.Equal(t, expected, result, name)
+	}
+}
+
+func Test_CountryCodeToCountryNameLocalized_Success(t *testing.T) {
+	tests := []struct {
+		lang     string
+		expected string
+	}{
+		{"en", "Belgium"},
+		{"fr", "Belgique"},
+		{"nl", "België"},
+		{"nl-BE", "België"},
+		{"de", "Belgien"},
+		{"es", "Bélgica"},
+		{"it", "Belgio"},
+	}
+
+	for _, test := range tests {
+		result, genErr := CountryCodeToCountryNameLocalized("BE", test.lang)
+		require.Nil(t, genErr, test.lang)
+		assert.Equal(t, test.expected, result, test.lang)
+	}
+}
+
+func Test_CountryCodeToCountryNameLocalized_UnsupportedLanguage_Failure(t *testing.T) {
+	for _, lang := range []string{"pt", "invalid!", ""} {
+		result, genErr := CountryCodeToCountryNameLocalized("BE", lang)
+		assert.Equal(t, "", result)
+		errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedLanguage, map[string]string{"language": lang})
+	}
+}
+
+func Test_CountryCodeToCountryNameLocalized_CountryNotFound_Failure(t *testing.T) {
+	result, genErr := CountryCodeToCountryNameLocalized("XX", "fr")
+	assert.Equal(t, "", result)
+	errors.AssertGenericError(t, genErr, 404, ErrorCountryNotFound, nil)
+}
+
+func Test_CountryNameToCountryCodeFuzzy_Exact_Success(t *testing.T) {
+	code, confidence, genErr := CountryNameToCountryCodeFuzzy("belgië", 0.8)
+	require.Nil(t, genErr)
+	assert.Equal(t, "BE", code)
+	assert.Equal(t, 1.0, confidence)
+}
+
+func Test_CountryNameToCountryCodeFuzzy_Typo_Success(t *testing.T) {
+	code, confidence, genErr := CountryNameToCountryCodeFuzzy("Belgum", 0.8)
+	require.Nil(t, genErr)
+	assert.Equal(t, "BE", code)
+	assert.True(t, confidence >= 0.8 && confidence < 1)
+}
+
+func Test_CountryNameToCountryCodeFuzzy_Failure(t *testing.T) {
+	code, confidence, genErr := CountryNameToCountryCodeFuzzy("Invalid", 0.8)
+	assert.Equal(t, "", code)
+	assert.True(t, confidence < 0.8)
+	errors.AssertGenericError(t, genErr, 404, ErrorCountryNotFound, map[string]string{"name": "Invalid"})
+}
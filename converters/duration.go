@@ -0,0 +1,225 @@
+package converters
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"golang.org/x/text/language"
+)
+
+// isoDurationRegex matches ISO 8601 durations like P1DT2H30M or -PT0.5S.
+// Years and months are matched to provide a clear error, since their length is ambiguous.
+var isoDurationRegex = regexp.MustCompile(`^([-+])?P(?:(\d+(?:[.,]\d+)?)Y)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)W)?(?:(\d+(?:[.,]\d+)?)D)?(?:T(?:(\d+(?:[.,]\d+)?)H)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// isoDurationUnits contains the length of the units matched by isoDurationRegex, starting from weeks
+var isoDurationUnits = []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+// durationUnit contains the abbreviations of the units for the human readable format of a duration
+type durationUnit struct {
+	day    string
+	hour   string
+	minute string
+	second string
+}
+
+// durationUnits contains the abbreviations of the supported languages
+var durationUnits = map[string]durationUnit{
+	"en": {day: "d", hour: "h", minute: "min", second: "s"},
+	"fr": {day: "j", hour: "h", minute: "min", second: "s"},
+	"nl": {day: "d", hour: "u", minute: "min", second: "s"},
+	"de": {day: "T", hour: "Std.", minute: "Min.", second: "Sek."},
+	"es": {day: "d", hour: "h", minute: "min", second: "s"},
+	"it": {day: "g", hour: "h", minute: "min", second: "s"},
+}
+
+// ParseISODuration parses an ISO 8601 duration (e.g. P1DT2H, PT90M, -PT0.5S, P2W).
+// A day is considered to be 24 hours. Years and months are not supported, since their length is ambiguous.
+//
+// Raises
+//
+// - 400/invalid_duration: Provided duration is not a valid ISO 8601 duration or contains years or months
+func ParseISODuration(input string) (time.Duration, *errors.GenericError) {
+	// Parse input
+	meta := map[string]string{"duration": input}
+	matches := isoDurationRegex.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(input)))
+	if matches == nil || strings.HasSuffix(matches[0], "P") || strings.HasSuffix(matches[0], "T") {
+		return 0, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidDuration, meta)
+	}
+	if matches[2] != "" || matches[3] != "" {
+		return 0, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidDuration, meta)
+	}
+
+	// Sum components
+	total := 0.0
+	for i, unit := range isoDurationUnits {
+		if component := matches[i+4]; component != "" {
+			value, _ := strconv.ParseFloat(strings.Replace(component, ",", ".", 1), 64)
+			total += value * float64(unit)
+		}
+	}
+	if total >= math.MaxInt64 { // float64(math.MaxInt64) is rounded to 2^63
+		return 0, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidDuration, meta)
+	}
+
+	// Apply sign
+	result := time.Duration(math.Round(total))
+	if matches[1] == "-" {
+		result = -result
+	}
+	return result, nil
+}
+
+// FormatISODuration formats the duration as ISO 8601 duration (e.g. P1DT2H30M).
+// A day is considered to be 24 hours. Zero is formatted as PT0S.
+func FormatISODuration(duration time.Duration) string {
+	// Handle special cases
+	if duration == 0 {
+		return "PT0S"
+	}
+	sign := ""
+	if duration < 0 {
+		sign = "-"
+	}
+
+	// Split duration
+	remaining := uint64(duration)
+	if duration < 0 {
+		remaining = uint64(-duration)
+	}
+	days := remaining / uint64(24*time.Hour)
+	remaining %= uint64(24 * time.Hour)
+	hours := remaining / uint64(time.Hour)
+	remaining %= uint64(time.Hour)
+	minutes := remaining / uint64(time.Minute)
+	nanos := remaining % uint64(time.Minute)
+
+	// Build result
+	var builder strings.Builder
+	builder.WriteString(sign + "P")
+	if days > 0 {
+		builder.WriteString(fmt.Sprintf("%dD", days))
+	}
+	if hours > 0 || minutes > 0 || nanos > 0 {
+		builder.WriteString("T")
+	}
+	if hours > 0 {
+		builder.WriteString(fmt.Sprintf("%dH", hours))
+	}
+	if minutes > 0 {
+		builder.WriteString(fmt.Sprintf("%dM", minutes))
+	}
+	if nanos > 0 {
+		seconds := fmt.Sprintf("%d.%09d", nanos/uint64(time.Second), nanos%uint64(time.Second))
+		builder.WriteString(strings.TrimRight(strings.TrimRight(seconds, "0"), ".") + "S")
+	}
+	return builder.String()
+}
+
+// FormatDuration formats the duration in a human readable way for the provided locale (e.g. "1 d 2 h 30 min").
+// Durations of at least a minute are rounded to minutes. Supported languages are en, fr, nl, de, es and it.
+// Spaces in the result are non-breaking spaces.
+//
+// Raises
+//
+// - 400/unsupported_locale: Provided locale is not supported
+func FormatDuration(duration time.Duration, locale string) (string, *errors.GenericError) {
+	// Fetch units
+	if _, genErr := GetNumberFormat(locale); genErr != nil {
+		return "", genErr
+	}
+	tag, _ := language.Parse(locale)
+	base, _ := tag.Base()
+	units := durationUnits[base.String()]
+
+	// Handle sign
+	sign := ""
+	if duration < 0 {
+		sign = "-"
+		duration = -duration
+	}
+
+	// Handle durations below a minute
+	if duration < time.Minute {
+		seconds, _ := formatLocalizedNumber(duration.Seconds(), 0, locale)
+		if seconds == "0" {
+			sign = ""
+		}
+		return sign + seconds + "\u00a0" + units.second, nil
+	}
+
+	// Split duration
+	duration = duration.Round(time.Minute)
+	parts := []string{}
+	if days := duration / (24 * time.Hour); days > 0 {
+		parts = append(parts, fmt.Sprintf("%d\u00a0%s", days, units.day))
+	}
+	if hours := (duration % (24 * time.Hour)) / time.Hour; hours > 0 {
+		parts = append(parts, fmt.Sprintf("%d\u00a0%s", hours, units.hour))
+	}
+	if minutes := (duration % time.Hour) / time.Minute; minutes > 0 {
+		parts = append(parts, fmt.Sprintf("%d\u00a0%s", minutes, units.minute))
+	}
+	return sign + strings.Join(parts, "\u00a0"), nil
+}
+
+// ISODuration is a time.Duration which is (un)marshalled as ISO 8601 duration (e.g. "PT1H30M")
+// in JSON and BSON. See ParseISODuration for the supported notations.
+type ISODuration time.Duration
+
+// String formats the duration as ISO 8601 duration
+func (d ISODuration) String() string {
+	return FormatISODuration(time.Duration(d))
+}
+
+// MarshalJSON encodes the duration as ISO 8601 string
+func (d ISODuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes the duration from an ISO 8601 string. Null is ignored.
+func (d *ISODuration) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+	var input string
+	if err := json.Unmarshal(data, &input); err != nil {
+		return err
+	}
+	return d.setParsed(input)
+}
+
+// MarshalBSONValue encodes the duration as ISO 8601 string
+func (d ISODuration) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bsontype.String, bsoncore.AppendString(nil, d.String()), nil
+}
+
+// UnmarshalBSONValue decodes the duration from an ISO 8601 string. Null is ignored.
+func (d *ISODuration) UnmarshalBSONValue(dataType bsontype.Type, data []byte) error {
+	if dataType == bsontype.Null {
+		return nil
+	}
+	input, ok := bson.RawValue{Type: dataType, Value: data}.StringValueOK()
+	if !ok {
+		meta := map[string]string{"type": dataType.String()}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidDuration, meta)
+	}
+	return d.setParsed(input)
+}
+
+func (d *ISODuration) setParsed(input string) error {
+	duration, genErr := ParseISODuration(input)
+	if genErr != nil {
+		return genErr
+	}
+	*d = ISODuration(duration)
+	return nil
+}
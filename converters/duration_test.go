@@ -0,0 +1,142 @@
+package converters
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func Test_ParseISODuration_Success(t *testing.T) {
+	tests := map[string]time.Duration{
+		"P1DT2H":     26 * time.Hour,
+		"PT90M":      90 * time.Minute,
+		"PT1H30M15S": time.Hour + 30*time.Minute + 15*time.Second,
+		"P2W":        14 * 24 * time.Hour,
+		"pt0.5s":     500 * time.Millisecond,
+		"PT1,5H":     90 * time.Minute,
+		"-P1D":       -24 * time.Hour,
+		"PT0S":       0,
+	}
+
+	for input, expected := range tests {
+		result, genErr := ParseISODuration(input)
+		require.Nil(t, genErr, input)
+		assert.Equal(t, expected, result, input)
+	}
+}
+
+func Test_ParseISODuration_InvalidDuration_Failure(t *testing.T) {
+	inputs := []string{"", "P", "PT", "P1Y", "P1M", "1H", "PT1D", "P1H", "PT1H30", "P99999999999999D", "PT9223372036.854775808S"}
+
+	for _, input := range inputs {
+		_, genErr := ParseISODuration(input)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidDuration, map[string]string{"duration": input})
+	}
+}
+
+func Test_FormatISODuration_Success(t *testing.T) {
+	tests := map[time.Duration]string{
+		0:                             "PT0S",
+		26 * time.Hour:                "P1DT2H",
+		90 * time.Minute:              "PT1H30M",
+		48 * time.Hour:                "P2D",
+		1500 * time.Millisecond:       "PT1.5S",
+		-(time.Hour + 15*time.Second): "-PT1H15S",
+		time.Minute + time.Nanosecond: "PT1M0.000000001S",
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, FormatISODuration(input))
+	}
+}
+
+func Test_FormatDuration_Success(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		locale   string
+		expected string
+	}{
+		{26*time.Hour + 30*time.Minute, "en", "1 d 2 h 30 min"},
+		{90*time.Minute + 40*time.Second, "nl-BE", "1 u 31 min"},
+		{2 * time.Hour, "de", "2 Std."},
+		{45 * time.Second, "fr", "45 s"},
+		{-5 * time.Minute, "en", "-5 min"},
+		{0, "en", "0 s"},
+	}
+
+	for _, test := range tests {
+		result, genErr := FormatDuration(test.duration, test.locale)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.expected, result)
+	}
+}
+
+func Test_FormatDuration_UnsupportedLocale_Failure(t *testing.T) {
+	_, genErr := FormatDuration(time.Hour, "xx")
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedLocale, map[string]string{"locale": "xx"})
+}
+
+func Test_ISODuration_JSON_Success(t *testing.T) {
+	type booking struct {
+		Duration ISODuration `json:"duration"`
+	}
+	input := booking{Duration: ISODuration(26 * time.Hour)}
+
+	data, err := json.Marshal(input)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"duration": "P1DT2H"}`, string(data))
+
+	var result booking
+	require.Nil(t, json.Unmarshal(data, &result))
+	assert.Equal(t, input, result)
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"duration": "P1M"}`), &result))
+	assert.NotNil(t, json.Unmarshal([]byte(`{"duration": 3600}`), &result))
+}
+
+func Test_ISODuration_BSON_Success(t *testing.T) {
+	type booking struct {
+		Duration ISODuration `bson:"duration"`
+	}
+	input := booking{Duration: ISODuration(90 * time.Minute)}
+
+	data, err := bson.Marshal(input)
+	require.Nil(t, err)
+	var raw bson.M
+	require.Nil(t, bson.Unmarshal(data, &raw))
+	assert.Equal(t, "PT1H30M", raw["duration"])
+
+	var result booking
+	require.Nil(t, bson.Unmarshal(data, &result))
+	assert.Equal(t, input, result)
+
+	data, err = bson.Marshal(bson.M{"duration": 3600})
+	require.Nil(t, err)
+	assert.NotNil(t, bson.Unmarshal(data, &result))
+}
+
+func Test_ISODuration_ZeroAndNull_Success(t *testing.T) {
+	type booking struct {
+		Duration ISODuration `json:"duration" bson:"duration"`
+	}
+
+	// JSON
+	data, err := json.Marshal(booking{})
+	require.Nil(t, err)
+	var result booking
+	require.Nil(t, json.Unmarshal(data, &result))
+	assert.Equal(t, booking{}, result)
+	require.Nil(t, json.Unmarshal([]byte(`{"duration": null}`), &result))
+	assert.Equal(t, booking{}, result)
+
+	// BSON
+	data, err = bson.Marshal(bson.M{"duration": nil})
+	require.Nil(t, err)
+	require.Nil(t, bson.Unmarshal(data, &result))
+	assert.Equal(t, booking{}, result)
+}
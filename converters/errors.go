@@ -6,6 +6,12 @@ const errorSubDomain = "converters"
 // ErrorCountryNotFound indicates the specified country is not found.
 const ErrorCountryNotFound = "country_not_found"
 
+// ErrorSubdivisionNotFound indicates the specified subdivision of a country is not found.
+const ErrorSubdivisionNotFound = "subdivision_not_found"
+
+// ErrorUnsupportedLanguage indicates the requested language is not supported.
+const ErrorUnsupportedLanguage = "unsupported_language"
+
 // ErrorInputIsNotPointer indicates a pointer was expected as input,
 // but the provided input is not a pointer.
 const ErrorInputIsNotPointer = "input_is_not_a_pointer"
@@ -17,3 +23,52 @@ const ErrorPanicDuringSanitizeObject = "panic_during_sanitize_object"
 // ErrorFailedToNormaliseString indicates we failed to normalise the
 // provided string. More info is printed in the logs.
 const ErrorFailedToNormaliseString = "failed_to_normalise_string"
+
+// ErrorUnknownSanitizePolicy indicates the requested sanitization policy is not registered.
+const ErrorUnknownSanitizePolicy = "unknown_sanitize_policy"
+
+// ErrorSanitizeKeyCollision indicates multiple keys of a map are equal after sanitation.
+const ErrorSanitizeKeyCollision = "sanitize_key_collision"
+
+// ErrorEmptySlug indicates the generated slug is empty.
+const ErrorEmptySlug = "empty_slug"
+
+// ErrorSlugNotUnique indicates no unused slug could be generated.
+const ErrorSlugNotUnique = "slug_not_unique"
+
+// ErrorInvalidPostalCode indicates the postal code is not valid for the country.
+const ErrorInvalidPostalCode = "invalid_postal_code"
+
+// ErrorInvalidAddress indicates the address could not be parsed.
+const ErrorInvalidAddress = "invalid_address"
+
+// ErrorInvalidCountryCode indicates the provided country code is invalid or missing.
+const ErrorInvalidCountryCode = "invalid_country_code"
+
+// ErrorNotAPhoneNumber indicates the provided phone number is not recognised as one.
+const ErrorNotAPhoneNumber = "not_a_phone_number"
+
+// ErrorInvalidPhoneNumber indicates the provided phone number has the correct format,
+// but is semantically incorrect.
+const ErrorInvalidPhoneNumber = "invalid_phone_number"
+
+// ErrorUnsupportedPhoneNumberFormat indicates the requested phone number format is not supported.
+const ErrorUnsupportedPhoneNumberFormat = "unsupported_phone_number_format"
+
+// ErrorUnsupportedUnit indicates the provided unit is not supported.
+const ErrorUnsupportedUnit = "unsupported_unit"
+
+// ErrorUnsupportedLocale indicates the provided locale is not supported.
+const ErrorUnsupportedLocale = "unsupported_locale"
+
+// ErrorInvalidDuration indicates the provided duration is invalid.
+const ErrorInvalidDuration = "invalid_duration"
+
+// ErrorInvalidCoordinate indicates the latitude or longitude of the provided coordinate is out of range.
+const ErrorInvalidCoordinate = "invalid_coordinate"
+
+// ErrorInvalidGeoJSON indicates the provided GeoJSON is invalid or contains unsupported geometries.
+const ErrorInvalidGeoJSON = "invalid_geojson"
+
+// ErrorCountryBoundariesNotLoaded indicates the loaded country boundaries don't contain any country.
+const ErrorCountryBoundariesNotLoaded = "country_boundaries_not_loaded"
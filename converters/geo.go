@@ -0,0 +1,432 @@
+package converters
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// earthRadius is the mean radius of the earth in meters
+const earthRadius = 6371008.8
+
+// Coordinate is a WGS 84 position in decimal degrees
+type Coordinate struct {
+	Latitude  float64 `json:"latitude" bson:"latitude"`
+	Longitude float64 `json:"longitude" bson:"longitude"`
+}
+
+// BoundingBox is the rectangle between the south west and north east corner.
+// Boxes crossing the antimeridian are not supported.
+type BoundingBox struct {
+	SouthWest Coordinate `json:"south_west" bson:"south_west"`
+	NorthEast Coordinate `json:"north_east" bson:"north_east"`
+}
+
+// Polygon is a list of closed rings. The first ring is the exterior, the other rings are holes.
+type Polygon [][]Coordinate
+
+// Geofence is an area consisting of one or more polygons
+type Geofence struct {
+	// Polygons contains the areas of the geofence
+	Polygons []Polygon
+
+	// Properties contains the properties of the GeoJSON feature.
+	// Nil if the geofence was parsed from a geometry.
+	Properties map[string]interface{}
+}
+
+// IsValid returns true if the latitude is between -90 and 90 and the longitude between -180 and 180
+func (c Coordinate) IsValid() bool {
+	return c.Latitude >= -90 && c.Latitude <= 90 && c.Longitude >= -180 && c.Longitude <= 180
+}
+
+// DistanceTo returns the great-circle distance in meters to the other coordinate, using the haversine formula
+func (c Coordinate) DistanceTo(other Coordinate) Distance {
+	lat1 := c.Latitude * math.Pi / 180
+	lat2 := other.Latitude * math.Pi / 180
+	deltaLat := lat2 - lat1
+	deltaLng := (other.Longitude - c.Longitude) * math.Pi / 180
+	a := math.Pow(math.Sin(deltaLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(deltaLng/2), 2)
+	return Distance{Value: 2 * earthRadius * math.Asin(math.Sqrt(a)), Unit: DistanceUnitMeter}
+}
+
+// NewBoundingBox returns the smallest bounding box containing all coordinates
+func NewBoundingBox(coordinates ...Coordinate) BoundingBox {
+	if len(coordinates) == 0 {
+		return BoundingBox{}
+	}
+	box := BoundingBox{SouthWest: coordinates[0], NorthEast: coordinates[0]}
+	for _, coordinate := range coordinates[1:] {
+		box.SouthWest.Latitude = math.Min(box.SouthWest.Latitude, coordinate.Latitude)
+		box.SouthWest.Longitude = math.Min(box.SouthWest.Longitude, coordinate.Longitude)
+		box.NorthEast.Latitude = math.Max(box.NorthEast.Latitude, coordinate.Latitude)
+		box.NorthEast.Longitude = math.Max(box.NorthEast.Longitude, coordinate.Longitude)
+	}
+	return box
+}
+
+// BoundingBoxAround returns the bounding box containing all coordinates within the radius of the center.
+// Useful to prefilter coordinates in a database before calculating the exact distance.
+//
+// Raises
+//
+// - 400/unsupported_unit: Unit of the radius is not supported
+func BoundingBoxAround(center Coordinate, radius Distance) (BoundingBox, *errors.GenericError) {
+	meters, genErr := radius.In(DistanceUnitMeter)
+	if genErr != nil {
+		return BoundingBox{}, genErr
+	}
+	deltaLat := meters / earthRadius * 180 / math.Pi
+	deltaLng := 180.0
+	if cos := math.Cos(center.Latitude * math.Pi / 180); cos > 0 {
+		deltaLng = math.Min(deltaLat/cos, 180)
+	}
+	return BoundingBox{
+		SouthWest: Coordinate{Latitude: math.Max(center.Latitude-deltaLat, -90), Longitude: math.Max(center.Longitude-deltaLng, -180)},
+		NorthEast: Coordinate{Latitude: math.Min(center.Latitude+deltaLat, 90), Longitude: math.Min(center.Longitude+deltaLng, 180)},
+	}, nil
+}
+
+// Contains returns true if the coordinate is inside or on the edge of the bounding box
+func (b BoundingBox) Contains(coordinate Coordinate) bool {
+	return coordinate.Latitude >= b.SouthWest.Latitude && coordinate.Latitude <= b.NorthEast.Latitude &&
+		coordinate.Longitude >= b.SouthWest.Longitude && coordinate.Longitude <= b.NorthEast.Longitude
+}
+
+// Contains returns true if the coordinate is inside the exterior ring and outside the holes.
+// Coordinates are treated as planar, which is accurate enough for geofences of a few hundred kilometers.
+func (p Polygon) Contains(coordinate Coordinate) bool {
+	for i, ring := range p {
+		if ringContains(ring, coordinate) != (i == 0) {
+			return false
+		}
+	}
+	return len(p) > 0
+}
+
+// ringContains checks if the coordinate is inside the ring using ray casting
+func ringContains(ring []Coordinate, coordinate Coordinate) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Latitude > coordinate.Latitude) != (b.Latitude > coordinate.Latitude) {
+			crossing := (b.Longitude-a.Longitude)*(coordinate.Latitude-a.Latitude)/(b.Latitude-a.Latitude) + a.Longitude
+			if coordinate.Longitude < crossing {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// Contains returns true if the coordinate is inside one of the polygons of the geofence
+func (g Geofence) Contains(coordinate Coordinate) bool {
+	for _, polygon := range g.Polygons {
+		if polygon.Contains(coordinate) {
+			return true
+		}
+	}
+	return false
+}
+
+// BoundingBox returns the smallest bounding box containing the geofence
+func (g Geofence) BoundingBox() BoundingBox {
+	coordinates := []Coordinate{}
+	for _, polygon := range g.Polygons {
+		if len(polygon) > 0 {
+			coordinates = append(coordinates, polygon[0]...)
+		}
+	}
+	return NewBoundingBox(coordinates...)
+}
+
+// geoJSONObject contains the fields of all supported GeoJSON objects
+type geoJSONObject struct {
+	Type        string                 `json:"type"`
+	Coordinates json.RawMessage        `json:"coordinates"`
+	Geometry    *geoJSONObject         `json:"geometry"`
+	Features    []geoJSONObject        `json:"features"`
+	Properties  map[string]interface{} `json:"properties"`
+}
+
+// ParseGeofences converts a GeoJSON (RFC 7946) object to geofences.
+// Each feature of a FeatureCollection becomes a geofence. A Feature or geometry results in a single geofence.
+// Only Polygon and MultiPolygon geometries are supported.
+//
+// Raises
+//
+// - 400/invalid_geojson: Provided GeoJSON is invalid or contains unsupported geometries
+func ParseGeofences(geoJSON []byte) ([]Geofence, *errors.GenericError) {
+	var object geoJSONObject
+	if err := json.Unmarshal(geoJSON, &object); err != nil {
+		return nil, invalidGeoJSONError(err.Error())
+	}
+
+	switch object.Type {
+	case "FeatureCollection":
+		geofences := make([]Geofence, 0, len(object.Features))
+		for _, feature := range object.Features {
+			geofence, genErr := parseGeoJSONFeature(feature)
+			if genErr != nil {
+				return nil, genErr
+			}
+			geofences = append(geofences, geofence)
+		}
+		return geofences, nil
+	case "Feature":
+		geofence, genErr := parseGeoJSONFeature(object)
+		if genErr != nil {
+			return nil, genErr
+		}
+		return []Geofence{geofence}, nil
+	default:
+		polygons, genErr := parseGeoJSONGeometry(object)
+		if genErr != nil {
+			return nil, genErr
+		}
+		return []Geofence{{Polygons: polygons}}, nil
+	}
+}
+
+// parseGeoJSONFeature converts a GeoJSON feature to a geofence
+//
+// Raises
+//
+// - 400/invalid_geojson: Provided feature is invalid or contains an unsupported geometry
+func parseGeoJSONFeature(feature geoJSONObject) (Geofence, *errors.GenericError) {
+	if feature.Type != "Feature" || feature.Geometry == nil {
+		return Geofence{}, invalidGeoJSONError("expected feature with geometry")
+	}
+	polygons, genErr := parseGeoJSONGeometry(*feature.Geometry)
+	if genErr != nil {
+		return Geofence{}, genErr
+	}
+	return Geofence{Polygons: polygons, Properties: feature.Properties}, nil
+}
+
+// parseGeoJSONGeometry converts a Polygon or MultiPolygon geometry to polygons
+//
+// Raises
+//
+// - 400/invalid_geojson: Provided geometry is invalid or not supported
+func parseGeoJSONGeometry(geometry geoJSONObject) ([]Polygon, *errors.GenericError) {
+	// Parse coordinates
+	var positions [][][][]float64
+	switch geometry.Type {
+	case "Polygon":
+		var polygon [][][]float64
+		if err := json.Unmarshal(geometry.Coordinates, &polygon); err != nil {
+			return nil, invalidGeoJSONError(err.Error())
+		}
+		positions = [][][][]float64{polygon}
+	case "MultiPolygon":
+		if err := json.Unmarshal(geometry.Coordinates, &positions); err != nil {
+			return nil, invalidGeoJSONError(err.Error())
+		}
+	default:
+		return nil, invalidGeoJSONError("unsupported geometry type " + strconv.Quote(geometry.Type))
+	}
+
+	// Convert positions
+	polygons := make([]Polygon, 0, len(positions))
+	for _, polygonPositions := range positions {
+		if len(polygonPositions) == 0 {
+			return nil, invalidGeoJSONError("polygon without rings")
+		}
+		polygon := make(Polygon, 0, len(polygonPositions))
+		for _, ringPositions := range polygonPositions {
+			ring, genErr := parseGeoJSONRing(ringPositions)
+			if genErr != nil {
+				return nil, genErr
+			}
+			polygon = append(polygon, ring)
+		}
+		polygons = append(polygons, polygon)
+	}
+	return polygons, nil
+}
+
+// parseGeoJSONRing converts the [longitude, latitude] positions of a ring to coordinates
+//
+// Raises
+//
+// - 400/invalid_geojson: Ring is not closed, has less than 4 positions or contains invalid positions
+func parseGeoJSONRing(positions [][]float64) ([]Coordinate, *errors.GenericError) {
+	if len(positions) < 4 {
+		return nil, invalidGeoJSONError("ring should have at least 4 positions")
+	}
+	ring := make([]Coordinate, 0, len(positions))
+	for _, position := range positions {
+		if len(position) < 2 {
+			return nil, invalidGeoJSONError("position should have a longitude and latitude")
+		}
+		coordinate := Coordinate{Latitude: position[1], Longitude: position[0]}
+		if !coordinate.IsValid() {
+			return nil, invalidGeoJSONError("position out of range")
+		}
+		ring = append(ring, coordinate)
+	}
+	if ring[0] != ring[len(ring)-1] {
+		return nil, invalidGeoJSONError("ring is not closed")
+	}
+	return ring, nil
+}
+
+func invalidGeoJSONError(reason string) *errors.GenericError {
+	meta := map[string]string{"reason": reason}
+	return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidGeoJSON, meta)
+}
+
+//go:generate go run geo_boundaries_gen.go
+
+// countryBoundary is the boundary of a single country
+type countryBoundary struct {
+	countryCode string
+	geofence    Geofence
+	box         BoundingBox // Used to skip the boundary without checking the polygons
+}
+
+// countryBoundarySet contains the boundaries used by CoordinateToCountryCode
+type countryBoundarySet struct {
+	boundaries   []countryBoundary
+	countryCodes []string // Sorted and unique
+}
+
+// countryBoundaries contains the parsed countryBoundariesGeoJSON or the boundaries set with LoadCountryBoundaries.
+// Bundled boundaries are parsed on first use to avoid slowing down the start of services which don't need them.
+var countryBoundaries *countryBoundarySet
+var countryBoundariesMutex sync.RWMutex
+var countryBoundariesOnce sync.Once
+
+// countryCodeProperties contains the feature properties which are checked in order for the country code.
+// ISO_A2_EH and ISO_A2 are used by Natural Earth, which uses "-99" if a feature has no country code.
+var countryCodeProperties = []string{"country_code", "ISO_A2_EH", "ISO_A2"}
+
+// LoadCountryBoundaries replaces the boundaries used by CoordinateToCountryCode.
+// Useful to load a higher resolution dataset (e.g. Natural Earth 1:10m admin 0 countries)
+// or to cover countries outside of Europe. Each feature is the boundary of a single country.
+// Country code is taken from the "country_code", "ISO_A2_EH" or "ISO_A2" property (first valid one).
+// Features without a valid country code (e.g. disputed areas) are ignored.
+//
+// Raises
+//
+// - 400/invalid_geojson: Provided GeoJSON is invalid or contains unsupported geometries
+func LoadCountryBoundaries(geoJSON []byte) *errors.GenericError {
+	set, genErr := parseCountryBoundaries(geoJSON)
+	if genErr != nil {
+		return genErr
+	}
+	countryBoundariesOnce.Do(func() {}) // Bundled boundaries are no longer needed
+	countryBoundariesMutex.Lock()
+	defer countryBoundariesMutex.Unlock()
+	countryBoundaries = set
+	return nil
+}
+
+// CountryBoundaryCodes returns the sorted codes of the countries for which
+// CoordinateToCountryCode can resolve coordinates.
+func CountryBoundaryCodes() []string {
+	set := getCountryBoundaries()
+	if set == nil {
+		return []string{}
+	}
+	return append([]string{}, set.countryCodes...)
+}
+
+// CoordinateToCountryCode returns the code of the country in which the coordinate is located.
+// By default the bundled simplified boundaries of the countries of the European Union, EFTA and
+// the United Kingdom are used, so results within about 10 km of a border or coast might be wrong.
+// Use LoadCountryBoundaries to use other boundaries and CountryBoundaryCodes to list the supported countries.
+//
+// Raises
+//
+// - 400/invalid_coordinate: Provided coordinate is out of range
+//
+// - 404/country_not_found: Provided coordinate is not located in a supported country
+//
+// - 500/country_boundaries_not_loaded: Boundaries loaded with LoadCountryBoundaries don't contain any country
+func CoordinateToCountryCode(coordinate Coordinate) (string, *errors.GenericError) {
+	meta := map[string]string{
+		"latitude":  strconv.FormatFloat(coordinate.Latitude, 'f', -1, 64),
+		"longitude": strconv.FormatFloat(coordinate.Longitude, 'f', -1, 64),
+	}
+	if !coordinate.IsValid() {
+		return "", errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidCoordinate, meta)
+	}
+
+	set := getCountryBoundaries()
+	if set == nil || len(set.boundaries) == 0 {
+		return "", errors.NewGenericError(500, errorDomain, errorSubDomain, ErrorCountryBoundariesNotLoaded, nil)
+	}
+	for _, boundary := range set.boundaries {
+		if boundary.box.Contains(coordinate) && boundary.geofence.Contains(coordinate) {
+			return boundary.countryCode, nil
+		}
+	}
+	return "", errors.NewGenericError(404, errorDomain, errorSubDomain, ErrorCountryNotFound, meta)
+}
+
+// getCountryBoundaries returns the loaded boundaries and parses the bundled boundaries on first use
+func getCountryBoundaries() *countryBoundarySet {
+	countryBoundariesOnce.Do(func() {
+		set, genErr := parseCountryBoundaries([]byte(countryBoundariesGeoJSON))
+		if genErr != nil {
+			panic(genErr) // Bundled data should always be valid
+		}
+		countryBoundariesMutex.Lock()
+		defer countryBoundariesMutex.Unlock()
+		countryBoundaries = set
+	})
+	countryBoundariesMutex.RLock()
+	defer countryBoundariesMutex.RUnlock()
+	return countryBoundaries
+}
+
+// parseCountryBoundaries converts a GeoJSON FeatureCollection to country boundaries
+//
+// Raises
+//
+// - 400/invalid_geojson: Provided GeoJSON is invalid or contains unsupported geometries
+func parseCountryBoundaries(geoJSON []byte) (*countryBoundarySet, *errors.GenericError) {
+	geofences, genErr := ParseGeofences(geoJSON)
+	if genErr != nil {
+		return nil, genErr
+	}
+
+	set := &countryBoundarySet{boundaries: make([]countryBoundary, 0, len(geofences)), countryCodes: []string{}}
+	seen := map[string]bool{}
+	for _, geofence := range geofences {
+		countryCode := geofenceCountryCode(geofence)
+		if countryCode == "" {
+			continue
+		}
+		set.boundaries = append(set.boundaries, countryBoundary{
+			countryCode: countryCode,
+			geofence:    geofence,
+			box:         geofence.BoundingBox(),
+		})
+		if !seen[countryCode] {
+			seen[countryCode] = true
+			set.countryCodes = append(set.countryCodes, countryCode)
+		}
+	}
+	sort.Strings(set.countryCodes)
+	return set, nil
+}
+
+// geofenceCountryCode returns the upper case country code in the properties of the geofence.
+// Returns an empty string if the geofence has no valid country code.
+func geofenceCountryCode(geofence Geofence) string {
+	for _, property := range countryCodeProperties {
+		countryCode, ok := geofence.Properties[property].(string)
+		if ok && len(countryCode) == 2 && isLetters(countryCode) {
+			return strings.ToUpper(countryCode)
+		}
+	}
+	return ""
+}
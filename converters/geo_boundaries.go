@@ -0,0 +1,45 @@
+package converters
+
+// countryBoundariesGeoJSON contains simplified boundaries of the countries of the European Union and EFTA,
+// the United Kingdom, Andorra, Monaco, San Marino and Vatican City. Outlines are simplified by hand to at most
+// about 150 points per country, so results within about 10 km of a border or coast might be wrong.
+// Overseas regions are not included. Run "go generate ./converters" to replace them with
+// the Natural Earth 1:50m boundaries (see geo_boundaries_gen.go).
+const countryBoundariesGeoJSON = `{"type":"FeatureCollection","features":[
+	{"type":"Feature","properties":{"country_code":"AD"},"geometry":{"type":"MultiPolygon","coordinates":[[[[1.44,42.6],[1.54,42.65],[1.72,42.61],[1.79,42.57],[1.73,42.5],[1.66,42.47],[1.56,42.43],[1.45,42.44],[1.41,42.53],[1.44,42.6]]]]}},
+	{"type":"Feature","properties":{"country_code":"AT"},"geometry":{"type":"MultiPolygon","coordinates":[[[[9.6,47.53],[9.73,47.55],[9.97,47.54],[10.1,47.37],[10.23,47.27],[10.45,47.55],[10.7,47.54],[10.95,47.48],[11.1,47.4],[11.4,47.45],[11.63,47.59],[12.2,47.61],[12.5,47.63],[12.76,47.67],[12.8,47.56],[12.95,47.47],[13.08,47.58],[12.98,47.7],[12.99,47.82],[12.8,48.05],[12.95,48.22],[13.43,48.56],[13.73,48.52],[13.84,48.77],[14.05,48.6],[14.35,48.56],[14.7,48.59],[14.98,48.77],[15,49.01],[15.6,48.98],[16.1,48.75],[16.55,48.8],[16.94,48.62],[16.85,48.37],[16.98,48.2],[17.05,48.1],[17.16,48.01],[17.05,47.71],[16.75,47.68],[16.6,47.75],[16.42,47.66],[16.45,47.4],[16.5,47.2],[16.3,47],[16.11,46.87],[16,46.68],[15.65,46.71],[15.05,46.65],[14.8,46.5],[14.55,46.38],[14,46.47],[13.71,46.52],[13.58,46.51],[13.4,46.55],[12.73,46.65],[12.44,46.69],[12.2,46.99],[12.1,47.08],[11.5,47],[10.8,46.77],[10.52,46.84],[10.47,46.86],[10.39,47],[10.1,46.85],[9.88,46.93],[9.61,47.06],[9.64,47.15],[9.53,47.27],[9.65,47.39],[9.6,47.53]]]]}},
+	{"type":"Feature","properties":{"country_code":"BE"},"geometry":{"type":"MultiPolygon","coordinates":[[[[2.55,51.09],[2.92,51.23],[3.37,51.37],[3.52,51.24],[3.9,51.2],[4.24,51.37],[4.4,51.36],[4.4,51.43],[4.77,51.5],[5.04,51.48],[5.24,51.26],[5.55,51.27],[5.84,51.16],[5.8,51.05],[5.76,50.95],[5.64,50.85],[5.69,50.76],[6.02,50.75],[6.12,50.72],[6.17,50.62],[6.27,50.5],[6.4,50.33],[6.14,50.13],[6.03,50.16],[5.75,49.99],[5.75,49.8],[5.9,49.66],[5.82,49.55],[5.43,49.61],[5.1,49.77],[4.96,49.8],[4.84,49.95],[4.87,50.15],[4.68,49.99],[4.15,49.98],[4.22,50.06],[4.15,50.27],[3.95,50.34],[3.67,50.35],[3.61,50.5],[3.29,50.52],[3.25,50.71],[3.15,50.79],[3.02,50.77],[2.86,50.7],[2.64,50.94],[2.55,51.09]]]]}},
+	{"type":"Feature","properties":{"country_code":"BG"},"geometry":{"type":"MultiPolygon","coordinates":[[[[22.68,44.21],[23,43.85],[23.7,43.8],[24.5,43.7],[25.35,43.63],[25.97,43.87],[26.7,44.08],[27.27,44.12],[27.95,43.98],[28.58,43.74],[28.47,43.36],[27.95,43.2],[27.9,42.88],[27.9,42.7],[27.5,42.48],[27.7,42.42],[28.02,41.98],[27.55,42],[27.05,42.08],[26.55,41.9],[26.36,41.71],[25.9,41.32],[25.2,41.25],[24.5,41.55],[24.05,41.52],[23.6,41.38],[22.96,41.34],[22.9,41.6],[22.95,41.9],[22.7,42.05],[22.36,42.32],[22.55,42.5],[22.45,42.8],[23,43.15],[22.7,43.5],[22.4,43.9],[22.68,44.21]]]]}},
+	{"type":"Feature","properties":{"country_code":"CH"},"geometry":{"type":"MultiPolygon","coordinates":[[[[7.59,47.59],[7.38,47.43],[6.98,47.47],[6.88,47.34],[6.44,46.99],[6.1,46.58],[6.07,46.41],[5.96,46.14],[6.18,46.16],[6.31,46.25],[6.52,46.45],[6.8,46.4],[6.86,46.12],[7.04,45.92],[7.35,45.9],[7.86,45.92],[8.1,46.15],[8.44,46.46],[8.71,46.1],[8.85,45.99],[9.03,45.82],[9.09,45.9],[9.28,46.49],[9.55,46.3],[9.99,46.38],[10.1,46.23],[10.17,46.4],[10.45,46.55],[10.47,46.86],[10.39,47],[10.1,46.85],[9.88,46.93],[9.61,47.06],[9.48,47.09],[9.5,47.22],[9.53,47.27],[9.65,47.39],[9.6,47.53],[9.18,47.65],[8.88,47.66],[8.66,47.8],[8.45,47.77],[8.4,47.58],[8.22,47.62],[7.9,47.55],[7.69,47.6],[7.59,47.59]]]]}},
+	{"type":"Feature","properties":{"country_code":"CY"},"geometry":{"type":"MultiPolygon","coordinates":[[[[32.4,34.75],[32.95,34.57],[33.04,34.67],[33.64,34.91],[34.08,34.98],[33.95,35.15],[34.58,35.69],[33.95,35.35],[33.32,35.34],[32.95,35.4],[32.9,35.16],[32.3,35.1],[32.27,34.9],[32.4,34.75]]]]}},
+	{"type":"Feature","properties":{"country_code":"CZ"},"geometry":{"type":"MultiPolygon","coordinates":[[[[13.84,48.77],[13.4,49],[13,49.3],[12.6,49.55],[12.45,49.7],[12.55,49.93],[12.26,50.06],[12.1,50.32],[12.32,50.24],[12.55,50.4],[12.95,50.42],[13.3,50.58],[13.55,50.71],[14,50.81],[14.25,50.87],[14.32,51.05],[14.58,50.92],[14.82,50.87],[15.05,50.98],[15.3,50.95],[15.82,50.75],[16.21,50.66],[16.43,50.57],[16.25,50.42],[16.7,50.1],[16.9,50.45],[17.25,50.28],[17.72,50.32],[18.04,50.05],[18.57,49.91],[18.85,49.52],[18.55,49.45],[18.15,49.28],[17.9,48.98],[17.55,48.82],[17.2,48.87],[16.94,48.62],[16.55,48.8],[16.1,48.75],[15.6,48.98],[15,49.01],[14.98,48.77],[14.7,48.59],[14.35,48.56],[14.05,48.6],[13.84,48.77]]]]}},
+	{"type":"Feature","properties":{"country_code":"DE"},"geometry":{"type":"MultiPolygon","coordinates":[[[[7.21,53.24],[7.2,53.6],[8,53.7],[8.13,53.52],[8.58,53.55],[8.7,53.87],[8.9,54],[8.85,54.3],[8.6,54.35],[9.05,54.48],[8.65,54.9],[8.66,54.91],[9,54.9],[9.42,54.84],[9.62,54.83],[10.03,54.67],[10.2,54.45],[10.85,54.33],[11.1,54.45],[11.2,54.4],[10.85,53.95],[11.45,53.95],[12.1,54.18],[12.5,54.47],[13.1,54.32],[13.8,54.15],[14.22,53.92],[14.27,53.7],[14.41,53.33],[14.14,52.87],[14.63,52.57],[14.55,52.35],[14.7,52.07],[14.72,51.95],[14.72,51.55],[14.97,51.3],[15,51.15],[14.93,51.03],[14.82,50.87],[14.58,50.92],[14.32,51.05],[14.25,50.87],[14,50.81],[13.55,50.71],[13.3,50.58],[12.95,50.42],[12.55,50.4],[12.32,50.24],[12.1,50.32],[12.26,50.06],[12.55,49.93],[12.45,49.7],[12.6,49.55],[13,49.3],[13.4,49],[13.84,48.77],[13.73,48.52],[13.43,48.56],[12.95,48.22],[12.8,48.05],[12.99,47.82],[12.98,47.7],[13.08,47.58],[12.95,47.47],[12.8,47.56],[12.76,47.67],[12.5,47.63],[12.2,47.61],[11.63,47.59],[11.4,47.45],[11.1,47.4],[10.95,47.48],[10.7,47.54],[10.45,47.55],[10.23,47.27],[10.1,47.37],[9.97,47.54],[9.73,47.55],[9.6,47.53],[9.18,47.65],[8.88,47.66],[8.66,47.8],[8.45,47.77],[8.4,47.58],[8.22,47.62],[7.9,47.55],[7.69,47.6],[7.59,47.59],[7.57,48],[7.8,48.5],[8.2,48.97],[7.8,49.05],[7.45,49.18],[7.05,49.11],[6.73,49.16],[6.37,49.47],[6.5,49.72],[6.51,49.81],[6.32,49.84],[6.14,50.13],[6.4,50.33],[6.27,50.5],[6.17,50.62],[6.12,50.72],[6.02,50.75],[6.02,50.81],[6.09,50.87],[6.02,50.94],[5.88,51.03],[6.08,51.11],[6.17,51.17],[6.23,51.36],[6.2,51.52],[5.96,51.74],[6.17,51.84],[6.41,51.83],[6.73,51.9],[6.83,51.97],[6.69,52.04],[7.06,52.24],[6.72,52.47],[7.05,52.64],[7.07,52.83],[7.21,53.24]]]]}},
+	{"type":"Feature","properties":{"country_code":"DK"},"geometry":{"type":"MultiPolygon","coordinates":[[[[9.62,54.83],[9.42,54.84],[9,54.9],[8.66,54.91],[8.6,55.4],[8.1,55.55],[8.15,56],[8.12,56.55],[8.25,56.8],[8.6,57.1],[9.5,57.5],[9.96,57.59],[10.6,57.74],[10.5,57.45],[10.3,56.95],[10.2,56.7],[10.95,56.45],[10.22,56.15],[10,55.85],[9.75,55.7],[9.75,55.56],[9.55,55.4],[9.7,55.25],[9.8,55],[9.62,54.83]]],[[[9.7,55.5],[10.2,55.62],[10.5,55.55],[10.73,55.3],[10.65,55.05],[10.2,55],[9.85,55.1],[9.7,55.5]]],[[[11.15,55.2],[11.08,55.7],[11.5,55.95],[12.31,56.13],[12.61,56.04],[12.6,55.72],[12.68,55.6],[12.2,55.45],[12.45,55.3],[12,55.17],[11.9,55],[11.4,55.1],[11.15,55.2]]],[[[11,54.8],[11.5,54.63],[11.97,54.57],[12.15,54.85],[11.85,54.95],[11.3,54.92],[11,54.8]]],[[[14.7,55.1],[14.88,55],[15.15,55.1],[15.1,55.28],[14.78,55.3],[14.7,55.1]]]]}},
+	{"type":"Feature","properties":{"country_code":"EE"},"geometry":{"type":"MultiPolygon","coordinates":[[[[24.31,57.87],[24.48,58.37],[23.51,58.57],[23.54,58.94],[23.45,59.2],[24.05,59.4],[24.75,59.46],[25.7,59.6],[26.5,59.55],[27.4,59.45],[28.04,59.46],[28.2,59.37],[27.9,59.2],[27.75,58.9],[27.5,58.3],[27.7,57.85],[27.35,57.53],[26.5,57.53],[26.05,57.84],[25.3,58.05],[24.31,57.87]]],[[[21.85,58.3],[22.3,58.6],[23,58.6],[23.35,58.45],[22.9,58.25],[22.3,57.92],[22,58.1],[21.85,58.3]]],[[[22.05,58.9],[22.5,59.05],[23.05,58.85],[22.6,58.7],[22.05,58.9]]]]}},
+	{"type":"Feature","properties":{"country_code":"ES"},"geometry":{"type":"MultiPolygon","coordinates":[[[[-8.87,41.87],[-8.8,42.24],[-8.9,42.5],[-9.27,42.88],[-9.2,43.2],[-8.4,43.37],[-7.68,43.79],[-7.04,43.54],[-6,43.58],[-5.85,43.66],[-5.66,43.55],[-4.5,43.4],[-3.8,43.47],[-3.02,43.38],[-1.98,43.32],[-1.78,43.37],[-1.6,43.25],[-1.38,43.03],[-0.75,42.95],[-0.31,42.84],[0,42.68],[0.66,42.69],[0.72,42.86],[1,42.72],[1.44,42.6],[1.41,42.53],[1.45,42.44],[1.56,42.43],[1.66,42.47],[1.73,42.5],[1.97,42.45],[2.2,42.42],[2.47,42.35],[2.68,42.34],[2.87,42.46],[3.17,42.43],[3.32,42.32],[3.2,41.9],[2.8,41.65],[2.2,41.4],[2.1,41.28],[1.8,41.23],[1.25,41.11],[0.87,40.72],[0.5,40.3],[-0.03,39.98],[-0.33,39.47],[-0.1,38.95],[0.23,38.73],[-0.48,38.35],[-0.75,37.85],[-0.69,37.63],[-0.98,37.58],[-1.9,37.25],[-2.19,36.72],[-2.47,36.83],[-3.52,36.72],[-4.42,36.7],[-5,36.48],[-5.35,36.15],[-5.6,36.01],[-6.04,36.2],[-6.29,36.53],[-6.36,36.78],[-6.95,37.2],[-7.4,37.17],[-7.5,37.55],[-7.34,37.98],[-6.95,38.2],[-7.1,38.17],[-7.27,38.43],[-7.26,38.72],[-7.05,38.91],[-7.23,39.28],[-7.3,39.45],[-7.53,39.66],[-7.02,39.67],[-6.95,40.12],[-6.86,40.27],[-6.8,40.85],[-6.93,41.02],[-6.19,41.58],[-6.55,41.96],[-6.98,41.97],[-7.2,41.88],[-7.45,41.86],[-7.9,41.87],[-8.08,41.81],[-8.2,42.14],[-8.64,42.04],[-8.87,41.87]]],[[[2.34,39.58],[2.52,39.47],[2.74,39.51],[2.98,39.36],[3.07,39.26],[3.25,39.35],[3.48,39.71],[3.21,39.96],[3.05,39.85],[2.78,39.86],[2.34,39.58]]],[[[3.79,39.93],[4.1,39.83],[4.33,39.86],[4.2,40.05],[3.85,40.06],[3.79,39.93]]],[[[1.2,38.97],[1.4,38.83],[1.6,39.03],[1.47,39.12],[1.25,39.08],[1.2,38.97]]]]}},
+	{"type":"Feature","properties":{"country_code":"FI"},"geometry":{"type":"MultiPolygon","coordinates":[[[[24.15,65.82],[23.9,66.3],[23.65,66.5],[23.9,67.1],[23.5,67.9],[22.8,68.4],[21.7,68.8],[20.55,69.06],[21.3,69.3],[22.4,68.7],[23,68.63],[24,68.83],[24.9,68.6],[25.8,69],[25.85,69.4],[27.03,69.91],[27.95,70.08],[28.45,69.85],[29.2,69.4],[28.93,69.05],[28.4,68.55],[28.7,68.15],[29.35,66.85],[30.1,65.7],[29.6,64.95],[30.55,64.25],[29.95,63.75],[31.55,62.9],[31.2,62.45],[30.15,61.85],[29.25,61.3],[28.75,61.05],[27.8,60.53],[26.95,60.45],[25.5,60.3],[24.95,60.15],[24.2,60],[22.95,59.82],[22.2,60.43],[21.35,60.8],[21.5,61.13],[21.45,61.5],[21.25,62],[21.1,62.6],[21.6,63.1],[22.5,63.5],[23.1,63.85],[24.3,64.5],[25.4,65.02],[24.55,65.75],[24.15,65.82]]],[[[19.65,60.1],[20.1,60.4],[20.45,60.2],[20.1,59.98],[19.65,60.1]]]]}},
+	{"type":"Feature","properties":{"country_code":"FR"},"geometry":{"type":"MultiPolygon","coordinates":[[[[-1.78,43.37],[-1.56,43.48],[-1.25,44.5],[-1.25,44.65],[-1.07,45.57],[-1.2,46.15],[-1.8,46.5],[-2.2,47.27],[-2.5,47.3],[-3.12,47.5],[-3.4,47.7],[-4.35,47.8],[-4.73,48.04],[-4.77,48.33],[-4.6,48.63],[-3.98,48.72],[-3.45,48.82],[-2.7,48.55],[-2.02,48.65],[-1.51,48.64],[-1.6,48.84],[-1.95,49.72],[-1.62,49.65],[-1.26,49.67],[-1.1,49.35],[-0.3,49.3],[0.1,49.48],[0.2,49.71],[1.08,49.93],[1.38,50.06],[1.6,50.73],[1.85,50.96],[2.37,51.05],[2.55,51.09],[2.64,50.94],[2.86,50.7],[3.02,50.77],[3.15,50.79],[3.25,50.71],[3.29,50.52],[3.61,50.5],[3.67,50.35],[3.95,50.34],[4.15,50.27],[4.22,50.06],[4.15,49.98],[4.68,49.99],[4.87,50.15],[4.84,49.95],[4.96,49.8],[5.1,49.77],[5.43,49.61],[5.82,49.55],[5.98,49.45],[6.24,49.51],[6.37,49.47],[6.73,49.16],[7.05,49.11],[7.45,49.18],[7.8,49.05],[8.2,48.97],[7.8,48.5],[7.57,48],[7.59,47.59],[7.38,47.43],[6.98,47.47],[6.88,47.34],[6.44,46.99],[6.1,46.58],[6.07,46.41],[5.96,46.14],[6.18,46.16],[6.31,46.25],[6.52,46.45],[6.8,46.4],[6.86,46.12],[7.04,45.92],[6.86,45.83],[6.88,45.68],[7.13,45.26],[6.9,45.25],[6.72,44.93],[7.05,44.7],[6.88,44.42],[7,44.23],[7.35,44.12],[7.71,44.1],[7.52,43.87],[7.53,43.78],[7.44,43.749],[7.427,43.752],[7.41,43.733],[7.409,43.725],[7.27,43.69],[7.02,43.55],[6.7,43.27],[6.2,43.05],[5.93,43.1],[5.6,43.16],[5.35,43.3],[5,43.37],[4.6,43.35],[4.1,43.55],[3.7,43.4],[3.17,43.16],[3.03,42.75],[3.17,42.43],[2.87,42.46],[2.68,42.34],[2.47,42.35],[2.2,42.42],[1.97,42.45],[1.73,42.5],[1.79,42.57],[1.72,42.61],[1.54,42.65],[1.44,42.6],[1,42.72],[0.72,42.86],[0.66,42.69],[0,42.68],[-0.31,42.84],[-0.75,42.95],[-1.38,43.03],[-1.6,43.25],[-1.78,43.37]]],[[[9.22,41.36],[8.85,41.55],[8.66,41.74],[8.8,41.9],[8.61,41.9],[8.58,42.25],[8.7,42.58],[9.3,42.7],[9.35,43.01],[9.46,42.99],[9.45,42.7],[9.55,42.15],[9.4,41.6],[9.22,41.36]]]]}},
+	{"type":"Feature","properties":{"country_code":"GB"},"geometry":{"type":"MultiPolygon","coordinates":[[[[1.31,51.13],[1.45,51.35],[0.95,51.38],[0.55,51.45],[0.45,51.5],[0.8,51.55],[0.95,51.62],[1.28,51.85],[1.75,52.48],[1.65,52.75],[1.3,52.95],[0.5,52.97],[0.1,52.8],[0.35,53.15],[0.12,53.58],[-0.08,54.12],[-0.4,54.28],[-1.1,54.62],[-1.42,55.01],[-1.6,55.6],[-2.03,55.78],[-2.52,56],[-2.9,55.97],[-3.2,55.99],[-3.7,56.03],[-3.3,56.06],[-2.6,56.25],[-2.8,56.34],[-2.97,56.46],[-2.6,56.55],[-2.07,57.15],[-1.78,57.5],[-2,57.69],[-3,57.68],[-4.1,57.58],[-3.8,57.85],[-3.09,58.44],[-3.03,58.64],[-3.52,58.6],[-5,58.62],[-5.35,58.2],[-5.7,57.85],[-5.72,57.28],[-6.22,56.72],[-5.47,56.41],[-5.6,56],[-5.75,55.3],[-5.45,55.5],[-5,55.85],[-4.7,55.95],[-4.5,55.93],[-4.85,55.7],[-4.63,55.46],[-5,55],[-5.02,54.9],[-4.86,54.63],[-4.4,54.85],[-3.6,54.88],[-3.4,54.55],[-3.2,54.1],[-3.05,53.82],[-3.1,53.4],[-3.2,53.38],[-3.83,53.32],[-4.55,53.35],[-4.75,52.8],[-4.1,52.9],[-4.08,52.41],[-4.7,52.1],[-5.3,51.85],[-5.05,51.7],[-3.95,51.62],[-3.6,51.4],[-3.17,51.46],[-2.55,51.72],[-2.72,51.5],[-3,51.33],[-3.4,51.2],[-4.2,51.2],[-4.53,51.02],[-5.05,50.55],[-5.7,50.05],[-5.2,49.96],[-4.15,50.33],[-3.64,50.22],[-3.42,50.61],[-2.45,50.52],[-1.95,50.6],[-1.4,50.85],[-1.09,50.8],[-0.8,50.73],[-0.14,50.81],[0.25,50.73],[0.97,50.91],[1.18,51.08],[1.31,51.13]]],[[[-6.1,54.02],[-6.35,54.12],[-6.62,54.04],[-7.05,54.28],[-7.35,54.12],[-7.65,54.2],[-8.15,54.45],[-7.75,54.62],[-7.55,54.75],[-7.45,54.83],[-7.35,55.03],[-7,55.2],[-6.5,55.24],[-6.15,55.22],[-6.05,55.2],[-5.75,54.85],[-5.7,54.78],[-5.9,54.63],[-5.55,54.68],[-5.45,54.5],[-5.55,54.3],[-6,54.02],[-6.1,54.02]]],[[[-7.1,57.75],[-6.75,57.85],[-6.15,58.5],[-6.6,58.35],[-7.1,58.2],[-7.1,57.75]]],[[[-3.35,58.95],[-2.95,59.1],[-2.7,58.95],[-3.2,58.85],[-3.35,58.95]]],[[[-1.35,59.85],[-1.05,60.5],[-1.3,60.6],[-1.6,60.3],[-1.35,59.85]]]]}},
+	{"type":"Feature","properties":{"country_code":"GR"},"geometry":{"type":"MultiPolygon","coordinates":[[[[22.96,41.34],[23.6,41.38],[24.05,41.52],[24.5,41.55],[25.2,41.25],[25.9,41.32],[26.36,41.71],[26.5,41.65],[26.62,41.35],[26.33,41],[26.03,40.73],[25.87,40.84],[25,40.95],[24.41,40.92],[23.8,40.7],[23.8,40.4],[24.4,40.15],[23.95,40],[23.7,40.25],[23.35,39.95],[23,40.4],[22.93,40.6],[22.65,40.5],[22.6,40.1],[22.7,39.9],[23.2,39.6],[23.35,39.15],[22.95,39.36],[23,39.05],[22.6,38.87],[23.1,38.65],[23.55,38.43],[24.05,38.15],[24.03,37.65],[23.64,37.94],[23.35,37.98],[22.98,37.93],[23.15,37.63],[23.5,37.45],[23.15,37.3],[22.75,37.55],[23.05,36.8],[23.19,36.44],[22.56,36.76],[22.48,36.39],[22.11,37],[22,36.8],[21.7,36.82],[21.68,36.91],[21.6,37.2],[21.12,37.94],[21.35,38.15],[21.7,38.26],[21.78,38.3],[22.4,38.1],[22.95,37.95],[23,38.15],[22.4,38.35],[21.75,38.33],[21.4,38.4],[21.1,38.4],[20.9,38.75],[20.75,38.95],[20.27,39.5],[20.01,39.66],[20.3,39.75],[20.65,40.1],[20.95,40.47],[21.03,40.86],[21.6,40.9],[21.95,41.12],[22.35,41.13],[22.96,41.34]]],[[[23.55,35.25],[23.6,35.55],[24.02,35.525],[24.25,35.55],[24.45,35.37],[25.14,35.35],[25.7,35.3],[26.3,35.28],[26.15,34.98],[25.74,35],[25,34.93],[24.7,35.1],[23.95,35.22],[23.55,35.25]]],[[[28.23,36.45],[28.1,36.1],[27.72,35.89],[27.7,36.15],[28,36.4],[28.23,36.45]]],[[[22.9,38.92],[23.3,39.03],[23.6,38.75],[24.2,38.55],[24.6,38.15],[24.55,37.98],[24.2,38.1],[23.65,38.48],[23.15,38.75],[22.9,38.92]]],[[[19.65,39.75],[19.95,39.8],[19.93,39.6],[20.12,39.38],[19.85,39.45],[19.65,39.75]]],[[[25.85,39.2],[26.15,39.37],[26.4,39.33],[26.6,39.05],[26.4,38.97],[26,39.05],[25.85,39.2]]],[[[25.87,38.45],[26.1,38.6],[26.15,38.35],[26.05,38.22],[25.9,38.3],[25.87,38.45]]],[[[26.57,37.72],[26.8,37.8],[27.05,37.7],[26.85,37.65],[26.57,37.72]]],[[[20.35,38.2],[20.55,38.5],[20.75,38.3],[20.6,38.1],[20.35,38.2]]],[[[20.65,37.85],[20.9,37.9],[20.98,37.7],[20.8,37.65],[20.65,37.85]]],[[[20.57,38.8],[20.7,38.85],[20.75,38.65],[20.6,38.6],[20.57,38.8]]],[[[25.35,37.1],[25.55,37.2],[25.6,36.95],[25.4,36.95],[25.35,37.1]]],[[[25.35,36.45],[25.45,36.47],[25.5,36.35],[25.4,36.35],[25.35,36.45]]],[[[24.55,40.7],[24.8,40.8],[24.8,40.6],[24.6,40.58],[24.55,40.7]]]]}},
+	{"type":"Feature","properties":{"country_code":"HR"},"geometry":{"type":"MultiPolygon","coordinates":[[[[13.59,45.48],[13.9,45.45],[14.25,45.5],[14.65,45.63],[15.3,45.46],[15.35,45.72],[15.68,45.84],[15.7,46.2],[16,46.31],[16.3,46.38],[16.6,46.48],[16.85,46.35],[17.35,45.95],[17.9,45.8],[18.4,45.75],[18.82,45.91],[18.95,45.55],[19.42,45.22],[19.02,44.86],[18.6,45.05],[18.01,45.14],[17.5,45.12],[16.91,45.25],[16.54,45.21],[16.2,45.05],[15.75,44.75],[16.1,44.45],[16.4,44.05],[16.9,43.7],[17.3,43.45],[17.45,43.2],[17.7,43.05],[17.57,42.95],[17.35,43.1],[17.02,43.3],[16.6,43.45],[16.45,43.49],[16.25,43.52],[15.89,43.73],[15.6,43.9],[15.23,44.12],[15.05,44.5],[14.9,45],[14.55,45.25],[14.44,45.31],[14.3,45.32],[14.17,45.13],[13.91,44.76],[13.83,44.87],[13.64,45.08],[13.59,45.23],[13.52,45.43],[13.59,45.48]]],[[[17.65,42.89],[18.1,42.64],[18.35,42.55],[18.53,42.43],[18.57,42.5],[18.25,42.62],[18.05,42.75],[17.78,42.92],[17.65,42.89]]]]}},
+	{"type":"Feature","properties":{"country_code":"HU"},"geometry":{"type":"MultiPolygon","coordinates":[[[[16.11,46.87],[16.3,47],[16.5,47.2],[16.45,47.4],[16.42,47.66],[16.6,47.75],[16.75,47.68],[17.05,47.71],[17.16,48.01],[17.75,47.75],[18.13,47.76],[18.75,47.81],[18.85,47.95],[19,48.07],[19.5,48.2],[19.9,48.15],[20.3,48.27],[20.8,48.57],[21.2,48.52],[21.6,48.5],[22.15,48.41],[22.5,48.25],[22.89,47.95],[22.3,47.73],[22,47.4],[21.65,47.05],[21.45,46.7],[21.25,46.42],[20.73,46.17],[20.26,46.11],[19.55,46.17],[19,45.97],[18.82,45.91],[18.4,45.75],[17.9,45.8],[17.35,45.95],[16.85,46.35],[16.6,46.48],[16.42,46.63],[16.11,46.87]]]]}},
+	{"type":"Feature","properties":{"country_code":"IE"},"geometry":{"type":"MultiPolygon","coordinates":[[[[-6.1,54.02],[-6.3,53.98],[-6.25,53.72],[-6.15,53.35],[-6,53.05],[-6.2,52.55],[-6.36,52.17],[-7,52.15],[-7.85,51.95],[-8.3,51.8],[-9,51.6],[-9.8,51.45],[-10.25,51.8],[-10.45,52.1],[-9.9,52.35],[-9.93,52.56],[-9.45,52.95],[-9.05,53.25],[-10.1,53.4],[-10.15,53.45],[-9.9,53.8],[-10,54.22],[-9.2,54.3],[-8.6,54.3],[-8.2,54.6],[-8.8,54.7],[-8.3,55.15],[-7.37,55.38],[-7,55.2],[-7.35,55.03],[-7.45,54.83],[-7.55,54.75],[-7.75,54.62],[-8.15,54.45],[-7.65,54.2],[-7.35,54.12],[-7.05,54.28],[-6.62,54.04],[-6.35,54.12],[-6.1,54.02]]]]}},
+	{"type":"Feature","properties":{"country_code":"IS"},"geometry":{"type":"MultiPolygon","coordinates":[[[[-22.7,63.82],[-22.65,64.05],[-22.05,64.06],[-22.02,64.16],[-21.8,64.2],[-22.1,64.32],[-22.4,64.55],[-23.8,64.85],[-22.5,65.05],[-24.5,65.5],[-23.2,66.2],[-22.5,66.45],[-21.3,65.9],[-20.3,66.1],[-18.1,66.18],[-18.05,65.7],[-17.6,66.1],[-16,66.55],[-14.6,66.1],[-13.5,65.25],[-14.5,64.4],[-16.5,63.85],[-18.7,63.4],[-20.5,63.7],[-21.5,63.85],[-22.7,63.82]]]]}},
+	{"type":"Feature","properties":{"country_code":"IT"},"geometry":{"type":"MultiPolygon","coordinates":[[[[7.53,43.78],[8,43.88],[8.45,44.3],[8.93,44.41],[9.4,44.3],[9.85,44.05],[10.25,43.85],[10.3,43.55],[10.5,42.95],[11.1,42.42],[11.8,42.09],[12.25,41.73],[12.9,41.35],[13.57,41.21],[14.05,40.8],[14.25,40.83],[14.33,40.6],[14.77,40.68],[15,40.3],[15.27,40.03],[15.7,39.95],[16.03,39.36],[16.2,38.75],[15.85,38.68],[15.65,38.25],[15.65,38.11],[16.06,37.92],[16.55,38.45],[16.62,38.82],[17.15,39.05],[17.1,39.4],[16.5,39.72],[16.8,40.4],[17.23,40.47],[17.98,40.05],[18.36,39.8],[18.52,40.15],[17.95,40.65],[16.87,41.12],[16.28,41.32],[15.92,41.63],[16.19,41.9],[15.9,41.95],[15,42],[14.22,42.47],[13.85,42.9],[13.52,43.62],[12.57,44.07],[12.3,44.45],[12.55,44.95],[12.4,45.45],[12.7,45.6],[13.1,45.65],[13.39,45.68],[13.53,45.78],[13.72,45.59],[13.92,45.64],[13.6,45.82],[13.63,45.93],[13.66,46.04],[13.38,46.22],[13.45,46.35],[13.71,46.52],[13.58,46.51],[13.4,46.55],[12.73,46.65],[12.44,46.69],[12.2,46.99],[12.1,47.08],[11.5,47],[10.8,46.77],[10.52,46.84],[10.47,46.86],[10.45,46.55],[10.17,46.4],[10.1,46.23],[9.99,46.38],[9.55,46.3],[9.28,46.49],[9.09,45.9],[9.03,45.82],[8.85,45.99],[8.71,46.1],[8.44,46.46],[8.1,46.15],[7.86,45.92],[7.35,45.9],[7.04,45.92],[6.86,45.83],[6.88,45.68],[7.13,45.26],[6.9,45.25],[6.72,44.93],[7.05,44.7],[6.88,44.42],[7,44.23],[7.35,44.12],[7.71,44.1],[7.52,43.87],[7.53,43.78]],[[12.4,43.95],[12.45,43.89],[12.52,43.92],[12.5,43.99],[12.43,43.99],[12.4,43.95]],[[12.446,41.9],[12.458,41.901],[12.458,41.907],[12.446,41.906],[12.446,41.9]]],[[[12.43,37.8],[12.51,38.02],[12.73,38.18],[13.1,38.2],[13.38,38.14],[14,38.03],[15.24,38.27],[15.65,38.27],[15.55,38.15],[15.25,37.8],[15.1,37.5],[15.3,37],[15.09,36.65],[14.85,36.73],[14.25,37.05],[13.5,37.28],[12.6,37.65],[12.43,37.8]]],[[[8.2,41.05],[8.4,40.84],[9.19,41.24],[9.55,41.1],[9.5,40.92],[9.83,40.5],[9.7,40.05],[9.65,39.55],[9.58,39.15],[9.1,39.2],[8.9,38.88],[8.4,38.95],[8.45,39.45],[8.5,39.9],[8.4,40.3],[8.15,40.6],[8.2,41.05]]]]}},
+	{"type":"Feature","properties":{"country_code":"LI"},"geometry":{"type":"MultiPolygon","coordinates":[[[[9.61,47.06],[9.48,47.09],[9.5,47.22],[9.53,47.27],[9.64,47.15],[9.61,47.06]]]]}},
+	{"type":"Feature","properties":{"country_code":"LT"},"geometry":{"type":"MultiPolygon","coordinates":[[[[22.79,54.36],[23.05,54.18],[23.35,54],[23.49,53.93],[24.35,53.9],[24.8,54],[25.5,54.3],[25.75,54.57],[25.8,54.85],[26.25,55.2],[26.62,55.68],[25.7,56.15],[24.9,56.44],[24.1,56.26],[23,56.38],[22,56.42],[21.05,56.07],[21.1,55.72],[21,55.28],[21.26,55.25],[22,55.08],[22.85,54.9],[22.7,54.72],[22.79,54.36]]]]}},
+	{"type":"Feature","properties":{"country_code":"LU"},"geometry":{"type":"MultiPolygon","coordinates":[[[[6.14,50.13],[6.03,50.16],[5.75,49.99],[5.75,49.8],[5.9,49.66],[5.82,49.55],[5.98,49.45],[6.24,49.51],[6.37,49.47],[6.5,49.72],[6.51,49.81],[6.32,49.84],[6.14,50.13]]]]}},
+	{"type":"Feature","properties":{"country_code":"LV"},"geometry":{"type":"MultiPolygon","coordinates":[[[[21.05,56.07],[22,56.42],[23,56.38],[24.1,56.26],[24.9,56.44],[25.7,56.15],[26.62,55.68],[27.2,55.85],[27.7,55.8],[28.17,56.15],[27.8,56.87],[27.75,57.25],[27.35,57.53],[26.5,57.53],[26.05,57.84],[25.3,58.05],[24.31,57.87],[24.36,57.75],[24.41,57.26],[24.03,57.06],[23.7,56.97],[23.22,57.16],[22.6,57.76],[22,57.58],[21.5,57.4],[21.05,57],[20.98,56.5],[21.05,56.07]]]]}},
+	{"type":"Feature","properties":{"country_code":"MC"},"geometry":{"type":"MultiPolygon","coordinates":[[[[7.409,43.725],[7.44,43.749],[7.427,43.752],[7.41,43.733],[7.409,43.725]]]]}},
+	{"type":"Feature","properties":{"country_code":"MT"},"geometry":{"type":"MultiPolygon","coordinates":[[[[14.33,35.99],[14.4,35.85],[14.56,35.82],[14.57,35.87],[14.42,35.96],[14.33,35.99]]],[[[14.18,36.05],[14.25,36.01],[14.34,36.03],[14.27,36.08],[14.18,36.05]]]]}},
+	{"type":"Feature","properties":{"country_code":"NL"},"geometry":{"type":"MultiPolygon","coordinates":[[[[3.37,51.37],[3.6,51.45],[3.45,51.55],[3.7,51.7],[3.98,51.95],[4.12,51.98],[4.27,52.11],[4.56,52.46],[4.75,52.96],[5.41,53.17],[6,53.4],[6.7,53.46],[7,53.33],[7.21,53.24],[7.07,52.83],[7.05,52.64],[6.72,52.47],[7.06,52.24],[6.69,52.04],[6.83,51.97],[6.73,51.9],[6.41,51.83],[6.17,51.84],[5.96,51.74],[6.2,51.52],[6.23,51.36],[6.17,51.17],[6.08,51.11],[5.88,51.03],[6.02,50.94],[6.09,50.87],[6.02,50.81],[6.02,50.75],[5.69,50.76],[5.64,50.85],[5.76,50.95],[5.8,51.05],[5.84,51.16],[5.55,51.27],[5.24,51.26],[5.04,51.48],[4.77,51.5],[4.4,51.43],[4.4,51.36],[4.24,51.37],[3.9,51.2],[3.52,51.24],[3.37,51.37]]]]}},
+	{"type":"Feature","properties":{"country_code":"NO"},"geometry":{"type":"MultiPolygon","coordinates":[[[[11.25,59.08],[10.85,59.15],[10.7,59.85],[10.72,59.89],[10.5,59.5],[10.3,59.1],[9.8,58.95],[9.2,58.65],[8.77,58.46],[8,58.13],[7.05,57.98],[6.1,58.35],[5.55,58.8],[5.6,58.97],[5.3,59.4],[5.1,60],[5.15,60.4],[4.95,61],[5,61.6],[5.1,62.2],[6.05,62.5],[7,62.95],[7.73,63.11],[8.5,63.45],[9.7,63.85],[10.7,64.4],[11.5,65],[12.3,65.6],[13,66.4],[14.35,67.28],[15.1,68.2],[15.9,68.7],[17.6,69.1],[18.8,69.7],[20.5,70.1],[21.5,70.25],[23.68,70.66],[24.5,70.9],[25.78,71.17],[27,71.05],[27.65,71.13],[29,70.8],[31.1,70.37],[29.6,70.05],[28.8,70.1],[29.8,69.8],[30.05,69.73],[30.83,69.78],[30.15,69.65],[29.35,69.3],[28.93,69.05],[29.2,69.4],[28.45,69.85],[27.95,70.08],[27.03,69.91],[25.85,69.4],[25.8,69],[24.9,68.6],[24,68.83],[23,68.63],[22.4,68.7],[21.3,69.3],[20.55,69.06],[20.3,68.5],[19.95,68.35],[18.1,68.45],[17.9,68],[16.8,67.9],[16.4,67],[15.4,66.25],[14.5,65.9],[13.65,65.1],[14.1,64.45],[13.2,64.05],[12.15,63.6],[12.05,63],[12.3,62.3],[12.2,62],[12.85,61.35],[12.25,61.05],[12.5,60.15],[11.8,59.85],[11.25,59.08]]],[[[11,78.7],[16.5,80],[23,79.6],[21,78],[19,77],[16.5,76.5],[13,77.7],[11,78.7]]]]}},
+	{"type":"Feature","properties":{"country_code":"PL"},"geometry":{"type":"MultiPolygon","coordinates":[[[[14.22,53.92],[15.58,54.18],[16.85,54.59],[17.55,54.77],[18.34,54.83],[18.55,54.52],[18.68,54.4],[19.2,54.35],[19.63,54.45],[20.5,54.4],[21.5,54.33],[22.79,54.36],[23.05,54.18],[23.35,54],[23.49,53.93],[23.55,53.75],[23.92,53.15],[23.93,52.72],[23.18,52.28],[23.65,52.08],[23.57,51.53],[24.11,50.84],[24.03,50.43],[23.5,50.22],[22.95,49.8],[22.64,49.53],[22.9,49.07],[22.56,49.09],[21.95,49.38],[21.28,49.45],[20.92,49.3],[20.35,49.4],[20.06,49.17],[19.78,49.2],[19.47,49.6],[19.18,49.41],[18.85,49.52],[18.57,49.91],[18.04,50.05],[17.72,50.32],[17.25,50.28],[16.9,50.45],[16.7,50.1],[16.25,50.42],[16.43,50.57],[16.21,50.66],[15.82,50.75],[15.3,50.95],[15.05,50.98],[14.82,50.87],[14.93,51.03],[15,51.15],[14.97,51.3],[14.72,51.55],[14.72,51.95],[14.7,52.07],[14.55,52.35],[14.63,52.57],[14.14,52.87],[14.41,53.33],[14.27,53.7],[14.22,53.92]]]]}},
+	{"type":"Feature","properties":{"country_code":"PT"},"geometry":{"type":"MultiPolygon","coordinates":[[[[-8.87,41.87],[-8.64,42.04],[-8.2,42.14],[-8.08,41.81],[-7.9,41.87],[-7.45,41.86],[-7.2,41.88],[-6.98,41.97],[-6.55,41.96],[-6.19,41.58],[-6.93,41.02],[-6.8,40.85],[-6.86,40.27],[-6.95,40.12],[-7.02,39.67],[-7.53,39.66],[-7.3,39.45],[-7.23,39.28],[-7.05,38.91],[-7.26,38.72],[-7.27,38.43],[-7.1,38.17],[-6.95,38.2],[-7.34,37.98],[-7.5,37.55],[-7.4,37.17],[-7.93,37],[-8.68,37.08],[-8.99,37.02],[-8.79,37.9],[-8.87,38.1],[-8.78,38.4],[-9.21,38.41],[-9.5,38.78],[-9.35,39.36],[-9.05,39.75],[-8.87,40.15],[-8.7,40.65],[-8.68,41.15],[-8.87,41.87]]]]}},
+	{"type":"Feature","properties":{"country_code":"RO"},"geometry":{"type":"MultiPolygon","coordinates":[[[[22.89,47.95],[22.3,47.73],[22,47.4],[21.65,47.05],[21.45,46.7],[21.25,46.42],[20.73,46.17],[20.26,46.11],[20.72,45.75],[20.85,45.48],[21.45,45.18],[21.38,44.81],[22,44.55],[22.4,44.72],[22.7,44.55],[22.68,44.21],[23,43.85],[23.7,43.8],[24.5,43.7],[25.35,43.63],[25.97,43.87],[26.7,44.08],[27.27,44.12],[27.95,43.98],[28.58,43.74],[28.66,44.17],[28.8,44.5],[29.05,44.8],[29.65,45],[29.7,45.3],[29.26,45.43],[28.84,45.32],[28.22,45.47],[28.1,45.95],[28.23,46.45],[28,46.95],[27.85,47.17],[27.4,47.65],[26.9,48.1],[26.63,48.26],[26.18,47.99],[25.5,47.93],[24.9,47.72],[24.4,47.95],[23.89,47.94],[23.35,48.01],[22.89,47.95]]]]}},
+	{"type":"Feature","properties":{"country_code":"SE"},"geometry":{"type":"MultiPolygon","coordinates":[[[[24.15,65.82],[23.9,66.3],[23.65,66.5],[23.9,67.1],[23.5,67.9],[22.8,68.4],[21.7,68.8],[20.55,69.06],[20.3,68.5],[19.95,68.35],[18.1,68.45],[17.9,68],[16.8,67.9],[16.4,67],[15.4,66.25],[14.5,65.9],[13.65,65.1],[14.1,64.45],[13.2,64.05],[12.15,63.6],[12.05,63],[12.3,62.3],[12.2,62],[12.85,61.35],[12.25,61.05],[12.5,60.15],[11.8,59.85],[11.25,59.08],[11.6,58.25],[11.85,57.7],[12.25,57.11],[12.85,56.65],[12.45,56.3],[12.68,56.05],[12.95,55.6],[13.16,55.37],[13.82,55.43],[14.35,55.55],[14.7,56.15],[15.6,56.15],[16,56.2],[16.4,56.65],[16.64,57.76],[16.7,58],[16.4,58.55],[17,58.7],[18,58.95],[18.9,59.4],[18.9,59.8],[18.4,60.35],[17.25,60.7],[17.15,60.8],[17.3,61.7],[17.45,62.35],[17.9,62.6],[18.6,63.1],[19.4,63.5],[20.4,63.7],[21.2,64.7],[21.5,65.3],[22.2,65.55],[24.15,65.82]]],[[[18.1,57.45],[18.2,57.62],[18.7,57.9],[19.3,57.95],[18.8,57.45],[18.7,57.1],[18.35,56.9],[18.1,57.2],[18.1,57.45]]],[[[16.4,56.2],[16.6,56.6],[16.85,57],[17.1,57.35],[16.95,56.95],[16.7,56.5],[16.45,56.2],[16.4,56.2]]]]}},
+	{"type":"Feature","properties":{"country_code":"SI"},"geometry":{"type":"MultiPolygon","coordinates":[[[[13.72,45.59],[13.6,45.52],[13.59,45.48],[13.9,45.45],[14.25,45.5],[14.65,45.63],[15.3,45.46],[15.35,45.72],[15.68,45.84],[15.7,46.2],[16,46.31],[16.3,46.38],[16.6,46.48],[16.42,46.63],[16.11,46.87],[16,46.68],[15.65,46.71],[15.05,46.65],[14.8,46.5],[14.55,46.38],[14,46.47],[13.71,46.52],[13.45,46.35],[13.38,46.22],[13.66,46.04],[13.63,45.93],[13.6,45.82],[13.92,45.64],[13.72,45.59]]]]}},
+	{"type":"Feature","properties":{"country_code":"SK"},"geometry":{"type":"MultiPolygon","coordinates":[[[[16.94,48.62],[17.2,48.87],[17.55,48.82],[17.9,48.98],[18.15,49.28],[18.55,49.45],[18.85,49.52],[19.18,49.41],[19.47,49.6],[19.78,49.2],[20.06,49.17],[20.35,49.4],[20.92,49.3],[21.28,49.45],[21.95,49.38],[22.56,49.09],[22.4,48.85],[22.25,48.62],[22.15,48.41],[21.6,48.5],[21.2,48.52],[20.8,48.57],[20.3,48.27],[19.9,48.15],[19.5,48.2],[19,48.07],[18.85,47.95],[18.75,47.81],[18.13,47.76],[17.75,47.75],[17.16,48.01],[17.05,48.1],[16.98,48.2],[16.85,48.37],[16.94,48.62]]]]}},
+	{"type":"Feature","properties":{"country_code":"SM"},"geometry":{"type":"MultiPolygon","coordinates":[[[[12.4,43.95],[12.45,43.89],[12.52,43.92],[12.5,43.99],[12.43,43.99],[12.4,43.95]]]]}},
+	{"type":"Feature","properties":{"country_code":"VA"},"geometry":{"type":"MultiPolygon","coordinates":[[[[12.446,41.9],[12.458,41.901],[12.458,41.907],[12.446,41.906],[12.446,41.9]]]]}}
+]}`
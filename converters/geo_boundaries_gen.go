@@ -0,0 +1,200 @@
+//go:build ignore
+// +build ignore
+
+// geo_boundaries_gen.go generates geo_boundaries.go from the Natural Earth 1:50m admin 0 countries.
+// Only the polygons which overlap with Europe are kept and coordinates are rounded to 3 decimals (about 100 m).
+//
+// Usage:
+//
+//	go generate ./converters
+//	go run geo_boundaries_gen.go -input ne_50m_admin_0_countries.geojson
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"strings"
+)
+
+// naturalEarthURL is the Natural Earth dataset (public domain) used when no input file is provided
+const naturalEarthURL = "https://raw.githubusercontent.com/nvkelso/natural-earth-vector/v5.1.2/geojson/ne_50m_admin_0_countries.geojson"
+
+// Polygons which overlap with this box are kept. Includes the Azores, Iceland, Svalbard and the Urals.
+const minLongitude, maxLongitude = -32.0, 45.0
+const minLatitude, maxLatitude = 34.0, 82.0
+
+type featureCollection struct {
+	Features []feature `json:"features"`
+}
+
+type feature struct {
+	Properties map[string]interface{} `json:"properties"`
+	Geometry   struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	} `json:"geometry"`
+}
+
+func main() {
+	input := flag.String("input", "", "Path to the Natural Earth GeoJSON, downloaded if empty")
+	output := flag.String("output", "geo_boundaries.go", "Path to the generated Go file")
+	flag.Parse()
+	log.SetFlags(0)
+
+	// Read dataset
+	data, err := readDataset(*input)
+	if err != nil {
+		log.Fatalf("Failed to read dataset: %v", err)
+	}
+	var collection featureCollection
+	if err := json.Unmarshal(data, &collection); err != nil {
+		log.Fatalf("Failed to parse dataset: %v", err)
+	}
+
+	// Convert features
+	lines := []string{}
+	for _, feature := range collection.Features {
+		countryCode := featureCountryCode(feature.Properties)
+		if countryCode == "" {
+			continue
+		}
+		polygons, err := featurePolygons(feature)
+		if err != nil {
+			log.Fatalf("Failed to parse geometry of %s: %v", countryCode, err)
+		}
+		polygons = simplifyPolygons(polygons)
+		if len(polygons) == 0 {
+			continue
+		}
+		coordinates, err := json.Marshal(polygons)
+		if err != nil {
+			log.Fatalf("Failed to marshal geometry of %s: %v", countryCode, err)
+		}
+		line := fmt.Sprintf(`	{"type":"Feature","properties":{"country_code":%q},"geometry":{"type":"MultiPolygon","coordinates":%s}}`, countryCode, coordinates)
+		lines = append(lines, line)
+	}
+
+	// Write Go file
+	source := &bytes.Buffer{}
+	fmt.Fprintln(source, "// Code generated by geo_boundaries_gen.go; DO NOT EDIT.")
+	fmt.Fprintln(source)
+	fmt.Fprintln(source, "package converters")
+	fmt.Fprintln(source)
+	fmt.Fprintln(source, "// countryBoundariesGeoJSON contains the Natural Earth 1:50m boundaries (public domain)")
+	fmt.Fprintln(source, "// of the countries with territory in Europe. Overseas regions are not included.")
+	fmt.Fprintf(source, "const countryBoundariesGeoJSON = `{\"type\":\"FeatureCollection\",\"features\":[\n%s\n]}`\n", strings.Join(lines, ",\n"))
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to format source: %v", err)
+	}
+	if err := ioutil.WriteFile(*output, formatted, 0644); err != nil {
+		log.Fatalf("Failed to write %s: %v", *output, err)
+	}
+	log.Printf("Wrote %d countries to %s", len(lines), *output)
+}
+
+// readDataset reads the dataset from the path or downloads it if the path is empty
+func readDataset(path string) ([]byte, error) {
+	if path != "" {
+		return ioutil.ReadFile(path)
+	}
+	response, err := http.Get(naturalEarthURL)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d for %s", response.StatusCode, naturalEarthURL)
+	}
+	return ioutil.ReadAll(response.Body)
+}
+
+// featureCountryCode returns the country code of the feature.
+// Natural Earth uses "-99" for ISO_A2 of some countries (e.g. France and Norway), ISO_A2_EH is filled in for those.
+func featureCountryCode(properties map[string]interface{}) string {
+	for _, property := range []string{"ISO_A2_EH", "ISO_A2"} {
+		countryCode, _ := properties[property].(string)
+		if len(countryCode) == 2 {
+			return strings.ToUpper(countryCode)
+		}
+	}
+	return ""
+}
+
+// featurePolygons returns the polygons of a Polygon or MultiPolygon geometry
+func featurePolygons(feature feature) ([][][][]float64, error) {
+	switch feature.Geometry.Type {
+	case "Polygon":
+		var polygon [][][]float64
+		err := json.Unmarshal(feature.Geometry.Coordinates, &polygon)
+		return [][][][]float64{polygon}, err
+	case "MultiPolygon":
+		var polygons [][][][]float64
+		err := json.Unmarshal(feature.Geometry.Coordinates, &polygons)
+		return polygons, err
+	default:
+		return nil, fmt.Errorf("unsupported geometry type %q", feature.Geometry.Type)
+	}
+}
+
+// simplifyPolygons removes the polygons outside of Europe, rounds the coordinates
+// and removes the rings which collapsed after rounding
+func simplifyPolygons(polygons [][][][]float64) [][][][]float64 {
+	result := [][][][]float64{}
+	for _, polygon := range polygons {
+		if len(polygon) == 0 || !overlapsEurope(polygon[0]) {
+			continue
+		}
+		simplified := [][][]float64{}
+		for i, ring := range polygon {
+			ring = roundRing(ring)
+			if len(ring) < 4 {
+				if i == 0 {
+					break // Exterior collapsed
+				}
+				continue // Hole collapsed
+			}
+			simplified = append(simplified, ring)
+		}
+		if len(simplified) > 0 {
+			result = append(result, simplified)
+		}
+	}
+	return result
+}
+
+// overlapsEurope returns true if the bounding box of the ring overlaps with the Europe box
+func overlapsEurope(ring [][]float64) bool {
+	west, south, east, north := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, position := range ring {
+		west, east = math.Min(west, position[0]), math.Max(east, position[0])
+		south, north = math.Min(south, position[1]), math.Max(north, position[1])
+	}
+	return west <= maxLongitude && east >= minLongitude && south <= maxLatitude && north >= minLatitude
+}
+
+// roundRing rounds the positions to 3 decimals and removes consecutive duplicates
+func roundRing(ring [][]float64) [][]float64 {
+	result := make([][]float64, 0, len(ring))
+	for _, position := range ring {
+		rounded := []float64{math.Round(position[0]*1000) / 1000, math.Round(position[1]*1000) / 1000}
+		if len(result) > 0 {
+			last := result[len(result)-1]
+			if last[0] == rounded[0] && last[1] == rounded[1] {
+				continue
+			}
+		}
+		result = append(result, rounded)
+	}
+	if len(result) > 0 && (result[0][0] != result[len(result)-1][0] || result[0][1] != result[len(result)-1][1]) {
+		result = append(result, result[0]) // Close ring again
+	}
+	return result
+}
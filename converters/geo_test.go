@@ -0,0 +1,279 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGeofenceGeoJSON = `{
+	"type": "FeatureCollection",
+	"features": [{
+		"type": "Feature",
+		"properties": {"name": "Brussels"},
+		"geometry": {
+			"type": "Polygon",
+			"coordinates": [
+				[[4.24, 50.76], [4.48, 50.76], [4.48, 50.92], [4.24, 50.92], [4.24, 50.76]],
+				[[4.34, 50.84], [4.36, 50.84], [4.36, 50.86], [4.34, 50.86], [4.34, 50.84]]
+			]
+		}
+	}]
+}`
+
+var testBrussels = Coordinate{Latitude: 50.8467, Longitude: 4.3525}
+var testParis = Coordinate{Latitude: 48.8566, Longitude: 2.3522}
+
+func Test_Coordinate_IsValid(t *testing.T) {
+	assert.True(t, testBrussels.IsValid())
+	assert.True(t, Coordinate{Latitude: -90, Longitude: 180}.IsValid())
+	assert.False(t, Coordinate{Latitude: 91, Longitude: 0}.IsValid())
+	assert.False(t, Coordinate{Latitude: 0, Longitude: -181}.IsValid())
+}
+
+func Test_Coordinate_DistanceTo_Success(t *testing.T) {
+	distance := testBrussels.DistanceTo(testParis)
+	assert.Equal(t, DistanceUnitMeter, distance.Unit)
+	assert.InDelta(t, 264000, distance.Value, 1000)
+	assert.Equal(t, 0.0, testBrussels.DistanceTo(testBrussels).Value)
+}
+
+func Test_NewBoundingBox_Success(t *testing.T) {
+	box := NewBoundingBox(testBrussels, testParis)
+	expected := BoundingBox{
+		SouthWest: Coordinate{Latitude: 48.8566, Longitude: 2.3522},
+		NorthEast: Coordinate{Latitude: 50.8467, Longitude: 4.3525},
+	}
+	assert.Equal(t, expected, box)
+	assert.True(t, box.Contains(Coordinate{Latitude: 50, Longitude: 3}))
+	assert.False(t, box.Contains(Coordinate{Latitude: 51, Longitude: 3}))
+	assert.Equal(t, BoundingBox{}, NewBoundingBox())
+}
+
+func Test_BoundingBoxAround_Success(t *testing.T) {
+	box, genErr := BoundingBoxAround(testBrussels, Distance{Value: 10, Unit: DistanceUnitKilometer})
+	require.Nil(t, genErr)
+	assert.InDelta(t, 50.7568, box.SouthWest.Latitude, 0.001)
+	assert.InDelta(t, 50.9366, box.NorthEast.Latitude, 0.001)
+	assert.InDelta(t, 4.2105, box.SouthWest.Longitude, 0.001)
+	assert.InDelta(t, 4.4945, box.NorthEast.Longitude, 0.001)
+}
+
+func Test_BoundingBoxAround_UnsupportedUnit_Failure(t *testing.T) {
+	_, genErr := BoundingBoxAround(testBrussels, Distance{Value: 10, Unit: "parsec"})
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedUnit, map[string]string{"unit": "parsec"})
+}
+
+func Test_ParseGeofences_FeatureCollection_Success(t *testing.T) {
+	geofences, genErr := ParseGeofences([]byte(testGeofenceGeoJSON))
+	require.Nil(t, genErr)
+	require.Len(t, geofences, 1)
+	geofence := geofences[0]
+	assert.Equal(t, "Brussels", geofence.Properties["name"])
+	assert.True(t, geofence.Contains(Coordinate{Latitude: 50.80, Longitude: 4.30}))
+	assert.False(t, geofence.Contains(testBrussels)) // Inside hole
+	assert.False(t, geofence.Contains(testParis))
+	assert.Equal(t, NewBoundingBox(Coordinate{50.76, 4.24}, Coordinate{50.92, 4.48}), geofence.BoundingBox())
+}
+
+func Test_ParseGeofences_Geometry_Success(t *testing.T) {
+	geoJSON := `{"type": "MultiPolygon", "coordinates": [
+		[[[0, 0], [1, 0], [1, 1], [0, 0]]],
+		[[[10, 10], [11, 10], [11, 11], [10, 10]]]
+	]}`
+	geofences, genErr := ParseGeofences([]byte(geoJSON))
+	require.Nil(t, genErr)
+	require.Len(t, geofences, 1)
+	assert.Nil(t, geofences[0].Properties)
+	assert.Len(t, geofences[0].Polygons, 2)
+	assert.True(t, geofences[0].Contains(Coordinate{Latitude: 10.2, Longitude: 10.8}))
+}
+
+func Test_ParseGeofences_Invalid_Failure(t *testing.T) {
+	tests := map[string]string{
+		"invalid json":     `{"type":`,
+		"unsupported":      `{"type": "Point", "coordinates": [4.35, 50.85]}`,
+		"not closed":       `{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 1]]]}`,
+		"too short":        `{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [0, 0]]]}`,
+		"out of range":     `{"type": "Polygon", "coordinates": [[[0, 0], [1, 100], [1, 1], [0, 0]]]}`,
+		"missing geometry": `{"type": "FeatureCollection", "features": [{"type": "Feature"}]}`,
+	}
+
+	for name, geoJSON := range tests {
+		_, genErr := ParseGeofences([]byte(geoJSON))
+		require.NotNil(t, genErr, name)
+		assert.Equal(t, 400, genErr.Code, name)
+		assert.Equal(t, ErrorInvalidGeoJSON, genErr.SubDomainCode, name)
+	}
+}
+
+// testCountryBoundariesGeoJSON uses the properties of Natural Earth: France has no ISO_A2 and
+// the last feature has no country code. The Belgian enclave is a hole in the Netherlands.
+const testCountryBoundariesGeoJSON = `{"type": "FeatureCollection", "features": [
+	{"type": "Feature", "properties": {"ADMIN": "Belgium", "ISO_A2": "BE", "ISO_A2_EH": "BE"}, "geometry": {"type": "MultiPolygon", "coordinates": [
+		[[[2, 50], [5, 50], [5, 51], [2, 51], [2, 50]]],
+		[[[4.9, 51.4], [5, 51.4], [5, 51.5], [4.9, 51.5], [4.9, 51.4]]]
+	]}},
+	{"type": "Feature", "properties": {"ADMIN": "Netherlands", "ISO_A2": "NL", "ISO_A2_EH": "NL"}, "geometry": {"type": "Polygon", "coordinates": [
+		[[3, 51], [7, 51], [7, 53], [3, 53], [3, 51]],
+		[[4.9, 51.4], [5, 51.4], [5, 51.5], [4.9, 51.5], [4.9, 51.4]]
+	]}},
+	{"type": "Feature", "properties": {"ADMIN": "France", "ISO_A2": "-99", "ISO_A2_EH": "FR"}, "geometry": {"type": "MultiPolygon", "coordinates": [
+		[[[-1, 43], [2, 43], [2, 50], [-1, 50], [-1, 43]]],
+		[[[8.5, 41.5], [9.5, 41.5], [9.5, 43], [8.5, 43], [8.5, 41.5]]]
+	]}},
+	{"type": "Feature", "properties": {"ADMIN": "Disputed", "ISO_A2": "-99", "ISO_A2_EH": "-99"}, "geometry": {"type": "Polygon", "coordinates": [
+		[[33, 35], [34, 35], [34, 35.5], [33, 35.5], [33, 35]]
+	]}}
+]}`
+
+// testLoadCountryBoundaries loads the boundaries and restores the previous boundaries after the test
+func testLoadCountryBoundaries(t *testing.T, geoJSON string) {
+	previous := getCountryBoundaries()
+	t.Cleanup(func() {
+		countryBoundariesMutex.Lock()
+		defer countryBoundariesMutex.Unlock()
+		countryBoundaries = previous
+	})
+	require.Nil(t, LoadCountryBoundaries([]byte(geoJSON)))
+}
+
+func Test_LoadCountryBoundaries_Success(t *testing.T) {
+	testLoadCountryBoundaries(t, testCountryBoundariesGeoJSON)
+	assert.Equal(t, []string{"BE", "FR", "NL"}, CountryBoundaryCodes())
+
+	tests := []struct {
+		name       string
+		coordinate Coordinate
+		expected   string
+	}{
+		{"Belgium", Coordinate{50.5, 3.5}, "BE"},
+		{"Netherlands", Coordinate{52, 5}, "NL"},
+		{"Enclave", Coordinate{51.45, 4.95}, "BE"},
+		{"France", Coordinate{47, 1}, "FR"},
+		{"Corsica", Coordinate{42.2, 9}, "FR"},
+	}
+	for _, test := range tests {
+		result, genErr := CoordinateToCountryCode(test.coordinate)
+		require.Nil(t, genErr, test.name)
+		assert.Equal(t, test.expected, result, test.name)
+	}
+}
+
+func Test_LoadCountryBoundaries_WithoutCountryCode_NotFound_Failure(t *testing.T) {
+	testLoadCountryBoundaries(t, testCountryBoundariesGeoJSON)
+	_, genErr := CoordinateToCountryCode(Coordinate{Latitude: 35.2, Longitude: 33.5})
+	expectedMeta := map[string]string{"latitude": "35.2", "longitude": "33.5"}
+	errors.AssertGenericError(t, genErr, 404, ErrorCountryNotFound, expectedMeta)
+}
+
+func Test_LoadCountryBoundaries_Invalid_Failure(t *testing.T) {
+	testLoadCountryBoundaries(t, testCountryBoundariesGeoJSON)
+	genErr := LoadCountryBoundaries([]byte(`{"type": "FeatureCollection", "features": [{"type": "Feature"}]}`))
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidGeoJSON, map[string]string{"reason": "expected feature with geometry"})
+	assert.Equal(t, []string{"BE", "FR", "NL"}, CountryBoundaryCodes()) // Previous boundaries are kept
+}
+
+func Test_CoordinateToCountryCode_NotLoaded_Failure(t *testing.T) {
+	testLoadCountryBoundaries(t, `{"type": "FeatureCollection", "features": []}`)
+	assert.Empty(t, CountryBoundaryCodes())
+	_, genErr := CoordinateToCountryCode(testBrussels)
+	errors.AssertGenericError(t, genErr, 500, ErrorCountryBoundariesNotLoaded, nil)
+}
+
+func Test_CountryBoundariesGeoJSON_Success(t *testing.T) {
+	set, genErr := parseCountryBoundaries([]byte(countryBoundariesGeoJSON))
+	require.Nil(t, genErr)
+	expected := []string{
+		"AD", "AT", "BE", "BG", "CH", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GB", "GR", "HR", "HU", "IE",
+		"IS", "IT", "LI", "LT", "LU", "LV", "MC", "MT", "NL", "NO", "PL", "PT", "RO", "SE", "SI", "SK", "SM", "VA",
+	}
+	assert.Equal(t, expected, set.countryCodes)
+}
+
+// Test_CoordinateToCountryCode_Bundled_Success checks the bundled boundaries near borders and coasts
+func Test_CoordinateToCountryCode_Bundled_Success(t *testing.T) {
+	tests := []struct {
+		name       string
+		coordinate Coordinate
+		expected   string
+	}{
+		{"Brussels", Coordinate{50.8467, 4.3525}, "BE"},
+		{"Antwerp", Coordinate{51.2194, 4.4025}, "BE"},
+		{"Liège", Coordinate{50.6326, 5.5797}, "BE"},
+		{"Ostend", Coordinate{51.2050, 2.9250}, "BE"},
+		{"Arlon", Coordinate{49.6833, 5.8167}, "BE"},
+		{"Eupen", Coordinate{50.6275, 6.0364}, "BE"},
+		{"Amsterdam", Coordinate{52.3676, 4.9041}, "NL"},
+		{"Groningen", Coordinate{53.2194, 6.5665}, "NL"},
+		{"Maastricht", Coordinate{50.8514, 5.6910}, "NL"},
+		{"Luxembourg", Coordinate{49.6116, 6.1319}, "LU"},
+		{"Paris", Coordinate{48.8566, 2.3522}, "FR"},
+		{"Lille", Coordinate{50.6292, 3.0573}, "FR"},
+		{"Strasbourg", Coordinate{48.5734, 7.7521}, "FR"},
+		{"Bastia", Coordinate{42.6977, 9.4350}, "FR"},
+		{"Ajaccio", Coordinate{41.9350, 8.7400}, "FR"},
+		{"Berlin", Coordinate{52.5200, 13.4050}, "DE"},
+		{"Munich", Coordinate{48.1351, 11.5820}, "DE"},
+		{"Cologne", Coordinate{50.9375, 6.9603}, "DE"},
+		{"Aachen", Coordinate{50.7753, 6.0839}, "DE"},
+		{"Geneva", Coordinate{46.2044, 6.1432}, "CH"},
+		{"Basel", Coordinate{47.5596, 7.5886}, "CH"},
+		{"Zurich", Coordinate{47.3769, 8.5417}, "CH"},
+		{"Milan", Coordinate{45.4642, 9.1900}, "IT"},
+		{"Ventimiglia", Coordinate{43.8000, 7.5900}, "IT"},
+		{"Madrid", Coordinate{40.4168, -3.7038}, "ES"},
+		{"Barcelona", Coordinate{41.3874, 2.1686}, "ES"},
+		{"Vienna", Coordinate{48.2082, 16.3738}, "AT"},
+		{"Salzburg", Coordinate{47.8095, 13.0550}, "AT"},
+		{"Lisbon", Coordinate{38.7223, -9.1393}, "PT"},
+		{"London", Coordinate{51.5074, -0.1278}, "GB"},
+		{"Belfast", Coordinate{54.5973, -5.9301}, "GB"},
+		{"Dublin", Coordinate{53.3498, -6.2603}, "IE"},
+		{"Copenhagen", Coordinate{55.6761, 12.5683}, "DK"},
+		{"Oslo", Coordinate{59.9139, 10.7522}, "NO"},
+		{"Stockholm", Coordinate{59.3293, 18.0686}, "SE"},
+		{"Helsinki", Coordinate{60.1699, 24.9384}, "FI"},
+		{"Warsaw", Coordinate{52.2297, 21.0122}, "PL"},
+		{"Prague", Coordinate{50.0755, 14.4378}, "CZ"},
+		{"Athens", Coordinate{37.9838, 23.7275}, "GR"},
+		{"Valletta", Coordinate{35.8989, 14.5146}, "MT"},
+		{"Monaco", Coordinate{43.7384, 7.4246}, "MC"},
+		{"Vatican City", Coordinate{41.9022, 12.4539}, "VA"},
+		{"Rome", Coordinate{41.9028, 12.4964}, "IT"},
+	}
+	for _, test := range tests {
+		result, genErr := CoordinateToCountryCode(test.coordinate)
+		require.Nil(t, genErr, test.name)
+		assert.Equal(t, test.expected, result, test.name)
+	}
+
+	// Atlantic Ocean
+	_, genErr := CoordinateToCountryCode(Coordinate{Latitude: 45, Longitude: -20})
+	errors.AssertGenericError(t, genErr, 404, ErrorCountryNotFound, map[string]string{"latitude": "45", "longitude": "-20"})
+}
+
+func Test_CoordinateToCountryCode_Bundled_NotFound_Failure(t *testing.T) {
+	tests := []struct {
+		name       string
+		coordinate Coordinate
+	}{
+		{"North Sea", Coordinate{54.5, 3}},
+		{"Minsk", Coordinate{53.9006, 27.5590}},
+		{"New York", Coordinate{40.7128, -74.0060}},
+	}
+	for _, test := range tests {
+		_, genErr := CoordinateToCountryCode(test.coordinate)
+		require.NotNil(t, genErr, test.name)
+		assert.Equal(t, 404, genErr.Code, test.name)
+		assert.Equal(t, ErrorCountryNotFound, genErr.SubDomainCode, test.name)
+	}
+}
+
+func Test_CoordinateToCountryCode_InvalidCoordinate_Failure(t *testing.T) {
+	_, genErr := CoordinateToCountryCode(Coordinate{Latitude: 95, Longitude: 4})
+	expectedMeta := map[string]string{"latitude": "95", "longitude": "4"}
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidCoordinate, expectedMeta)
+}
@@ -0,0 +1,118 @@
+//go:build ignore
+// +build ignore
+
+// han_pinyin_gen.go generates han_pinyin.go with the pinyin of the common Chinese characters.
+// Common characters are the hanzi of GB 2312 (simplified) and the level 1 hanzi of Big5 (traditional).
+// Readings are taken from the ICU Han-Latin transliterator without tones, so uconv (ICU) should be installed.
+//
+// Usage:
+//
+//	go generate ./converters
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+// syllableRegex matches a single pinyin syllable without tones
+var syllableRegex = regexp.MustCompile(`^[a-z]+$`)
+
+func main() {
+	log.SetFlags(0)
+
+	// Collect common characters
+	characters := map[rune]bool{}
+	collect(characters, simplifiedchinese.GBK, 0xB0, 0xF7, [][2]byte{{0xA1, 0xFE}})
+	collect(characters, traditionalchinese.Big5, 0xA4, 0xC6, [][2]byte{{0x40, 0x7E}, {0xA1, 0xFE}})
+	runes := make([]rune, 0, len(characters))
+	for r := range characters {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	// Transliterate with ICU, one character per line
+	input := &strings.Builder{}
+	for _, r := range runes {
+		input.WriteString(string(r) + "\n")
+	}
+	command := exec.Command("uconv", "-x", "Han-Latin; Latin-ASCII; Lower")
+	command.Stdin = strings.NewReader(input.String())
+	output, err := command.Output()
+	if err != nil {
+		log.Fatalf("Failed to run uconv: %v", err)
+	}
+	readings := strings.Split(strings.TrimSuffix(string(output), "\n"), "\n")
+	if len(readings) != len(runes) {
+		log.Fatalf("Expected %d readings, got %d", len(runes), len(readings))
+	}
+
+	// Group characters per syllable
+	syllables := map[string][]rune{}
+	for i, r := range runes {
+		reading := strings.TrimSpace(readings[i])
+		if !syllableRegex.MatchString(reading) {
+			continue // No reading available
+		}
+		syllables[reading] = append(syllables[reading], r)
+	}
+	keys := make([]string, 0, len(syllables))
+	for syllable := range syllables {
+		keys = append(keys, syllable)
+	}
+	sort.Strings(keys)
+
+	// Write Go file
+	source := &bytes.Buffer{}
+	fmt.Fprintln(source, "// Code generated by han_pinyin_gen.go; DO NOT EDIT.")
+	fmt.Fprintln(source)
+	fmt.Fprintln(source, "package converters")
+	fmt.Fprintln(source)
+	fmt.Fprintln(source, "// hanPinyinSyllables contains the common Chinese characters (GB 2312 and Big5 level 1)")
+	fmt.Fprintln(source, "// per pinyin syllable without tones, based on the ICU Han-Latin transliterator.")
+	fmt.Fprintln(source, "var hanPinyinSyllables = map[string]string{")
+	for _, syllable := range keys {
+		fmt.Fprintf(source, "\t%q: %q,\n", syllable, string(syllables[syllable]))
+	}
+	fmt.Fprintln(source, "}")
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to format source: %v", err)
+	}
+	if err := ioutil.WriteFile("han_pinyin.go", formatted, 0644); err != nil {
+		log.Fatalf("Failed to write han_pinyin.go: %v", err)
+	}
+	log.Printf("Wrote %d characters in %d syllables to han_pinyin.go", len(runes), len(keys))
+}
+
+// collect decodes all double byte characters in the lead and trail byte ranges and adds the Han characters
+func collect(characters map[rune]bool, charset encoding.Encoding, leadFrom byte, leadTo byte, trailRanges [][2]byte) {
+	decoder := charset.NewDecoder()
+	for lead := int(leadFrom); lead <= int(leadTo); lead++ {
+		for _, trailRange := range trailRanges {
+			for trail := int(trailRange[0]); trail <= int(trailRange[1]); trail++ {
+				decoded, err := decoder.Bytes([]byte{byte(lead), byte(trail)})
+				if err != nil {
+					continue
+				}
+				for _, r := range string(decoded) {
+					if unicode.Is(unicode.Han, r) {
+						characters[r] = true
+					}
+				}
+			}
+		}
+	}
+}
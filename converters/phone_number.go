@@ -2,10 +2,97 @@ package converters
 
 import (
 	"regexp"
+	"strconv"
+	"strings"
 
+	"github.com/nyaruka/phonenumbers"
 	"github.com/skiprco/go-utils/v2/errors"
 )
 
+// PhoneNumberFormat is the notation used to format a phone number
+type PhoneNumberFormat string
+
+const (
+	// PhoneNumberFormatE164 formats the number without spaces, including country code (e.g. +32468300431)
+	PhoneNumberFormatE164 PhoneNumberFormat = "e164"
+
+	// PhoneNumberFormatInternational formats the number with spaces, including country code (e.g. +32 468 30 04 31)
+	PhoneNumberFormatInternational PhoneNumberFormat = "international"
+
+	// PhoneNumberFormatNational formats the number as dialed within the country (e.g. 0468 30 04 31)
+	PhoneNumberFormatNational PhoneNumberFormat = "national"
+
+	// PhoneNumberFormatRFC3966 formats the number as a tel URI (e.g. tel:+32-468-30-04-31)
+	PhoneNumberFormatRFC3966 PhoneNumberFormat = "rfc3966"
+)
+
+var phoneNumberFormats = map[PhoneNumberFormat]phonenumbers.PhoneNumberFormat{
+	PhoneNumberFormatE164:          phonenumbers.E164,
+	PhoneNumberFormatInternational: phonenumbers.INTERNATIONAL,
+	PhoneNumberFormatNational:      phonenumbers.NATIONAL,
+	PhoneNumberFormatRFC3966:       phonenumbers.RFC3966,
+}
+
+// PhoneNumberType is the kind of line of a phone number
+type PhoneNumberType string
+
+// Supported phone number types
+const (
+	PhoneNumberTypeMobile            PhoneNumberType = "mobile"
+	PhoneNumberTypeFixedLine         PhoneNumberType = "fixed_line"
+	PhoneNumberTypeFixedLineOrMobile PhoneNumberType = "fixed_line_or_mobile"
+	PhoneNumberTypeTollFree          PhoneNumberType = "toll_free"
+	PhoneNumberTypePremiumRate       PhoneNumberType = "premium_rate"
+	PhoneNumberTypeSharedCost        PhoneNumberType = "shared_cost"
+	PhoneNumberTypeVoIP              PhoneNumberType = "voip"
+	PhoneNumberTypePersonalNumber    PhoneNumberType = "personal_number"
+	PhoneNumberTypePager             PhoneNumberType = "pager"
+	PhoneNumberTypeUAN               PhoneNumberType = "uan"
+	PhoneNumberTypeVoicemail         PhoneNumberType = "voicemail"
+	PhoneNumberTypeUnknown           PhoneNumberType = "unknown"
+)
+
+var phoneNumberTypes = map[phonenumbers.PhoneNumberType]PhoneNumberType{
+	phonenumbers.MOBILE:               PhoneNumberTypeMobile,
+	phonenumbers.FIXED_LINE:           PhoneNumberTypeFixedLine,
+	phonenumbers.FIXED_LINE_OR_MOBILE: PhoneNumberTypeFixedLineOrMobile,
+	phonenumbers.TOLL_FREE:            PhoneNumberTypeTollFree,
+	phonenumbers.PREMIUM_RATE:         PhoneNumberTypePremiumRate,
+	phonenumbers.SHARED_COST:          PhoneNumberTypeSharedCost,
+	phonenumbers.VOIP:                 PhoneNumberTypeVoIP,
+	phonenumbers.PERSONAL_NUMBER:      PhoneNumberTypePersonalNumber,
+	phonenumbers.PAGER:                PhoneNumberTypePager,
+	phonenumbers.UAN:                  PhoneNumberTypeUAN,
+	phonenumbers.VOICEMAIL:            PhoneNumberTypeVoicemail,
+}
+
+// PhoneNumberInfo contains the details of a valid phone number
+type PhoneNumberInfo struct {
+	// E164 is the number in E.164 format (e.g. +32468300431)
+	E164 string
+
+	// CallingCode is the international calling code, without plus sign (e.g. 32)
+	CallingCode int
+
+	// RegionCode is the ISO 3166-1 alpha-2 code of the region of the number (e.g. BE)
+	RegionCode string
+
+	// NationalNumber is the national significant number, without leading zero (e.g. 468300431)
+	NationalNumber string
+
+	// Type is the kind of line (e.g. mobile)
+	Type PhoneNumberType
+
+	// Carrier is the English name of the original carrier of the number.
+	// Empty if unknown. Be aware the number might have been ported to another carrier.
+	Carrier string
+}
+
+const phoneNumberInvalidCountryCodeMessage = "invalid country code"
+
+var phoneNumberCandidateRegex = regexp.MustCompile(`(?:\+|\(?\d)[\d\s().\-/]{5,}\d`)
+var cleanPhoneNumberRegex = regexp.MustCompile(`[^\+\d]`)
+
 // CleanPhoneNumber remove all non necessary code of a phone number
 // Be aware that remove all non numeric char except the sign '+'
 //
@@ -13,6 +100,162 @@ import (
 //
 // Nothing: This function will never raise an error
 func CleanPhoneNumber(phoneNumber string) (string, *errors.GenericError) {
-	regExpression := regexp.MustCompile(`[^\+\d]`)
-	return regExpression.ReplaceAllLiteralString(phoneNumber, ""), nil
+	return cleanPhoneNumberRegex.ReplaceAllLiteralString(phoneNumber, ""), nil
+}
+
+// parsePhoneNumber parses and validates the phone number.
+// The country code is only required if the number doesn't include the calling code (e.g. +32).
+//
+// Raises
+//
+// - 400/invalid_country_code: Provided country code is invalid or missing
+//
+// - 400/not_a_phone_number: Provided phone number is not recognised as one
+//
+// - 400/invalid_phone_number: Provided phone number has the correct format, but is semantically incorrect
+func parsePhoneNumber(phoneNumber string, countryCode string) (*phonenumbers.PhoneNumber, *errors.GenericError) {
+	meta := map[string]string{"country_code": countryCode}
+	parsed, err := phonenumbers.Parse(phoneNumber, strings.ToUpper(countryCode))
+	if err != nil {
+		if err.Error() == phoneNumberInvalidCountryCodeMessage {
+			return nil, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidCountryCode, meta)
+		}
+		return nil, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorNotAPhoneNumber, meta)
+	}
+	if !phonenumbers.IsValidNumber(parsed) {
+		return nil, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidPhoneNumber, meta)
+	}
+	return parsed, nil
+}
+
+// FormatPhoneNumber validates the phone number and formats it in the requested format.
+// The country code is only required if the number doesn't include the calling code (e.g. +32).
+//
+// Raises
+//
+// - 400/unsupported_phone_number_format: Provided format is not supported
+//
+// - 400/invalid_country_code: Provided country code is invalid or missing
+//
+// - 400/not_a_phone_number: Provided phone number is not recognised as one
+//
+// - 400/invalid_phone_number: Provided phone number has the correct format, but is semantically incorrect
+func FormatPhoneNumber(phoneNumber string, countryCode string, format PhoneNumberFormat) (string, *errors.GenericError) {
+	// Validate format
+	libFormat, supported := phoneNumberFormats[format]
+	if !supported {
+		meta := map[string]string{"format": string(format)}
+		return "", errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorUnsupportedPhoneNumberFormat, meta)
+	}
+
+	// Parse and format number
+	parsed, genErr := parsePhoneNumber(phoneNumber, countryCode)
+	if genErr != nil {
+		return "", genErr
+	}
+	return phonenumbers.Format(parsed, libFormat), nil
+}
+
+// ParsePhoneNumber validates the phone number and returns its details.
+// The country code is only required if the number doesn't include the calling code (e.g. +32).
+//
+// Raises
+//
+// - 400/invalid_country_code: Provided country code is invalid or missing
+//
+// - 400/not_a_phone_number: Provided phone number is not recognised as one
+//
+// - 400/invalid_phone_number: Provided phone number has the correct format, but is semantically incorrect
+func ParsePhoneNumber(phoneNumber string, countryCode string) (PhoneNumberInfo, *errors.GenericError) {
+	// Parse number
+	parsed, genErr := parsePhoneNumber(phoneNumber, countryCode)
+	if genErr != nil {
+		return PhoneNumberInfo{}, genErr
+	}
+
+	// Map type
+	numberType, exists := phoneNumberTypes[phonenumbers.GetNumberType(parsed)]
+	if !exists {
+		numberType = PhoneNumberTypeUnknown
+	}
+
+	// Build info
+	carrier, _ := phonenumbers.GetCarrierForNumber(parsed, "en")
+	return PhoneNumberInfo{
+		E164:           phonenumbers.Format(parsed, phonenumbers.E164),
+		CallingCode:    int(parsed.GetCountryCode()),
+		RegionCode:     phonenumbers.GetRegionCodeForNumber(parsed),
+		NationalNumber: phonenumbers.GetNationalSignificantNumber(parsed),
+		Type:           numberType,
+		Carrier:        carrier,
+	}, nil
+}
+
+// FindPhoneNumbers searches all valid phone numbers in a free text.
+// Numbers without calling code are parsed for the provided country code.
+// Returns the unique numbers in E.164 format, in order of appearance.
+//
+// Raises
+//
+// Nothing: This function will never raise an error
+func FindPhoneNumbers(text string, countryCode string) []string {
+	result := []string{}
+	found := map[string]bool{}
+	for _, candidate := range phoneNumberCandidateRegex.FindAllString(text, -1) {
+		parsed, genErr := parsePhoneNumber(candidate, countryCode)
+		if genErr != nil {
+			continue
+		}
+		e164 := phonenumbers.Format(parsed, phonenumbers.E164)
+		if !found[e164] {
+			found[e164] = true
+			result = append(result, e164)
+		}
+	}
+	return result
+}
+
+// MaskPhoneNumber masks a phone number to be safely written to logs.
+// Valid numbers are formatted in international format, only keeping the calling code,
+// the first digit of the national number and the last 2 digits (e.g. +32 4** ** ** 31).
+// For invalid numbers, all digits except the last 2 are masked.
+//
+// Raises
+//
+// Nothing: This function will never raise an error
+func MaskPhoneNumber(phoneNumber string, countryCode string) string {
+	parsed, genErr := parsePhoneNumber(phoneNumber, countryCode)
+	if genErr != nil {
+		cleaned, _ := CleanPhoneNumber(phoneNumber)
+		return maskPhoneNumberDigits(cleaned, 0)
+	}
+	formatted := phonenumbers.Format(parsed, phonenumbers.INTERNATIONAL)
+	callingCode := strconv.Itoa(int(parsed.GetCountryCode()))
+	return maskPhoneNumberDigits(formatted, len(callingCode)+1)
+}
+
+// maskPhoneNumberDigits replaces all digits with an asterisk, except the first keepFirst
+// and the last 2 digits. All other characters are kept to preserve the formatting.
+func maskPhoneNumberDigits(phoneNumber string, keepFirst int) string {
+	// Count digits
+	digitCount := 0
+	for _, r := range phoneNumber {
+		if r >= '0' && r <= '9' {
+			digitCount++
+		}
+	}
+
+	// Mask digits
+	var builder strings.Builder
+	index := 0
+	for _, r := range phoneNumber {
+		if r >= '0' && r <= '9' {
+			if index >= keepFirst && index < digitCount-2 {
+				r = '*'
+			}
+			index++
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
 }
@@ -1,9 +1,11 @@
 package converters
 
 import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"testing"
 )
 
 func Test_CleanPhoneNumber_success(t *testing.T) {
@@ -29,3 +31,73 @@ func Test_CleanPhoneNumberWithEmpty_success(t *testing.T) {
 	assert.Equal(t, "", result)
 	require.Nil(t, err)
 }
+
+func Test_FormatPhoneNumber_Success(t *testing.T) {
+	tests := []struct {
+		format   PhoneNumberFormat
+		expected string
+	}{
+		{PhoneNumberFormatE164, "+32468300431"},
+		{PhoneNumberFormatInternational, "+32 468 30 04 31"},
+		{PhoneNumberFormatNational, "0468 30 04 31"},
+		{PhoneNumberFormatRFC3966, "tel:+32-468-30-04-31"},
+	}
+
+	for _, test := range tests {
+		result, genErr := FormatPhoneNumber("0468/30.04.31", "BE", test.format)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.expected, result)
+	}
+}
+
+func Test_FormatPhoneNumber_UnsupportedFormat_Failure(t *testing.T) {
+	_, genErr := FormatPhoneNumber("+32468300431", "", "unknown")
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedPhoneNumberFormat, map[string]string{"format": "unknown"})
+}
+
+func Test_FormatPhoneNumber_InvalidPhoneNumber_Failure(t *testing.T) {
+	_, genErr := FormatPhoneNumber("0468300431", "", PhoneNumberFormatE164)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidCountryCode, map[string]string{"country_code": ""})
+
+	_, genErr = FormatPhoneNumber("0461", "BE", PhoneNumberFormatE164)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidPhoneNumber, map[string]string{"country_code": "BE"})
+}
+
+func Test_ParsePhoneNumber_Success(t *testing.T) {
+	result, genErr := ParsePhoneNumber("+33 6 23 83 96 79", "BE")
+	require.Nil(t, genErr)
+	assert.Equal(t, "+33623839679", result.E164)
+	assert.Equal(t, 33, result.CallingCode)
+	assert.Equal(t, "FR", result.RegionCode)
+	assert.Equal(t, "623839679", result.NationalNumber)
+	assert.Equal(t, PhoneNumberTypeMobile, result.Type)
+
+	result, genErr = ParsePhoneNumber("02 789 61 43", "BE")
+	require.Nil(t, genErr)
+	assert.Equal(t, PhoneNumberTypeFixedLine, result.Type)
+}
+
+func Test_FindPhoneNumbers_Success(t *testing.T) {
+	text := "Call me on 0468 30 04 31 or +33 6 23 83 96 79. Booking 12345678 costs 25.00 EUR. Again: +32468300431"
+	result := FindPhoneNumbers(text, "BE")
+	assert.Equal(t, []string{"+32468300431", "+33623839679"}, result)
+}
+
+func Test_FindPhoneNumbers_NoNumbers_Success(t *testing.T) {
+	assert.Equal(t, []string{}, FindPhoneNumbers("No numbers here", "BE"))
+}
+
+func Test_MaskPhoneNumber_Success(t *testing.T) {
+	assert.Equal(t, "+32 4** ** ** 31", MaskPhoneNumber("0468300431", "BE"))
+}
+
+func Test_MaskPhoneNumber_InvalidPhoneNumber_Success(t *testing.T) {
+	assert.Equal(t, "+******31", MaskPhoneNumber("+1234-5631", ""))
+	assert.Equal(t, "", MaskPhoneNumber("", ""))
+}
+
+func Test_maskPhoneNumberDigits_Success(t *testing.T) {
+	assert.Equal(t, "+32 4** ** ** 31", maskPhoneNumberDigits("+32 468 30 04 31", 3))
+	assert.Equal(t, "+1 6**-***-**99", maskPhoneNumberDigits("+1 650-253-0099", 2))
+	assert.Equal(t, "12", maskPhoneNumberDigits("12", 3))
+}
@@ -3,19 +3,22 @@ package converters
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
-	"unicode"
+	"sync"
 
 	"github.com/microcosm-cc/bluemonday"
 	log "github.com/sirupsen/logrus"
 	"github.com/skiprco/go-utils/v2/errors"
 )
 
+var strictPolicy = bluemonday.StrictPolicy()
+
 // Sanitize removes all HTML tags from the input and escapes entities.
 // Following entities are excluded from escaping: ' (apos), " (quote), &
 func Sanitize(input string) string {
 	// Sanitize input
-	output := bluemonday.StrictPolicy().Sanitize(input)
+	output := strictPolicy.Sanitize(input)
 
 	// Restore some characters
 	output = strings.ReplaceAll(output, "&#39;", "'")
@@ -23,20 +26,41 @@ func Sanitize(input string) string {
 	return strings.ReplaceAll(output, "&amp;", "&")
 }
 
-// SanitizeObject takes a pointer to an object (struct, map, slice, ...) as input
+// SanitizeObject takes a pointer to an object (struct, map, slice, array, ...) as input
 // and runs Sanitize for each field which is a (pointer to a) string.
+// The policy of a struct field and its children can be changed with the sanitize
+// struct tag (e.g. `sanitize:"ugc"` or `sanitize:"-"`). See SanitizeTag.
+//
+// Exported fields and fields of embedded structs are sanitized. Map keys are sanitized as well.
+// Each pointer, map and slice is only sanitized once, so cyclic objects are supported.
+// If the same pointer is reachable through fields with a different policy, the first policy is used.
+//
+// Raises
+//
+// - 500/input_is_not_a_pointer: Provided input is not a pointer
 //
-// Note: A pointer to an interface with a non-pointer struct value "&interface{obj}" is
-// not supported. In this case you should convert it to an interface with a pointer
-// to a struct value "interface{&obj}" before calling this helper.
-// See "mongo.Save" for an example how to convert between these two.
+// - 500/unknown_sanitize_policy: A sanitize struct tag contains an unknown policy
+//
+// - 400/sanitize_key_collision: Multiple keys of a map are equal after sanitation
+//
+// - 500/panic_during_sanitize_object: A panic occured during sanitation
+func SanitizeObject(input interface{}) *errors.GenericError {
+	return SanitizeObjectWithPolicy(input, SanitizePolicyStrict)
+}
+
+// SanitizeObjectWithPolicy is equal to SanitizeObject, but uses the provided policy
+// for all strings which are not covered by a sanitize struct tag.
 //
 // Raises
 //
 // - 500/input_is_not_a_pointer: Provided input is not a pointer
 //
+// - 500/unknown_sanitize_policy: Provided policy or a sanitize struct tag contains an unknown policy
+//
+// - 400/sanitize_key_collision: Multiple keys of a map are equal after sanitation
+//
 // - 500/panic_during_sanitize_object: A panic occured during sanitation
-func SanitizeObject(input interface{}) (genErr *errors.GenericError) {
+func SanitizeObjectWithPolicy(input interface{}, policyName string) (genErr *errors.GenericError) {
 	// Validate type of input
 	inputType := reflect.TypeOf(input)
 	if inputType.Kind() != reflect.Ptr {
@@ -45,6 +69,12 @@ func SanitizeObject(input interface{}) (genErr *errors.GenericError) {
 		return errors.NewGenericError(500, "go-utils", "common", ErrorInputIsNotPointer, meta)
 	}
 
+	// Fetch policy
+	policy, genErr := getSanitizePolicy(policyName)
+	if genErr != nil {
+		return genErr
+	}
+
 	// Convert panic to correct error
 	defer func() {
 		if r := recover(); r != nil {
@@ -58,46 +88,182 @@ func SanitizeObject(input interface{}) (genErr *errors.GenericError) {
 	}()
 
 	// Start traverse
-	inputValue := reflect.ValueOf(input).Elem()
-	sanitizeTraverse(inputValue)
+	s := sanitizer{visited: map[sanitizeVisit]bool{}}
+	return s.traverse(reflect.ValueOf(input), policy)
+}
 
-	// Sanitize successful
-	return nil
+// sanitizePlan contains the precalculated traversal info of a type
+type sanitizePlan struct {
+	// containsStrings is false if the type can never contain a string => Traversal can be skipped
+	containsStrings bool
+
+	// fields contains the struct fields which should be traversed
+	fields []sanitizePlanField
+}
+
+type sanitizePlanField struct {
+	index      int
+	policyName string
+	hasPolicy  bool
+}
+
+// sanitizePlans caches a *sanitizePlan per reflect.Type
+var sanitizePlans sync.Map
+
+// getSanitizePlan returns the cached plan of the type or builds a new one
+func getSanitizePlan(t reflect.Type) *sanitizePlan {
+	if plan, exists := sanitizePlans.Load(t); exists {
+		return plan.(*sanitizePlan)
+	}
+	return buildSanitizePlan(t, map[reflect.Type]bool{})
+}
+
+// buildSanitizePlan builds and caches the plan of the type.
+// Recursive types are assumed to contain strings.
+func buildSanitizePlan(t reflect.Type, building map[reflect.Type]bool) *sanitizePlan {
+	// Check cache and recursion
+	if plan, exists := sanitizePlans.Load(t); exists {
+		return plan.(*sanitizePlan)
+	}
+	if building[t] {
+		return &sanitizePlan{containsStrings: true}
+	}
+	building[t] = true
+	defer delete(building, t)
+
+	// Build plan
+	plan := &sanitizePlan{}
+	switch t.Kind() {
+	case reflect.String, reflect.Interface:
+		plan.containsStrings = true
+
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		plan.containsStrings = buildSanitizePlan(t.Elem(), building).containsStrings
+
+	case reflect.Map:
+		plan.containsStrings = buildSanitizePlan(t.Key(), building).containsStrings ||
+			buildSanitizePlan(t.Elem(), building).containsStrings
+
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			policyName, hasPolicy := field.Tag.Lookup(SanitizeTag)
+			if !isSanitizableField(field) || policyName == "-" || !buildSanitizePlan(field.Type, building).containsStrings {
+				continue
+			}
+			plan.fields = append(plan.fields, sanitizePlanField{index: i, policyName: policyName, hasPolicy: hasPolicy})
+		}
+		plan.containsStrings = len(plan.fields) > 0
+	}
+
+	// Store plan
+	sanitizePlans.Store(t, plan)
+	return plan
+}
+
+// isSanitizableField checks if the field can be set.
+// This is the case for exported fields and embedded structs, which can contain exported fields.
+func isSanitizableField(field reflect.StructField) bool {
+	if field.PkgPath == "" {
+		return true
+	}
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	return field.Anonymous && fieldType.Kind() == reflect.Struct
+}
+
+// sanitizeVisit identifies a visited pointer, map or slice
+type sanitizeVisit struct {
+	pointer uintptr
+	length  int
+	typ     reflect.Type
+}
+
+// sanitizer contains the state of a single SanitizeObject call
+type sanitizer struct {
+	visited map[sanitizeVisit]bool
 }
 
-// sanitizeTraverse is a recursive function which sanitizes each child of the provided input
-func sanitizeTraverse(input reflect.Value) *errors.GenericError {
-	// Unpack if interface or pointer
-	if input.Kind() == reflect.Interface || input.Kind() == reflect.Ptr {
-		input = input.Elem()
+// visit marks the pointer, map or slice as visited.
+// Returns false if the value was already visited.
+func (s sanitizer) visit(input reflect.Value) bool {
+	key := sanitizeVisit{pointer: input.Pointer(), typ: input.Type()}
+	if input.Kind() == reflect.Slice {
+		key.length = input.Len()
+	}
+	if s.visited[key] {
+		return false
+	}
+	s.visited[key] = true
+	return true
+}
+
+// traverse is a recursive function which sanitizes each child of the provided input
+func (s sanitizer) traverse(input reflect.Value, policy SanitizePolicy) *errors.GenericError {
+	if !input.IsValid() || !getSanitizePlan(input.Type()).containsStrings {
+		return nil
 	}
 
 	// Check type and proceed traverse
 	switch input.Kind() {
 	case reflect.String:
-		input.SetString(Sanitize(input.String()))
+		if cleaned := policy.Sanitize(input.String()); cleaned != input.String() {
+			input.SetString(cleaned)
+		}
+
+	case reflect.Ptr:
+		if !input.IsNil() && s.visit(input) {
+			return s.traverse(input.Elem(), policy)
+		}
+
+	case reflect.Interface:
+		// Values inside an interface are not addressable => Sanitize a copy
+		if input.IsNil() || !getSanitizePlan(input.Elem().Type()).containsStrings {
+			return nil
+		}
+		cleaned, genErr := s.copy(input.Elem(), policy)
+		if genErr != nil {
+			return genErr
+		}
+		if input.Elem().Kind() != reflect.Ptr {
+			input.Set(cleaned)
+		}
 
 	case reflect.Struct:
-		for i := 0; i < input.NumField(); i++ {
-			name := input.Type().Field(i).Name
-			if isExported(name) {
-				// Field is exported => Continue traversal
-				genErr := sanitizeTraverse(input.Field(i))
+		for _, field := range getSanitizePlan(input.Type()).fields {
+			// Select policy
+			fieldPolicy := policy
+			if field.hasPolicy {
+				var genErr *errors.GenericError
+				fieldPolicy, genErr = getSanitizePolicy(field.policyName)
 				if genErr != nil {
 					return genErr
 				}
 			}
+
+			// Continue traversal
+			genErr := s.traverse(input.Field(field.index), fieldPolicy)
+			if genErr != nil {
+				return genErr
+			}
 		}
 
 	case reflect.Map:
-		genErr := sanitizeMap(input)
-		if genErr != nil {
-			return genErr
+		if !input.IsNil() && s.visit(input) {
+			return s.sanitizeMap(input, policy)
 		}
 
 	case reflect.Slice:
+		if input.IsNil() || !s.visit(input) {
+			return nil
+		}
+		fallthrough
+
+	case reflect.Array:
 		for i := 0; i < input.Len(); i++ {
-			genErr := sanitizeTraverse(input.Index(i))
+			genErr := s.traverse(input.Index(i), policy)
 			if genErr != nil {
 				return genErr
 			}
@@ -108,53 +274,92 @@ func sanitizeTraverse(input reflect.Value) *errors.GenericError {
 	return nil
 }
 
-func sanitizeMap(input reflect.Value) *errors.GenericError {
-	for _, k := range input.MapKeys() {
-		// Clean copy of key and value
-		cleanedKey := sanitizeCopy(k)
-		value := input.MapIndex(k)
-		cleanedValue := sanitizeCopy(value)
+// sanitizeMap sanitizes all keys and values of the map.
+// The map is only updated if no keys collide after sanitation.
+//
+// Raises
+//
+// - 400/sanitize_key_collision: Multiple keys are equal after sanitation
+func (s sanitizer) sanitizeMap(input reflect.Value, policy SanitizePolicy) *errors.GenericError {
+	type mapEntry struct {
+		key   reflect.Value
+		value reflect.Value
+		dirty bool
+	}
 
-		// Delete old key from map
-		input.SetMapIndex(k, reflect.Value{})
+	// Sanitize keys and values
+	sanitizeKeys := getSanitizePlan(input.Type().Key()).containsStrings
+	sanitizeValues := getSanitizePlan(input.Type().Elem()).containsStrings
+	removedKeys := []reflect.Value{}
+	entries := make([]mapEntry, 0, input.Len())
+	keyCounts := map[interface{}]int{}
+	iter := input.MapRange()
+	for iter.Next() {
+		entry := mapEntry{key: iter.Key(), value: iter.Value()}
+		if sanitizeKeys {
+			cleanedKey, genErr := s.copy(entry.key, policy)
+			if genErr != nil {
+				return genErr
+			}
+			if cleanedKey.Interface() != entry.key.Interface() {
+				removedKeys = append(removedKeys, entry.key)
+				entry.key = cleanedKey
+				entry.dirty = true
+			}
+			keyCounts[entry.key.Interface()]++
+		}
+		if sanitizeValues && entry.value.Kind() != reflect.Ptr {
+			cleanedValue, genErr := s.copy(entry.value, policy)
+			if genErr != nil {
+				return genErr
+			}
+			entry.value = cleanedValue
+			entry.dirty = true
+		} else if sanitizeValues {
+			if genErr := s.traverse(entry.value, policy); genErr != nil {
+				return genErr
+			}
+		}
+		entries = append(entries, entry)
+	}
 
-		// Write new key/value to map
-		input.SetMapIndex(cleanedKey, cleanedValue)
+	// Check for collisions
+	collisions := []string{}
+	for key, count := range keyCounts {
+		if count > 1 {
+			collisions = append(collisions, fmt.Sprintf("%v", key))
+		}
+	}
+	if len(collisions) > 0 {
+		sort.Strings(collisions)
+		meta := map[string]string{"key": collisions[0]}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorSanitizeKeyCollision, meta)
+	}
+
+	// Update map
+	for _, key := range removedKeys {
+		input.SetMapIndex(key, reflect.Value{})
+	}
+	for _, entry := range entries {
+		if entry.dirty {
+			input.SetMapIndex(entry.key, entry.value)
+		}
 	}
 
 	// Sanitize successful
 	return nil
 }
 
-// sanitizeCopy takes a Value which has "CanSet() == false",
-// makes a copy, sanitizes the copy and returns this copy.
+// copy sanitizes a Value which has "CanSet() == false", e.g. a value from a map or interface.
+// Pointers are sanitized in place and returned as is. Other values are copied
+// before sanitizing and the sanitized copy is returned.
 //
-// This is needed when you want to sanitize e.g. a value from a map.
 // See https://golang.org/pkg/reflect/#Value.CanSet for more info.
-func sanitizeCopy(input reflect.Value) reflect.Value {
-	var cleaned reflect.Value
+func (s sanitizer) copy(input reflect.Value, policy SanitizePolicy) (reflect.Value, *errors.GenericError) {
 	if input.Kind() == reflect.Ptr {
-		cleaned = reflect.New(input.Type())
-		if !input.IsNil() {
-			cleanedValue := reflect.New(input.Elem().Type())
-			cleanedValue.Elem().Set(input.Elem())
-			sanitizeTraverse(cleanedValue)
-			cleaned.Elem().Set(cleanedValue)
-		}
-	} else {
-		cleaned = reflect.New(input.Type())
-		cleaned.Elem().Set(input)
-		sanitizeTraverse(cleaned)
-	}
-	return cleaned.Elem()
-}
-
-// isExported checks if the provided field name is exported
-// by checking if the first letter is capital
-func isExported(input string) bool {
-	// Based on https://stackoverflow.com/a/30263910
-	for _, rune := range input {
-		return unicode.IsUpper(rune)
+		return input, s.traverse(input, policy)
 	}
-	return false
+	cleaned := reflect.New(input.Type()).Elem()
+	cleaned.Set(input)
+	return cleaned, s.traverse(cleaned, policy)
 }
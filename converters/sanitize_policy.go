@@ -0,0 +1,96 @@
+package converters
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// Names of the built-in sanitization policies
+const (
+	// SanitizePolicyStrict removes all HTML tags. See Sanitize. Used by default.
+	SanitizePolicyStrict = "strict"
+
+	// SanitizePolicyUGC only keeps safe HTML tags and attributes for user generated content (e.g. <b>, <a href>)
+	SanitizePolicyUGC = "ugc"
+
+	// SanitizePolicyNone leaves the input untouched (e.g. for passwords or regex patterns)
+	SanitizePolicyNone = "none"
+)
+
+// SanitizeTag is the struct tag to select the sanitization policy of a field and its children
+// (e.g. `sanitize:"ugc"`). Value "-" is equal to SanitizePolicyNone.
+const SanitizeTag = "sanitize"
+
+// SanitizePolicy sanitizes a single string.
+// A *bluemonday.Policy can be used as SanitizePolicy as well.
+type SanitizePolicy interface {
+	Sanitize(input string) string
+}
+
+// SanitizePolicyFunc converts a function to a SanitizePolicy
+type SanitizePolicyFunc func(input string) string
+
+// Sanitize calls the function
+func (f SanitizePolicyFunc) Sanitize(input string) string {
+	return f(input)
+}
+
+var ugcPolicy = bluemonday.UGCPolicy()
+
+// sanitizeUGC only keeps safe HTML tags and attributes and escapes entities.
+// Like Sanitize, ' (apos) and & are excluded from escaping. " (quote) stays escaped,
+// since it could end an attribute value (e.g. title="&#34; onclick=&#34;...").
+func sanitizeUGC(input string) string {
+	output := ugcPolicy.Sanitize(input)
+	output = strings.ReplaceAll(output, "&#39;", "'")
+	return strings.ReplaceAll(output, "&amp;", "&")
+}
+
+var sanitizePolicies = map[string]SanitizePolicy{
+	SanitizePolicyStrict: SanitizePolicyFunc(Sanitize),
+	SanitizePolicyUGC:    SanitizePolicyFunc(sanitizeUGC),
+	SanitizePolicyNone:   SanitizePolicyFunc(func(input string) string { return input }),
+	"-":                  SanitizePolicyFunc(func(input string) string { return input }),
+}
+var sanitizePoliciesLock sync.RWMutex
+
+// RegisterSanitizePolicy registers a custom policy, so it can be used in the sanitize
+// struct tag and SanitizeObjectWithPolicy. A policy with the same name is replaced.
+// Policies should be registered on startup and must be safe for concurrent use.
+func RegisterSanitizePolicy(name string, policy SanitizePolicy) {
+	sanitizePoliciesLock.Lock()
+	defer sanitizePoliciesLock.Unlock()
+	sanitizePolicies[name] = policy
+}
+
+// getSanitizePolicy returns the registered policy with the provided name
+//
+// Raises
+//
+// - 500/unknown_sanitize_policy: No policy registered with the provided name
+func getSanitizePolicy(name string) (SanitizePolicy, *errors.GenericError) {
+	sanitizePoliciesLock.RLock()
+	defer sanitizePoliciesLock.RUnlock()
+	policy, exists := sanitizePolicies[name]
+	if !exists {
+		meta := map[string]string{"policy": name}
+		return nil, errors.NewGenericError(500, errorDomain, errorSubDomain, ErrorUnknownSanitizePolicy, meta)
+	}
+	return policy, nil
+}
+
+// SanitizeWithPolicy sanitizes the input with the registered policy
+//
+// Raises
+//
+// - 500/unknown_sanitize_policy: No policy registered with the provided name
+func SanitizeWithPolicy(input string, policyName string) (string, *errors.GenericError) {
+	policy, genErr := getSanitizePolicy(policyName)
+	if genErr != nil {
+		return "", genErr
+	}
+	return policy.Sanitize(input), nil
+}
@@ -1,6 +1,8 @@
 package converters
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/skiprco/go-utils/v2/errors"
@@ -171,3 +173,208 @@ func Test_SanitizeObject_NotPointer_Failure(t *testing.T) {
 	genErr := SanitizeObject(input)
 	errors.AssertGenericError(t, genErr, 500, ErrorInputIsNotPointer, nil)
 }
+
+func Test_SanitizeWithPolicy_Success(t *testing.T) {
+	tests := []struct {
+		policy   string
+		expected string
+	}{
+		{SanitizePolicyStrict, "Test"},
+		{SanitizePolicyUGC, "<b>Test</b>"},
+		{SanitizePolicyNone, "<b>Test</b><script>alert()</script>"},
+	}
+
+	for _, test := range tests {
+		result, genErr := SanitizeWithPolicy("<b>Test</b><script>alert()</script>", test.policy)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.expected, result, test.policy)
+	}
+}
+
+func Test_SanitizeWithPolicy_UGCEntities_Success(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"<b>Tom & Jerry's</b>", "<b>Tom & Jerry's</b>"},
+		{`<a href="https://skipr.co?a=1&b=2" rel="nofollow">Link</a>`, `<a href="https://skipr.co?a=1&b=2" rel="nofollow">Link</a>`},
+		{"&lt;script&gt;", "&lt;script&gt;"},
+		{`<b>Say "hi"</b>`, "<b>Say &#34;hi&#34;</b>"}, // Quotes stay escaped
+	}
+
+	for _, test := range tests {
+		result, genErr := SanitizeWithPolicy(test.input, SanitizePolicyUGC)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.expected, result, test.input)
+	}
+}
+
+func Test_SanitizeWithPolicy_UnknownPolicy_Failure(t *testing.T) {
+	_, genErr := SanitizeWithPolicy("Test", "unknown")
+	errors.AssertGenericError(t, genErr, 500, ErrorUnknownSanitizePolicy, map[string]string{"policy": "unknown"})
+}
+
+func Test_SanitizeObject_StructTag_Success(t *testing.T) {
+	type Note struct {
+		Author string
+		Body   string `sanitize:"ugc"`
+	}
+	type Account struct {
+		Name     string
+		Password string `sanitize:"-"`
+		Pattern  string `sanitize:"none"`
+		Notes    []Note `sanitize:"none"`
+		Note     Note
+	}
+
+	input := Account{
+		Name:     "<p>Test</p>",
+		Password: "<p>Test</p>",
+		Pattern:  "^<[a-z]+>$",
+		Notes:    []Note{{Author: "<p>Test</p>", Body: "<b>Test</b><script></script>"}},
+		Note:     Note{Author: "<p>Test</p>", Body: "<b>Test</b><script></script>"},
+	}
+	expected := Account{
+		Name:     "Test",
+		Password: "<p>Test</p>",
+		Pattern:  "^<[a-z]+>$",
+		Notes:    []Note{{Author: "<p>Test</p>", Body: "<b>Test</b>"}}, // Tag on field overrides parent policy
+		Note:     Note{Author: "Test", Body: "<b>Test</b>"},
+	}
+	genErr := SanitizeObject(&input)
+	require.Nil(t, genErr)
+	assert.Equal(t, expected, input)
+}
+
+func Test_SanitizeObject_CustomPolicy_Success(t *testing.T) {
+	RegisterSanitizePolicy("upper", SanitizePolicyFunc(strings.ToUpper))
+	type Item struct {
+		Code string `sanitize:"upper"`
+	}
+
+	input := Item{Code: "<p>test</p>"}
+	genErr := SanitizeObject(&input)
+	require.Nil(t, genErr)
+	assert.Equal(t, Item{Code: "<P>TEST</P>"}, input)
+}
+
+func Test_SanitizeObject_UnknownTagPolicy_Failure(t *testing.T) {
+	type Item struct {
+		Code string `sanitize:"unknown"`
+	}
+
+	input := Item{Code: "<p>Test</p>"}
+	genErr := SanitizeObject(&input)
+	errors.AssertGenericError(t, genErr, 500, ErrorUnknownSanitizePolicy, map[string]string{"policy": "unknown"})
+}
+
+func Test_SanitizeObjectWithPolicy_Success(t *testing.T) {
+	input := map[string]string{"key": "<b>Test</b><script></script>"}
+	genErr := SanitizeObjectWithPolicy(&input, SanitizePolicyUGC)
+	require.Nil(t, genErr)
+	assert.Equal(t, map[string]string{"key": "<b>Test</b>"}, input)
+}
+
+func Test_SanitizeObjectWithPolicy_UnknownPolicy_Failure(t *testing.T) {
+	input := "Test"
+	genErr := SanitizeObjectWithPolicy(&input, "unknown")
+	errors.AssertGenericError(t, genErr, 500, ErrorUnknownSanitizePolicy, nil)
+}
+
+func Test_SanitizeObject_Array_Success(t *testing.T) {
+	input := [2]string{"<p>Test</p>", "Test"}
+	genErr := SanitizeObject(&input)
+	require.Nil(t, genErr)
+	assert.Equal(t, [2]string{"Test", "Test"}, input)
+}
+
+type sanitizeEmbedded struct {
+	Name    string
+	private string
+}
+
+func Test_SanitizeObject_UnexportedEmbedded_Success(t *testing.T) {
+	type Item struct {
+		sanitizeEmbedded
+	}
+	type Ptr struct {
+		*sanitizeEmbedded
+	}
+
+	input := Item{sanitizeEmbedded: sanitizeEmbedded{Name: "<p>Test</p>", private: "<p>Test</p>"}}
+	genErr := SanitizeObject(&input)
+	require.Nil(t, genErr)
+	assert.Equal(t, "Test", input.Name)
+	assert.Equal(t, "<p>Test</p>", input.private) // Private fields are unreachable
+
+	inputPtr := Ptr{&sanitizeEmbedded{Name: "<p>Test</p>"}}
+	genErr = SanitizeObject(&inputPtr)
+	require.Nil(t, genErr)
+	assert.Equal(t, "Test", inputPtr.Name)
+}
+
+func Test_SanitizeObject_Cycle_Success(t *testing.T) {
+	type Node struct {
+		Name string
+		Next *Node
+	}
+
+	first := &Node{Name: "<p>First</p>"}
+	first.Next = &Node{Name: "<p>Second</p>", Next: first}
+	genErr := SanitizeObject(first)
+	require.Nil(t, genErr)
+	assert.Equal(t, "First", first.Name)
+	assert.Equal(t, "Second", first.Next.Name)
+
+	input := map[string]interface{}{"name": "<p>Test</p>"}
+	input["self"] = input
+	genErr = SanitizeObject(&input)
+	require.Nil(t, genErr)
+	assert.Equal(t, "Test", input["name"])
+}
+
+func Test_SanitizeObject_Interface_Success(t *testing.T) {
+	type Item struct {
+		Name string
+	}
+
+	input := map[string]interface{}{
+		"item":   Item{Name: "<p>Test</p>"},
+		"slice":  []interface{}{"<p>Test</p>", 8},
+		"number": 8,
+	}
+	expected := map[string]interface{}{
+		"item":   Item{Name: "Test"},
+		"slice":  []interface{}{"Test", 8},
+		"number": 8,
+	}
+	genErr := SanitizeObject(&input)
+	require.Nil(t, genErr)
+	assert.Equal(t, expected, input)
+}
+
+func Test_SanitizeObject_KeyCollision_Failure(t *testing.T) {
+	input := map[string]string{"<b>Test</b>": "1", "<i>Test</i>": "2", "Other": "<p>3</p>"}
+	genErr := SanitizeObject(&input)
+	errors.AssertGenericError(t, genErr, 400, ErrorSanitizeKeyCollision, map[string]string{"key": "Test"})
+
+	// Map should be untouched
+	assert.Equal(t, map[string]string{"<b>Test</b>": "1", "<i>Test</i>": "2", "Other": "<p>3</p>"}, input)
+}
+
+func Test_SanitizeObject_PlanCached_Success(t *testing.T) {
+	type Item struct {
+		Name   string
+		Count  int
+		Hidden string `sanitize:"-"`
+	}
+
+	input := []Item{{Name: "<p>Test</p>", Count: 8, Hidden: "<p>Test</p>"}}
+	genErr := SanitizeObject(&input)
+	require.Nil(t, genErr)
+	assert.Equal(t, []Item{{Name: "Test", Count: 8, Hidden: "<p>Test</p>"}}, input)
+
+	plan, exists := sanitizePlans.Load(reflect.TypeOf(Item{}))
+	require.True(t, exists)
+	assert.Equal(t, []sanitizePlanField{{index: 0}}, plan.(*sanitizePlan).fields)
+}
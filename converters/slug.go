@@ -0,0 +1,168 @@
+package converters
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// SlugOptions defines how a slug is generated
+type SlugOptions struct {
+	// Separator between words. Defaults to "-".
+	Separator string
+
+	// MaxLength is the maximum length of the slug. The slug is cut at a
+	// word boundary where possible. Zero means no maximum.
+	MaxLength int
+
+	// StopWords are removed from the slug, ignoring casing and accents
+	// (e.g. SlugStopWords["en"]). Stop words are kept if the slug would be empty otherwise.
+	StopWords []string
+
+	// MaxAttempts is the maximum number of slugs tried by UniqueSlug. Defaults to 100.
+	MaxAttempts int
+}
+
+// DefaultSlugOptions contains the default options to generate a slug
+var DefaultSlugOptions = SlugOptions{Separator: "-", MaxAttempts: 100}
+
+// SlugStopWords contains common stop words per language
+var SlugStopWords = map[string][]string{
+	"en": {"a", "an", "and", "at", "by", "for", "in", "of", "on", "or", "the", "to", "with"},
+	"fr": {"a", "au", "aux", "de", "des", "du", "en", "et", "l", "la", "le", "les", "ou", "un", "une"},
+	"nl": {"de", "een", "en", "het", "in", "met", "of", "op", "te", "van", "voor"},
+	"de": {"am", "an", "das", "der", "die", "ein", "eine", "im", "in", "mit", "oder", "und", "von", "zu"},
+}
+
+// Slugify converts the input to a URL friendly slug (e.g. "Café & Crème" => "cafe-creme").
+// Input is transliterated to Latin letters (see Transliterate) and converted to lower case.
+// All characters which are not a letter a-z or digit are considered separators.
+//
+// Raises
+//
+// - 400/failed_to_normalise_string: Provided input could not be normalised
+func Slugify(input string, options SlugOptions) (string, *errors.GenericError) {
+	// Transliterate input
+	latin, genErr := Transliterate(input)
+	if genErr != nil {
+		return "", genErr
+	}
+
+	// Split in words
+	words := splitSlugWords(latin)
+
+	// Remove stop words
+	if len(options.StopWords) > 0 {
+		stopWords := map[string]bool{}
+		for _, stopWord := range options.StopWords {
+			for _, word := range splitSlugWords(stopWord) {
+				stopWords[word] = true
+			}
+		}
+		filtered := []string{}
+		for _, word := range words {
+			if !stopWords[word] {
+				filtered = append(filtered, word)
+			}
+		}
+		if len(filtered) > 0 {
+			words = filtered
+		}
+	}
+
+	// Build slug
+	separator := options.Separator
+	if separator == "" {
+		separator = DefaultSlugOptions.Separator
+	}
+	return truncateSlug(strings.Join(words, separator), separator, options.MaxLength), nil
+}
+
+// SlugExistsFunc checks if a slug is already in use (e.g. by calling IMongoRepository.Count)
+type SlugExistsFunc func(slug string) (bool, *errors.GenericError)
+
+// UniqueSlug generates a slug with Slugify which isn't in use yet according to the exists check.
+// If the slug is already in use, a suffix is appended (e.g. "my-title-2", "my-title-3", ...).
+// The suffix is taken into account for options.MaxLength.
+//
+// Raises
+//
+// - 400/failed_to_normalise_string: Provided input could not be normalised
+//
+// - 400/empty_slug: Slug is empty after removing all unsupported characters
+//
+// - 409/slug_not_unique: No unused slug found within options.MaxAttempts
+//
+// - Any error returned by the exists check
+func UniqueSlug(input string, options SlugOptions, exists SlugExistsFunc) (string, *errors.GenericError) {
+	// Generate base slug
+	slug, genErr := Slugify(input, options)
+	if genErr != nil {
+		return "", genErr
+	}
+	if slug == "" {
+		meta := map[string]string{"input": input}
+		return "", errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorEmptySlug, meta)
+	}
+
+	// Parse options
+	separator := options.Separator
+	if separator == "" {
+		separator = DefaultSlugOptions.Separator
+	}
+	maxAttempts := options.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultSlugOptions.MaxAttempts
+	}
+
+	// Search unused slug
+	candidate := slug
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if attempt > 1 {
+			suffix := separator + strconv.Itoa(attempt)
+			maxLength := 0
+			if options.MaxLength > 0 {
+				maxLength = options.MaxLength - len(suffix)
+				if maxLength < 1 {
+					maxLength = 1
+				}
+			}
+			candidate = truncateSlug(slug, separator, maxLength) + suffix
+		}
+		inUse, genErr := exists(candidate)
+		if genErr != nil {
+			return "", genErr
+		}
+		if !inUse {
+			return candidate, nil
+		}
+	}
+
+	// No unused slug found
+	meta := map[string]string{"slug": slug, "attempts": strconv.Itoa(maxAttempts)}
+	return "", errors.NewGenericError(409, errorDomain, errorSubDomain, ErrorSlugNotUnique, meta)
+}
+
+// splitSlugWords converts the input to lower case and splits it on all characters except a-z and 0-9
+func splitSlugWords(input string) []string {
+	return strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+		return r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r))
+	})
+}
+
+// truncateSlug cuts the slug to maxLength, at the last separator if possible
+func truncateSlug(slug string, separator string, maxLength int) string {
+	if maxLength <= 0 || len(slug) <= maxLength {
+		return slug
+	}
+	if strings.HasPrefix(slug[maxLength:], separator) {
+		return slug[:maxLength]
+	}
+	truncated := slug[:maxLength]
+	if index := strings.LastIndex(truncated, separator); index > 0 {
+		return truncated[:index]
+	}
+	return strings.TrimSuffix(truncated, separator)
+}
@@ -0,0 +1,100 @@
+package converters
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Transliterate_Success(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"Crème brûlée", "Creme brulee"},
+		{"Straße Øresund", "Strasse Oresund"},
+		{"Жуков Щука", "Zhukov Shchuka"},
+		{"Львів", "Lviv"},
+		{"Αθήνα", "Athina"},
+		{"とうきょう", "toukyou"},
+		{"がっこう", "gakkou"},
+		{"マッチャ", "matcha"},
+		{"서울", "seoul"},
+		{"北京", "bei jing"},
+		{"北京2008年", "bei jing 2008 nian"},
+		{"臺北 Taipei", "tai bei Taipei"},
+		{"𠀀", "𠀀"}, // Rare characters are not converted
+	}
+
+	for _, test := range tests {
+		result, genErr := Transliterate(test.input)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.expected, result, test.input)
+	}
+}
+
+func Test_Slugify_Success(t *testing.T) {
+	tests := []struct {
+		input    string
+		options  SlugOptions
+		expected string
+	}{
+		{"", DefaultSlugOptions, ""},
+		{"Café & Crème", DefaultSlugOptions, "cafe-creme"},
+		{"  Hello, World!  ", DefaultSlugOptions, "hello-world"},
+		{"Москва 2024", DefaultSlugOptions, "moskva-2024"},
+		{"Hello World", SlugOptions{Separator: "_"}, "hello_world"},
+		{"The Lord of the Rings", SlugOptions{StopWords: SlugStopWords["en"]}, "lord-rings"},
+		{"The Of", SlugOptions{StopWords: SlugStopWords["en"]}, "the-of"},
+		{"The quick brown fox", SlugOptions{MaxLength: 13}, "the-quick"},
+		{"The quick brown fox", SlugOptions{MaxLength: 15}, "the-quick-brown"},
+		{"Supercalifragilistic", SlugOptions{MaxLength: 5}, "super"},
+		{"北京", DefaultSlugOptions, "bei-jing"},
+		{"上海市 Shanghai", DefaultSlugOptions, "shang-hai-shi-shanghai"},
+	}
+
+	for _, test := range tests {
+		result, genErr := Slugify(test.input, test.options)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.expected, result, test.input)
+	}
+}
+
+func Test_UniqueSlug_Success(t *testing.T) {
+	existing := map[string]bool{"my-title": true, "my-title-2": true}
+	exists := func(slug string) (bool, *errors.GenericError) {
+		return existing[slug], nil
+	}
+
+	result, genErr := UniqueSlug("Other title", DefaultSlugOptions, exists)
+	require.Nil(t, genErr)
+	assert.Equal(t, "other-title", result)
+
+	result, genErr = UniqueSlug("My Title", DefaultSlugOptions, exists)
+	require.Nil(t, genErr)
+	assert.Equal(t, "my-title-3", result)
+
+	result, genErr = UniqueSlug("My Title", SlugOptions{MaxLength: 8}, exists)
+	require.Nil(t, genErr)
+	assert.Equal(t, "my-2", result) // Cut at word boundary
+}
+
+func Test_UniqueSlug_Failure(t *testing.T) {
+	alwaysExists := func(slug string) (bool, *errors.GenericError) {
+		return true, nil
+	}
+	_, genErr := UniqueSlug("My Title", SlugOptions{MaxAttempts: 3}, alwaysExists)
+	errors.AssertGenericError(t, genErr, 409, ErrorSlugNotUnique, map[string]string{"slug": "my-title", "attempts": "3"})
+
+	_, genErr = UniqueSlug("مرحبا", DefaultSlugOptions, alwaysExists)
+	errors.AssertGenericError(t, genErr, 400, ErrorEmptySlug, nil)
+
+	failing := func(slug string) (bool, *errors.GenericError) {
+		return false, errors.NewGenericError(500, "test", "test", "count_failed", nil)
+	}
+	_, genErr = UniqueSlug("My Title", DefaultSlugOptions, failing)
+	errors.AssertGenericError(t, genErr, 500, "count_failed", nil)
+}
@@ -2,7 +2,6 @@ package converters
 
 import (
 	"regexp"
-	"strings"
 	"unicode"
 
 	log "github.com/sirupsen/logrus"
@@ -33,18 +32,6 @@ func NormaliseString(input string) (string, *errors.GenericError) {
 	return output, nil
 }
 
-var matchFirstCap = regexp.MustCompile("([A-Z])([A-Z][a-z])")
-var matchAllCap = regexp.MustCompile("([a-z0-9])([A-Z])")
-
-// ToSnakeCase converts the provided string to snake_case.
-// Based on https://gist.github.com/stoewer/fbe273b711e6a06315d19552dd4d33e6
-func ToSnakeCase(input string) string {
-	output := matchFirstCap.ReplaceAllString(input, "${1}_${2}")
-	output = matchAllCap.ReplaceAllString(output, "${1}_${2}")
-	output = strings.ReplaceAll(output, "-", "_")
-	return strings.ToLower(output)
-}
-
 // CleanSpecialCharacters removes any character(included spaces) which is
 // not a digit or a letter from the input.
 //
@@ -0,0 +1,183 @@
+package converters
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"golang.org/x/text/unicode/norm"
+)
+
+// transliterations contains the Latin transliteration of lower case letters.
+// Cyrillic is based on a simplified BGN/PCGN, Greek on a simplified ELOT 743 and kana on Hepburn.
+var transliterations = map[rune]string{
+	// Latin letters which are not removed by NormaliseString
+	'ß': "ss", 'æ': "ae", 'ø': "o", 'œ': "oe", 'ł': "l", 'đ': "d", 'ð': "d", 'þ': "th", 'ı': "i", 'ŋ': "ng",
+
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh", 'з': "z", 'и': "i",
+	'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+	'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "",
+	'э': "e", 'ю': "yu", 'я': "ya", 'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g", 'ў': "u", 'ђ': "dj", 'ј': "j",
+	'љ': "lj", 'њ': "nj", 'ћ': "c", 'џ': "dz", 'ѓ': "gj", 'ќ': "kj", 'ѕ': "dz",
+
+	// Greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i", 'κ': "k",
+	'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t",
+	'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+
+	// Hiragana (katakana is converted to hiragana first)
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o", 'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko", 'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so", 'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to", 'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no", 'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo", 'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo", 'や': "ya", 'ゆ': "yu", 'よ': "yo", 'ゃ': "ya", 'ゅ': "yu",
+	'ょ': "yo", 'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro", 'わ': "wa", 'ゐ': "wi", 'ゑ': "we", 'を': "wo",
+	'ん': "n", 'ゔ': "vu", 'っ': "", 'ー': "",
+}
+
+//go:generate go run han_pinyin_gen.go
+
+// hanPinyin contains the pinyin of the common Chinese characters.
+// Built from hanPinyinSyllables on first use to avoid slowing down the start of services which don't need it.
+var hanPinyin map[rune]string
+var hanPinyinOnce sync.Once
+
+// Revised Romanization of Korean, without assimilation rules
+var hangulInitials = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+var hangulMedials = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+var hangulFinals = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+
+// Transliterate converts Cyrillic, Greek, Japanese kana, Korean hangul and common Chinese characters
+// to Latin letters and removes all accents. Casing is kept where possible (e.g. "Жуков" => "Zhukov").
+// Chinese characters are converted to pinyin without tones, separated by spaces (e.g. "北京" => "bei jing").
+// Only a single reading is used per character. Japanese kanji are converted to pinyin as well.
+// Other scripts and rare Chinese characters are returned unchanged.
+//
+// Raises
+//
+// - 400/failed_to_normalise_string: Provided input could not be normalised
+func Transliterate(input string) (string, *errors.GenericError) {
+	runes := []rune(input)
+	var builder strings.Builder
+	doubleNext := false
+	previousHan := false
+	for i := 0; i < len(runes); i++ {
+		// Convert Chinese characters to separate pinyin syllables
+		original := runes[i]
+		if syllable, exists := hanRuneToPinyin(original); exists {
+			if i > 0 && isLetterOrDigit(runes[i-1]) {
+				builder.WriteRune(' ')
+			}
+			builder.WriteString(syllable)
+			previousHan = true
+			continue
+		}
+		if previousHan && isLetterOrDigit(original) {
+			builder.WriteRune(' ')
+		}
+		previousHan = false
+
+		// Convert katakana to hiragana
+		r := original
+		if r >= 'ァ' && r <= 'ヶ' {
+			r -= 'ァ' - 'ぁ'
+		}
+
+		// Transliterate rune
+		latin, exists := transliterateRune(r)
+		if !exists {
+			builder.WriteRune(r)
+			continue
+		}
+
+		// Handle kana combinations (e.g. きゃ => kya) and sokuon (e.g. がっこう => gakkou)
+		if next := nextKana(runes, i); next == 'ゃ' || next == 'ゅ' || next == 'ょ' {
+			if base := strings.TrimSuffix(latin, "i"); base != latin {
+				vowel := transliterations[next][1:]
+				if strings.HasSuffix(base, "sh") || strings.HasSuffix(base, "ch") || strings.HasSuffix(base, "j") {
+					latin = base + vowel
+				} else {
+					latin = base + "y" + vowel
+				}
+				i++
+			}
+		}
+		if r == 'っ' {
+			doubleNext = true
+			continue
+		}
+		if doubleNext && latin != "" {
+			if strings.HasPrefix(latin, "ch") {
+				latin = "t" + latin
+			} else {
+				latin = latin[:1] + latin
+			}
+		}
+		doubleNext = false
+
+		// Restore casing
+		if unicode.IsUpper(original) && latin != "" {
+			latin = strings.ToUpper(latin[:1]) + latin[1:]
+		}
+		builder.WriteString(latin)
+	}
+
+	// Remove accents
+	return NormaliseString(builder.String())
+}
+
+// transliterateRune returns the Latin transliteration of a single rune
+func transliterateRune(r rune) (string, bool) {
+	// Korean hangul syllable
+	if r >= 0xAC00 && r <= 0xD7A3 {
+		index := int(r - 0xAC00)
+		return hangulInitials[index/588] + hangulMedials[(index%588)/28] + hangulFinals[index%28], true
+	}
+
+	// Lookup in table, without accents if needed (e.g. ά => α)
+	lower := unicode.ToLower(r)
+	if latin, exists := transliterations[lower]; exists {
+		return latin, true
+	}
+	base := []rune(norm.NFD.String(string(lower)))[0]
+	latin, exists := transliterations[base]
+	return latin, exists
+}
+
+// hanRuneToPinyin returns the pinyin of a common Chinese character
+func hanRuneToPinyin(r rune) (string, bool) {
+	if !unicode.Is(unicode.Han, r) {
+		return "", false
+	}
+	hanPinyinOnce.Do(func() {
+		hanPinyin = map[rune]string{}
+		for syllable, characters := range hanPinyinSyllables {
+			for _, character := range characters {
+				hanPinyin[character] = syllable
+			}
+		}
+	})
+	syllable, exists := hanPinyin[r]
+	return syllable, exists
+}
+
+// isLetterOrDigit returns true if the rune is a letter or digit in any script
+func isLetterOrDigit(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// nextKana returns the next rune as hiragana. Returns 0 if there is no next rune.
+func nextKana(runes []rune, i int) rune {
+	if i+1 >= len(runes) {
+		return 0
+	}
+	next := runes[i+1]
+	if next >= 'ァ' && next <= 'ヶ' {
+		next -= 'ァ' - 'ぁ'
+	}
+	return next
+}
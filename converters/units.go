@@ -0,0 +1,487 @@
+package converters
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"golang.org/x/text/language"
+)
+
+// DistanceUnit is the unit of a Distance
+type DistanceUnit string
+
+// Supported distance units
+const (
+	DistanceUnitMeter     DistanceUnit = "m"
+	DistanceUnitKilometer DistanceUnit = "km"
+	DistanceUnitMile      DistanceUnit = "mi"
+	DistanceUnitFoot      DistanceUnit = "ft"
+)
+
+// distanceFactors contains the number of meters per unit
+var distanceFactors = map[string]float64{
+	string(DistanceUnitMeter):     1,
+	string(DistanceUnitKilometer): 1000,
+	string(DistanceUnitMile):      1609.344,
+	string(DistanceUnitFoot):      0.3048,
+}
+
+// SpeedUnit is the unit of a Speed
+type SpeedUnit string
+
+// Supported speed units
+const (
+	SpeedUnitMetersPerSecond   SpeedUnit = "m/s"
+	SpeedUnitKilometersPerHour SpeedUnit = "km/h"
+	SpeedUnitMilesPerHour      SpeedUnit = "mph"
+	SpeedUnitKnot              SpeedUnit = "kn"
+)
+
+// speedFactors contains the number of meters per second per unit
+var speedFactors = map[string]float64{
+	string(SpeedUnitMetersPerSecond):   1,
+	string(SpeedUnitKilometersPerHour): 1000.0 / 3600,
+	string(SpeedUnitMilesPerHour):      1609.344 / 3600,
+	string(SpeedUnitKnot):              1852.0 / 3600,
+}
+
+// EmissionUnit is the unit of an Emission
+type EmissionUnit string
+
+// Supported emission units
+const (
+	EmissionUnitGram     EmissionUnit = "g"
+	EmissionUnitKilogram EmissionUnit = "kg"
+	EmissionUnitTonne    EmissionUnit = "t"
+	EmissionUnitPound    EmissionUnit = "lb"
+)
+
+// emissionFactors contains the number of grams per unit
+var emissionFactors = map[string]float64{
+	string(EmissionUnitGram):     1,
+	string(EmissionUnitKilogram): 1000,
+	string(EmissionUnitTonne):    1000000,
+	string(EmissionUnitPound):    453.59237,
+}
+
+// NumberFormat defines how a number is formatted in a language
+type NumberFormat struct {
+	DecimalSeparator string
+	GroupSeparator   string
+}
+
+// numberFormats contains the formats of the supported languages, based on CLDR
+var numberFormats = map[string]NumberFormat{
+	"en": {DecimalSeparator: ".", GroupSeparator: ","},
+	"fr": {DecimalSeparator: ",", GroupSeparator: "\u202f"},
+	"nl": {DecimalSeparator: ",", GroupSeparator: "."},
+	"de": {DecimalSeparator: ",", GroupSeparator: "."},
+	"es": {DecimalSeparator: ",", GroupSeparator: "."},
+	"it": {DecimalSeparator: ",", GroupSeparator: "."},
+}
+
+// Distance is a length with an explicit unit.
+// JSON/BSON representation is {"value": 12.5, "unit": "km"}.
+type Distance struct {
+	Value float64      `json:"value" bson:"value"`
+	Unit  DistanceUnit `json:"unit" bson:"unit"`
+}
+
+// Speed is a velocity with an explicit unit.
+// JSON/BSON representation is {"value": 50, "unit": "km/h"}.
+type Speed struct {
+	Value float64   `json:"value" bson:"value"`
+	Unit  SpeedUnit `json:"unit" bson:"unit"`
+}
+
+// Emission is a mass of CO2 with an explicit unit.
+// JSON/BSON representation is {"value": 120, "unit": "g"}.
+type Emission struct {
+	Value float64      `json:"value" bson:"value"`
+	Unit  EmissionUnit `json:"unit" bson:"unit"`
+}
+
+// NewDistance creates a new Distance
+//
+// Raises
+//
+// - 400/unsupported_unit: Provided unit is not supported
+func NewDistance(value float64, unit DistanceUnit) (Distance, *errors.GenericError) {
+	if genErr := validateUnit(string(unit), distanceFactors); genErr != nil {
+		return Distance{}, genErr
+	}
+	return Distance{Value: value, Unit: unit}, nil
+}
+
+// In returns the value of the distance in the provided unit
+//
+// Raises
+//
+// - 400/unsupported_unit: Unit of the distance or provided unit is not supported
+func (d Distance) In(unit DistanceUnit) (float64, *errors.GenericError) {
+	return convertUnit(d.Value, string(d.Unit), string(unit), distanceFactors)
+}
+
+// To converts the distance to the provided unit
+//
+// Raises
+//
+// - 400/unsupported_unit: Unit of the distance or provided unit is not supported
+func (d Distance) To(unit DistanceUnit) (Distance, *errors.GenericError) {
+	value, genErr := d.In(unit)
+	return Distance{Value: value, Unit: unit}, genErr
+}
+
+// Format formats the distance for display in the provided locale (e.g. "fr-BE"),
+// rounded to 2 decimals. Supported languages are en, fr, nl, de, es and it.
+//
+// Example for 1234.5 km: en => "1,234.5 km", fr => "1 234,5 km"
+//
+// Raises
+//
+// - 400/unsupported_locale: Provided locale is not supported
+func (d Distance) Format(locale string) (string, *errors.GenericError) {
+	return formatQuantity(d.Value, string(d.Unit), locale)
+}
+
+// NewSpeed creates a new Speed
+//
+// Raises
+//
+// - 400/unsupported_unit: Provided unit is not supported
+func NewSpeed(value float64, unit SpeedUnit) (Speed, *errors.GenericError) {
+	if genErr := validateUnit(string(unit), speedFactors); genErr != nil {
+		return Speed{}, genErr
+	}
+	return Speed{Value: value, Unit: unit}, nil
+}
+
+// NewSpeedFromDistance calculates the average speed to travel the distance in the provided duration
+//
+// Raises
+//
+// - 400/unsupported_unit: Unit of the distance or provided unit is not supported
+//
+// - 400/invalid_duration: Provided duration is not positive
+func NewSpeedFromDistance(distance Distance, duration time.Duration, unit SpeedUnit) (Speed, *errors.GenericError) {
+	// Validate input
+	if duration <= 0 {
+		meta := map[string]string{"duration": duration.String()}
+		return Speed{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidDuration, meta)
+	}
+	meters, genErr := distance.In(DistanceUnitMeter)
+	if genErr != nil {
+		return Speed{}, genErr
+	}
+
+	// Calculate speed
+	speed := Speed{Value: meters / duration.Seconds(), Unit: SpeedUnitMetersPerSecond}
+	return speed.To(unit)
+}
+
+// In returns the value of the speed in the provided unit
+//
+// Raises
+//
+// - 400/unsupported_unit: Unit of the speed or provided unit is not supported
+func (s Speed) In(unit SpeedUnit) (float64, *errors.GenericError) {
+	return convertUnit(s.Value, string(s.Unit), string(unit), speedFactors)
+}
+
+// To converts the speed to the provided unit
+//
+// Raises
+//
+// - 400/unsupported_unit: Unit of the speed or provided unit is not supported
+func (s Speed) To(unit SpeedUnit) (Speed, *errors.GenericError) {
+	value, genErr := s.In(unit)
+	return Speed{Value: value, Unit: unit}, genErr
+}
+
+// Format formats the speed for display in the provided locale, rounded to 2 decimals.
+// See Distance.Format for details.
+//
+// Raises
+//
+// - 400/unsupported_locale: Provided locale is not supported
+func (s Speed) Format(locale string) (string, *errors.GenericError) {
+	return formatQuantity(s.Value, string(s.Unit), locale)
+}
+
+// NewEmission creates a new Emission
+//
+// Raises
+//
+// - 400/unsupported_unit: Provided unit is not supported
+func NewEmission(value float64, unit EmissionUnit) (Emission, *errors.GenericError) {
+	if genErr := validateUnit(string(unit), emissionFactors); genErr != nil {
+		return Emission{}, genErr
+	}
+	return Emission{Value: value, Unit: unit}, nil
+}
+
+// In returns the value of the emission in the provided unit
+//
+// Raises
+//
+// - 400/unsupported_unit: Unit of the emission or provided unit is not supported
+func (e Emission) In(unit EmissionUnit) (float64, *errors.GenericError) {
+	return convertUnit(e.Value, string(e.Unit), string(unit), emissionFactors)
+}
+
+// To converts the emission to the provided unit
+//
+// Raises
+//
+// - 400/unsupported_unit: Unit of the emission or provided unit is not supported
+func (e Emission) To(unit EmissionUnit) (Emission, *errors.GenericError) {
+	value, genErr := e.In(unit)
+	return Emission{Value: value, Unit: unit}, genErr
+}
+
+// Format formats the emission for display in the provided locale, rounded to 2 decimals.
+// See Distance.Format for details.
+//
+// Raises
+//
+// - 400/unsupported_locale: Provided locale is not supported
+func (e Emission) Format(locale string) (string, *errors.GenericError) {
+	return formatQuantity(e.Value, string(e.Unit), locale)
+}
+
+// validateUnit checks if the unit is present in the factors
+//
+// Raises
+//
+// - 400/unsupported_unit: Provided unit is not supported
+func validateUnit(unit string, factors map[string]float64) *errors.GenericError {
+	if _, exists := factors[unit]; !exists {
+		meta := map[string]string{"unit": unit}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorUnsupportedUnit, meta)
+	}
+	return nil
+}
+
+// convertUnit converts the value between 2 units, based on their factors to the base unit
+//
+// Raises
+//
+// - 400/unsupported_unit: One of the provided units is not supported
+func convertUnit(value float64, from string, to string, factors map[string]float64) (float64, *errors.GenericError) {
+	if genErr := validateUnit(from, factors); genErr != nil {
+		return 0, genErr
+	}
+	if genErr := validateUnit(to, factors); genErr != nil {
+		return 0, genErr
+	}
+	if from == to {
+		return value, nil
+	}
+	return value * factors[from] / factors[to], nil
+}
+
+// formatQuantity formats a value rounded to 2 decimals, followed by a non-breaking space and the unit
+//
+// Raises
+//
+// - 400/unsupported_locale: Provided locale is not supported
+func formatQuantity(value float64, unit string, locale string) (string, *errors.GenericError) {
+	number, genErr := formatLocalizedNumber(value, 2, locale)
+	if genErr != nil {
+		return "", genErr
+	}
+	return number + "\u00a0" + unit, nil
+}
+
+// formatLocalizedNumber formats the value rounded to the provided number of decimals,
+// removing trailing zeros. Supported languages are en, fr, nl, de, es and it.
+//
+// Raises
+//
+// - 400/unsupported_locale: Provided locale is not supported
+func formatLocalizedNumber(value float64, decimals int, locale string) (string, *errors.GenericError) {
+	// Fetch locale format
+	format, genErr := GetNumberFormat(locale)
+	if genErr != nil {
+		return "", genErr
+	}
+
+	// Round value
+	number := strconv.FormatFloat(math.Abs(value), 'f', decimals, 64)
+	if strings.Contains(number, ".") {
+		number = strings.TrimRight(strings.TrimRight(number, "0"), ".")
+	}
+	sign := ""
+	if value < 0 && strings.Trim(number, "0.") != "" {
+		sign = "-"
+	}
+
+	// Format number
+	integer, fraction := number, ""
+	if index := strings.Index(number, "."); index >= 0 {
+		integer, fraction = number[:index], format.DecimalSeparator+number[index+1:]
+	}
+	return sign + GroupNumberDigits(integer, format.GroupSeparator) + fraction, nil
+}
+
+// GetNumberFormat returns the number format for the language of the locale (e.g. "fr-BE").
+// Supported languages are en, fr, nl, de, es and it.
+//
+// Raises
+//
+// - 400/unsupported_locale: Provided locale is not supported
+func GetNumberFormat(locale string) (NumberFormat, *errors.GenericError) {
+	tag, err := language.Parse(locale)
+	base, _ := tag.Base()
+	format, exists := numberFormats[base.String()]
+	if err != nil || !exists {
+		meta := map[string]string{"locale": locale}
+		return NumberFormat{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorUnsupportedLocale, meta)
+	}
+	return format, nil
+}
+
+// GroupNumberDigits inserts the separator between each group of 3 digits (e.g. "1234567" => "1,234,567")
+func GroupNumberDigits(digits string, separator string) string {
+	var builder strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			builder.WriteString(separator)
+		}
+		builder.WriteRune(digit)
+	}
+	return builder.String()
+}
+
+// distanceFields, speedFields and emissionFields prevent infinite recursion when unmarshalling
+type distanceFields Distance
+type speedFields Speed
+type emissionFields Emission
+
+// UnmarshalJSON decodes a Distance from {"value": 12.5, "unit": "km"}.
+// Decoding fails if the unit is not supported. The zero value is accepted and null is ignored.
+func (d *Distance) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+	var fields distanceFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	return d.setValidated(fields)
+}
+
+// UnmarshalBSON decodes a Distance from {value: 12.5, unit: "km"}.
+// Decoding fails if the unit is not supported. The zero value is accepted and null is ignored.
+func (d *Distance) UnmarshalBSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var fields distanceFields
+	if err := bson.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	return d.setValidated(fields)
+}
+
+// setValidated stores the fields if they are the zero value or contain a supported unit
+func (d *Distance) setValidated(fields distanceFields) error {
+	if fields == (distanceFields{}) {
+		*d = Distance{}
+		return nil
+	}
+	if genErr := validateUnit(string(fields.Unit), distanceFactors); genErr != nil {
+		return genErr
+	}
+	*d = Distance(fields)
+	return nil
+}
+
+// UnmarshalJSON decodes a Speed from {"value": 50, "unit": "km/h"}.
+// Decoding fails if the unit is not supported. The zero value is accepted and null is ignored.
+func (s *Speed) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+	var fields speedFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	return s.setValidated(fields)
+}
+
+// UnmarshalBSON decodes a Speed from {value: 50, unit: "km/h"}.
+// Decoding fails if the unit is not supported. The zero value is accepted and null is ignored.
+func (s *Speed) UnmarshalBSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var fields speedFields
+	if err := bson.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	return s.setValidated(fields)
+}
+
+// setValidated stores the fields if they are the zero value or contain a supported unit
+func (s *Speed) setValidated(fields speedFields) error {
+	if fields == (speedFields{}) {
+		*s = Speed{}
+		return nil
+	}
+	if genErr := validateUnit(string(fields.Unit), speedFactors); genErr != nil {
+		return genErr
+	}
+	*s = Speed(fields)
+	return nil
+}
+
+// UnmarshalJSON decodes an Emission from {"value": 120, "unit": "g"}.
+// Decoding fails if the unit is not supported. The zero value is accepted and null is ignored.
+func (e *Emission) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+	var fields emissionFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	return e.setValidated(fields)
+}
+
+// UnmarshalBSON decodes an Emission from {value: 120, unit: "g"}.
+// Decoding fails if the unit is not supported. The zero value is accepted and null is ignored.
+func (e *Emission) UnmarshalBSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var fields emissionFields
+	if err := bson.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	return e.setValidated(fields)
+}
+
+// setValidated stores the fields if they are the zero value or contain a supported unit
+func (e *Emission) setValidated(fields emissionFields) error {
+	if fields == (emissionFields{}) {
+		*e = Emission{}
+		return nil
+	}
+	if genErr := validateUnit(string(fields.Unit), emissionFactors); genErr != nil {
+		return genErr
+	}
+	*e = Emission(fields)
+	return nil
+}
+
+// isJSONNull returns true if the JSON value is null
+func isJSONNull(data []byte) bool {
+	return string(bytes.TrimSpace(data)) == "null"
+}
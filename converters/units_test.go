@@ -0,0 +1,174 @@
+package converters
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func Test_Distance_In_Success(t *testing.T) {
+	distance, genErr := NewDistance(10, DistanceUnitMile)
+	require.Nil(t, genErr)
+
+	km, genErr := distance.In(DistanceUnitKilometer)
+	require.Nil(t, genErr)
+	assert.InDelta(t, 16.09344, km, 0.000001)
+
+	meters, genErr := distance.To(DistanceUnitMeter)
+	require.Nil(t, genErr)
+	assert.InDelta(t, 16093.44, meters.Value, 0.000001)
+	assert.Equal(t, DistanceUnitMeter, meters.Unit)
+}
+
+func Test_Distance_UnsupportedUnit_Failure(t *testing.T) {
+	_, genErr := NewDistance(10, "league")
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedUnit, map[string]string{"unit": "league"})
+
+	_, genErr = Distance{Value: 10, Unit: DistanceUnitKilometer}.In("")
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedUnit, map[string]string{"unit": ""})
+}
+
+func Test_Distance_Format_Success(t *testing.T) {
+	distance := Distance{Value: 1234.5678, Unit: DistanceUnitKilometer}
+	tests := map[string]string{
+		"en":    "1,234.57 km",
+		"fr-BE": "1 234,57 km",
+		"nl":    "1.234,57 km",
+	}
+
+	for locale, expected := range tests {
+		result, genErr := distance.Format(locale)
+		require.Nil(t, genErr)
+		assert.Equal(t, expected, result)
+	}
+
+	result, genErr := Distance{Value: -2.5, Unit: DistanceUnitMile}.Format("en")
+	require.Nil(t, genErr)
+	assert.Equal(t, "-2.5 mi", result)
+}
+
+func Test_Distance_Format_UnsupportedLocale_Failure(t *testing.T) {
+	_, genErr := Distance{Value: 1, Unit: DistanceUnitKilometer}.Format("ja")
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedLocale, map[string]string{"locale": "ja"})
+}
+
+func Test_GetNumberFormat_Success(t *testing.T) {
+	format, genErr := GetNumberFormat("nl-BE")
+	require.Nil(t, genErr)
+	assert.Equal(t, NumberFormat{DecimalSeparator: ",", GroupSeparator: "."}, format)
+	assert.Equal(t, "1.234.567", GroupNumberDigits("1234567", format.GroupSeparator))
+	assert.Equal(t, "123", GroupNumberDigits("123", format.GroupSeparator))
+}
+
+func Test_GetNumberFormat_UnsupportedLocale_Failure(t *testing.T) {
+	_, genErr := GetNumberFormat("pt")
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedLocale, map[string]string{"locale": "pt"})
+}
+
+func Test_NewSpeedFromDistance_Success(t *testing.T) {
+	distance := Distance{Value: 45, Unit: DistanceUnitKilometer}
+	speed, genErr := NewSpeedFromDistance(distance, 30*time.Minute, SpeedUnitKilometersPerHour)
+	require.Nil(t, genErr)
+	assert.InDelta(t, 90, speed.Value, 0.000001)
+	assert.Equal(t, SpeedUnitKilometersPerHour, speed.Unit)
+
+	mph, genErr := speed.In(SpeedUnitMilesPerHour)
+	require.Nil(t, genErr)
+	assert.InDelta(t, 55.923407, mph, 0.000001)
+}
+
+func Test_NewSpeedFromDistance_InvalidDuration_Failure(t *testing.T) {
+	distance := Distance{Value: 45, Unit: DistanceUnitKilometer}
+	_, genErr := NewSpeedFromDistance(distance, 0, SpeedUnitKilometersPerHour)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidDuration, map[string]string{"duration": "0s"})
+}
+
+func Test_Emission_To_Success(t *testing.T) {
+	emission, genErr := NewEmission(2500, EmissionUnitGram)
+	require.Nil(t, genErr)
+	result, genErr := emission.To(EmissionUnitKilogram)
+	require.Nil(t, genErr)
+	assert.Equal(t, Emission{Value: 2.5, Unit: EmissionUnitKilogram}, result)
+
+	formatted, genErr := result.Format("de")
+	require.Nil(t, genErr)
+	assert.Equal(t, "2,5 kg", formatted)
+}
+
+func Test_Units_JSON_Success(t *testing.T) {
+	type trip struct {
+		Distance Distance `json:"distance"`
+		Speed    Speed    `json:"speed"`
+		Emission Emission `json:"emission"`
+	}
+	input := trip{
+		Distance: Distance{Value: 12.5, Unit: DistanceUnitKilometer},
+		Speed:    Speed{Value: 50, Unit: SpeedUnitKilometersPerHour},
+		Emission: Emission{Value: 120, Unit: EmissionUnitGram},
+	}
+
+	data, err := json.Marshal(input)
+	require.Nil(t, err)
+	expected := `{"distance":{"value":12.5,"unit":"km"},"speed":{"value":50,"unit":"km/h"},"emission":{"value":120,"unit":"g"}}`
+	assert.JSONEq(t, expected, string(data))
+
+	var result trip
+	require.Nil(t, json.Unmarshal(data, &result))
+	assert.Equal(t, input, result)
+}
+
+func Test_Units_JSON_UnsupportedUnit_Failure(t *testing.T) {
+	var distance Distance
+	err := json.Unmarshal([]byte(`{"value": 12.5, "unit": "league"}`), &distance)
+	errors.AssertGenericError(t, err.(*errors.GenericError), 400, ErrorUnsupportedUnit, map[string]string{"unit": "league"})
+
+	var speed Speed
+	assert.NotNil(t, json.Unmarshal([]byte(`{"value": 50}`), &speed))
+}
+
+func Test_Units_ZeroAndNull_Success(t *testing.T) {
+	type trip struct {
+		Distance Distance `json:"distance" bson:"distance"`
+		Speed    Speed    `json:"speed" bson:"speed"`
+		Emission Emission `json:"emission" bson:"emission"`
+	}
+
+	// Zero value round-trip
+	data, err := json.Marshal(trip{})
+	require.Nil(t, err)
+	var result trip
+	require.Nil(t, json.Unmarshal(data, &result))
+	assert.Equal(t, trip{}, result)
+
+	data, err = bson.Marshal(trip{})
+	require.Nil(t, err)
+	require.Nil(t, bson.Unmarshal(data, &result))
+	assert.Equal(t, trip{}, result)
+
+	// Null
+	require.Nil(t, json.Unmarshal([]byte(`{"distance": null, "speed": null, "emission": null}`), &result))
+	assert.Equal(t, trip{}, result)
+	data, err = bson.Marshal(bson.M{"distance": nil, "speed": nil, "emission": nil})
+	require.Nil(t, err)
+	require.Nil(t, bson.Unmarshal(data, &result))
+	assert.Equal(t, trip{}, result)
+}
+
+func Test_Units_BSON_Success(t *testing.T) {
+	input := Emission{Value: 1.5, Unit: EmissionUnitTonne}
+	data, err := bson.Marshal(input)
+	require.Nil(t, err)
+
+	var result Emission
+	require.Nil(t, bson.Unmarshal(data, &result))
+	assert.Equal(t, input, result)
+
+	data, err = bson.Marshal(bson.M{"value": 1.5, "unit": "stone"})
+	require.Nil(t, err)
+	assert.NotNil(t, bson.Unmarshal(data, &result))
+}
@@ -1,33 +1,90 @@
 package gin
 
 import (
+	"bufio"
 	"bytes"
+	"io"
 	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pborman/uuid"
 	"github.com/skiprco/go-utils/v2/logging"
 	"github.com/skiprco/go-utils/v2/metadata"
+	"github.com/skiprco/go-utils/v2/tracing"
 )
 
-// AuditMiddleware logs the attempt and result for an API call.
+// AuditTruncatedMarker is appended to payloads which are longer than AuditOptions.MaxPayloadSize
+const AuditTruncatedMarker = "...[truncated]"
+
+// AuditOmittedMarker replaces payloads which are not captured (skipped content type or path, hijacked connection)
+const AuditOmittedMarker = "[omitted]"
+
+// AuditOptions defines which parts of the request and response payload are logged by the AuditMiddleware
+type AuditOptions struct {
+	// MaxPayloadSize is the maximum number of bytes captured of the request and response payload.
+	// Longer payloads are truncated and suffixed with AuditTruncatedMarker. 0 disables the limit.
+	MaxPayloadSize int
+
+	// SkippedContentTypes contains prefixes of content types (e.g. image/) which are not captured
+	SkippedContentTypes []string
+
+	// SkippedPaths contains the routes (as registered, e.g. /files/:id) of which the payloads are not captured
+	SkippedPaths []string
+}
+
+// DefaultAuditOptions captures 16 KiB of each payload and skips binary, multipart and streaming content types
+var DefaultAuditOptions = AuditOptions{
+	MaxPayloadSize: 16 * 1024,
+	SkippedContentTypes: []string{
+		"application/octet-stream",
+		"application/pdf",
+		"application/zip",
+		"audio/",
+		"font/",
+		"image/",
+		"multipart/",
+		"text/event-stream",
+		"video/",
+	},
+}
+
+// AuditMiddleware logs the attempt and result for an API call using DefaultAuditOptions.
 // It also sets metadata in the context to support further audit logging.
+// The trace of the traceparent header is continued (or a new trace is started)
+// and the trace and span IDs are added to the metadata.
 //
 // Arguments
 //
 // - operator: e.g. booking-api, registration-api, ...
 func AuditMiddleware(operator string) gin.HandlerFunc {
+	return AuditMiddlewareWithOptions(operator, DefaultAuditOptions)
+}
+
+// AuditMiddlewareWithOptions is equal to AuditMiddleware, but captures the payloads according to the provided options.
+// Only the captured part of the request body is kept in memory, the remainder is streamed to the handler.
+//
+// Arguments
+//
+// - operator: e.g. booking-api, registration-api, ...
+//
+// - options: Defines which parts of the payloads are captured
+func AuditMiddlewareWithOptions(operator string, options AuditOptions) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Read request body
-		var bodyBytes []byte
+		skipPath := containsPath(options.SkippedPaths, c.FullPath())
+
+		// Capture request body
+		request := &payloadCapture{options: options, omitted: skipPath}
 		if c.Request.Body != nil {
-			bodyBytes, _ = ioutil.ReadAll(c.Request.Body)
+			request.readFrom(c.Request, c.ContentType())
 		}
-		c.Request.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
 
 		// Replace writer with bodyLogWriter to capture response body
-		blw := &bodyLogWriter{body: bytes.NewBufferString(""), ResponseWriter: c.Writer}
+		blw := &bodyLogWriter{ResponseWriter: c.Writer, capture: &payloadCapture{options: options, omitted: skipPath}}
 		c.Writer = blw
 
 		// Build audit data
@@ -38,13 +95,20 @@ func AuditMiddleware(operator string) gin.HandlerFunc {
 			"operation_time":           "0",
 		}
 
+		// Start server span
+		name := strings.TrimSpace(c.Request.Method + " " + c.FullPath())
+		span := tracing.NewSpanFromTraceParent(name, tracing.SpanKindServer, c.GetHeader(tracing.TraceParentHeader))
+		span.SetAttribute("http.method", c.Request.Method)
+		span.SetAttribute("http.route", c.FullPath())
+
 		// Update metadataToPass
 		metadata.UpdateGinMetadata(c, auditData)
+		metadata.UpdateGinMetadata(c, span.Metadata())
 
 		// Log operation attempt
 		ctx := metadata.ConvertGinToGoMicro(c)
 		additional := map[string]interface{}{ // This data shouldn't be included in the context
-			"request_payload": string(bodyBytes),
+			"request_payload": request.String(),
 		}
 		logging.AuditOperationAttempt(ctx, additional)
 
@@ -52,7 +116,7 @@ func AuditMiddleware(operator string) gin.HandlerFunc {
 		c.Next()
 
 		// Read response body
-		additional["response_payload"] = blw.body.String()
+		additional["response_payload"] = blw.capture.String()
 
 		// Log operation result
 		ctx = metadata.ConvertGinToGoMicro(c)
@@ -61,20 +125,128 @@ func AuditMiddleware(operator string) gin.HandlerFunc {
 		} else {
 			logging.AuditOperationFail(ctx, additional)
 		}
+
+		// End server span
+		span.SetAttribute("http.status_code", strconv.Itoa(c.Writer.Status()))
+		if c.Writer.Status() >= 500 {
+			span.SetStatus(tracing.SpanStatusError, http.StatusText(c.Writer.Status()))
+		}
+		span.End()
 	}
 }
 
+// payloadCapture keeps the first part of a payload in memory
+type payloadCapture struct {
+	options   AuditOptions
+	body      bytes.Buffer
+	truncated bool
+	omitted   bool
+	checked   bool // True if the content type is checked
+}
+
+// readFrom captures the start of the request body. The body of the request is replaced,
+// so the handler can still read the full body.
+func (p *payloadCapture) readFrom(request *http.Request, contentType string) {
+	p.checkContentType(contentType)
+	if p.omitted {
+		return
+	}
+
+	// Read captured part
+	var reader io.Reader = request.Body
+	if p.options.MaxPayloadSize > 0 {
+		reader = io.LimitReader(request.Body, int64(p.options.MaxPayloadSize)+1)
+	}
+	captured, _ := ioutil.ReadAll(reader)
+	p.write(captured)
+
+	// Replace body with captured part followed by remainder
+	request.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(captured), request.Body), Closer: request.Body}
+}
+
+// checkContentType omits the payload if the content type is skipped. Only the first call is taken into account.
+func (p *payloadCapture) checkContentType(contentType string) {
+	if p.checked {
+		return
+	}
+	p.checked = true
+	for _, skipped := range p.options.SkippedContentTypes {
+		if strings.HasPrefix(strings.ToLower(contentType), skipped) {
+			p.omitted = true
+		}
+	}
+}
+
+// write captures the data until MaxPayloadSize is reached
+func (p *payloadCapture) write(data []byte) {
+	if p.omitted || p.truncated {
+		return
+	}
+	if limit := p.options.MaxPayloadSize; limit > 0 && p.body.Len()+len(data) > limit {
+		p.body.Write(data[:limit-p.body.Len()])
+		p.truncated = true
+		return
+	}
+	p.body.Write(data)
+}
+
+// String returns the captured payload including the markers
+func (p *payloadCapture) String() string {
+	switch {
+	case p.omitted:
+		return AuditOmittedMarker
+	case p.truncated:
+		return p.body.String() + AuditTruncatedMarker
+	default:
+		return p.body.String()
+	}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// bodyLogWriter captures the response body while writing it to the client
 type bodyLogWriter struct {
 	gin.ResponseWriter
-	body *bytes.Buffer
+	capture *payloadCapture
 }
 
-func (w bodyLogWriter) Write(data []byte) (int, error) {
-	w.body.Write(data)
+func (w *bodyLogWriter) Write(data []byte) (int, error) {
+	w.capture.checkContentType(w.Header().Get("Content-Type"))
+	w.capture.write(data)
 	return w.ResponseWriter.Write(data)
 }
 
-func (w bodyLogWriter) WriteString(s string) (int, error) {
-	w.body.Write([]byte(s))
+func (w *bodyLogWriter) WriteString(s string) (int, error) {
+	w.capture.checkContentType(w.Header().Get("Content-Type"))
+	w.capture.write([]byte(s))
 	return w.ResponseWriter.WriteString(s)
 }
+
+// Flush sends the buffered data to the client, required for streaming responses (e.g. SSE)
+func (w *bodyLogWriter) Flush() {
+	w.ResponseWriter.Flush()
+}
+
+// Hijack lets the handler take over the connection (e.g. websockets). The payload is omitted afterwards.
+func (w *bodyLogWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.capture.omitted = true
+	return w.ResponseWriter.Hijack()
+}
+
+// CloseNotify returns a channel which receives a value when the client disconnects
+func (w *bodyLogWriter) CloseNotify() <-chan bool {
+	return w.ResponseWriter.CloseNotify()
+}
+
+// containsPath returns true if the path is in the list of paths
+func containsPath(paths []string, path string) bool {
+	for _, candidate := range paths {
+		if candidate == path {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,8 @@ package gin
 
 import (
 	"bytes"
+	"context"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -14,6 +16,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/skiprco/go-utils/v2/logging"
+	"github.com/skiprco/go-utils/v2/tracing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +39,7 @@ func Test_AuditMiddleware_OperationSuccess(t *testing.T) {
 		"operator":        "test-operator",
 		"category":        logging.AuditCategoryAttempt,
 		"request_payload": fixtureBody,
+		"trace_flags":     "01",
 	}
 	assert.Equal(t, expected, actualAttempt)
 	assert.Equal(t, logging.AuditMessageOperationAttempt, hook.Entries[0].Message)
@@ -67,6 +71,7 @@ func Test_AuditMiddleware_OperationFail(t *testing.T) {
 		"operator":        "test-operator",
 		"category":        logging.AuditCategoryAttempt,
 		"request_payload": fixtureBody,
+		"trace_flags":     "01",
 	}
 	assert.Equal(t, expected, actualAttempt)
 	assert.Equal(t, logging.AuditMessageOperationAttempt, hook.Entries[0].Message)
@@ -83,6 +88,119 @@ func Test_AuditMiddleware_OperationFail(t *testing.T) {
 	hook.Reset()
 }
 
+func Test_AuditMiddlewareWithOptions_Truncated(t *testing.T) {
+	// Setup test
+	hook := logTest.NewGlobal()
+	options := DefaultAuditOptions
+	options.MaxPayloadSize = 10
+	var received string
+	router := gin.New()
+	router.Use(AuditMiddlewareWithOptions("test-operator", options))
+	router.POST("/", func(c *gin.Context) {
+		body, _ := ioutil.ReadAll(c.Request.Body)
+		received = string(body)
+		c.String(200, "test-response-body")
+	})
+	router.ServeHTTP(httptest.NewRecorder(), fixtureRequest())
+
+	// Assert payloads
+	require.Len(t, hook.Entries, 2)
+	assert.Equal(t, fixtureBody, received) // Handler receives full body
+	assert.Equal(t, `{"test-key`+AuditTruncatedMarker, hook.Entries[1].Data["request_payload"])
+	assert.Equal(t, "test-respo"+AuditTruncatedMarker, hook.Entries[1].Data["response_payload"])
+	hook.Reset()
+}
+
+func Test_AuditMiddlewareWithOptions_SkippedContentType(t *testing.T) {
+	// Setup test
+	hook := logTest.NewGlobal()
+	router := gin.New()
+	router.Use(AuditMiddleware("test-operator"))
+	router.POST("/", func(c *gin.Context) {
+		c.Data(200, "image/png", []byte("test-image"))
+	})
+	request := fixtureRequest()
+	request.Header.Set("Content-Type", "multipart/form-data; boundary=test")
+	router.ServeHTTP(httptest.NewRecorder(), request)
+
+	// Assert payloads
+	require.Len(t, hook.Entries, 2)
+	assert.Equal(t, AuditOmittedMarker, hook.Entries[1].Data["request_payload"])
+	assert.Equal(t, AuditOmittedMarker, hook.Entries[1].Data["response_payload"])
+	hook.Reset()
+}
+
+func Test_AuditMiddlewareWithOptions_SkippedPath(t *testing.T) {
+	// Setup test
+	hook := logTest.NewGlobal()
+	options := DefaultAuditOptions
+	options.SkippedPaths = []string{"/files/:id"}
+	router := gin.New()
+	router.Use(AuditMiddlewareWithOptions("test-operator", options))
+	router.POST("/files/:id", func(c *gin.Context) {
+		c.String(200, "test-response-body")
+	})
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/files/123", bytes.NewBufferString(fixtureBody)))
+
+	// Assert payloads
+	require.Len(t, hook.Entries, 2)
+	assert.Equal(t, AuditOmittedMarker, hook.Entries[1].Data["request_payload"])
+	assert.Equal(t, AuditOmittedMarker, hook.Entries[1].Data["response_payload"])
+	hook.Reset()
+}
+
+func Test_AuditMiddleware_Streaming(t *testing.T) {
+	// Setup test
+	hook := logTest.NewGlobal()
+	router := gin.New()
+	router.Use(AuditMiddleware("test-operator"))
+	router.GET("/events", func(c *gin.Context) {
+		c.Header("Content-Type", "text/event-stream")
+		c.Stream(func(w io.Writer) bool {
+			c.SSEvent("message", "test-event")
+			return false
+		})
+	})
+	w := &testStreamRecorder{ResponseRecorder: httptest.NewRecorder()}
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/events", nil))
+
+	// Assert response is flushed and not captured
+	assert.True(t, w.Flushed)
+	assert.Contains(t, w.Body.String(), "test-event")
+	require.Len(t, hook.Entries, 2)
+	assert.Equal(t, AuditOmittedMarker, hook.Entries[1].Data["response_payload"])
+	hook.Reset()
+}
+
+func Test_AuditMiddleware_TraceParent(t *testing.T) {
+	// Setup test
+	hook := logTest.NewGlobal()
+	exporter := &testSpanExporter{}
+	tracing.SetSpanExporter(exporter)
+	defer tracing.SetSpanExporter(nil)
+	router := fixtureRouter(500, 0)
+	request := fixtureRequest()
+	request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	router.ServeHTTP(httptest.NewRecorder(), request)
+
+	// Assert trace is continued in audit logs
+	require.Len(t, hook.Entries, 2)
+	for _, entry := range hook.Entries {
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", entry.Data["trace_id"])
+		assert.Equal(t, "00f067aa0ba902b7", entry.Data["parent_span_id"])
+	}
+
+	// Assert exported span
+	require.Len(t, exporter.spans, 1)
+	span := exporter.spans[0]
+	assert.Equal(t, "POST /", span.Name)
+	assert.Equal(t, tracing.SpanKindServer, span.Kind)
+	assert.Equal(t, hook.Entries[1].Data["span_id"], span.SpanID)
+	assert.Equal(t, "500", span.Attributes["http.status_code"])
+	assert.Equal(t, tracing.SpanStatusError, span.Status)
+	hook.Reset()
+}
+
 // ========================================
 // =                HELPERS               =
 // ========================================
@@ -113,6 +231,29 @@ func fixtureRouter(status int, duration time.Duration) *gin.Engine {
 	return router
 }
 
+// testSpanExporter keeps the exported spans in memory
+type testSpanExporter struct {
+	spans []tracing.Span
+}
+
+func (e *testSpanExporter) ExportSpans(ctx context.Context, spans []tracing.Span) error {
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *testSpanExporter) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+// testStreamRecorder adds CloseNotify to the ResponseRecorder, which is required for streaming
+type testStreamRecorder struct {
+	*httptest.ResponseRecorder
+}
+
+func (r *testStreamRecorder) CloseNotify() <-chan bool {
+	return make(chan bool)
+}
+
 func testAssertAndDropDynamicEntryFields(t *testing.T, entry log.Entry) log.Fields {
 	// Duplicate fields
 	fields := make(log.Fields, len(entry.Data))
@@ -139,6 +280,14 @@ func testAssertAndDropDynamicEntryFields(t *testing.T, entry log.Entry) log.Fiel
 	require.True(t, int64(operationTime) < 2*time.Second.Milliseconds(), "operation_time should be less than 2 seconds")
 	delete(fields, "operation_time")
 
+	// Assert trace_id and span_id => Valid W3C trace context
+	require.Contains(t, fields, "trace_id")
+	require.Contains(t, fields, "span_id")
+	traceContext := tracing.TraceContext{TraceID: fields["trace_id"].(string), SpanID: fields["span_id"].(string)}
+	require.True(t, traceContext.IsValid(), "trace_id and span_id should be a valid trace context")
+	delete(fields, "trace_id")
+	delete(fields, "span_id")
+
 	// Return remaining fields
 	return fields
 }
@@ -0,0 +1,116 @@
+package gin
+
+import (
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/skiprco/go-utils/v2/converters"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/validation"
+)
+
+// Bind decodes the query parameters and the body of the request into obj (which should be a pointer)
+// and sanitizes all strings with converters.SanitizeObject.
+// Query parameters and form fields are matched with the form struct tag, JSON with the json struct tag.
+// Supported content types for the body are JSON, URL encoded form and multipart form. Empty bodies are ignored.
+// On failure, the request is aborted with the status code of the error and the error as micro error JSON.
+//
+// Raises
+//
+// - 400/invalid_request_body: Query parameters or body could not be decoded into obj
+//
+// - 415/unsupported_content_type: Content type of the body is not supported
+//
+// - All errors of converters.SanitizeObject
+func Bind(c *gin.Context, obj interface{}) *errors.GenericError {
+	genErr := bind(c, obj)
+	if genErr != nil {
+		abortWithGenericError(c, genErr)
+	}
+	return genErr
+}
+
+// BindAndValidate is equal to Bind, but validates obj with validation.Struct after sanitation.
+// Handlers should return immediately if an error is returned, since the request is already aborted.
+//
+//	var request CreateBookingRequest
+//	if genErr := gin.BindAndValidate(c, &request); genErr != nil {
+//	    return
+//	}
+//
+// Raises
+//
+// - 400/invalid_request_body: Query parameters or body could not be decoded into obj
+//
+// - 415/unsupported_content_type: Content type of the body is not supported
+//
+// - 400/validation_failed: At least one field is invalid. Meta contains the field path as key and the failed rule as value.
+//
+// - All errors of converters.SanitizeObject and validation.Struct
+func BindAndValidate(c *gin.Context, obj interface{}) *errors.GenericError {
+	genErr := bind(c, obj)
+	if genErr == nil {
+		genErr = validation.Struct(obj)
+	}
+	if genErr != nil {
+		abortWithGenericError(c, genErr)
+	}
+	return genErr
+}
+
+// bind decodes and sanitizes the request without aborting
+//
+// Raises
+//
+// - 400/invalid_request_body: Query parameters or body could not be decoded into obj
+//
+// - 415/unsupported_content_type: Content type of the body is not supported
+//
+// - All errors of converters.SanitizeObject
+func bind(c *gin.Context, obj interface{}) *errors.GenericError {
+	// Decode query parameters
+	if len(c.Request.URL.Query()) > 0 {
+		if err := binding.Query.Bind(c.Request, obj); err != nil {
+			return newInvalidRequestBodyError(err)
+		}
+	}
+
+	// Decode body
+	if c.Request.Body != nil && c.Request.ContentLength != 0 {
+		var bodyBinding binding.Binding
+		switch c.ContentType() {
+		case binding.MIMEJSON:
+			bodyBinding = binding.JSON
+		case binding.MIMEPOSTForm:
+			bodyBinding = binding.Form
+		case binding.MIMEMultipartPOSTForm:
+			bodyBinding = binding.FormMultipart
+		default:
+			meta := map[string]string{"content_type": c.ContentType()}
+			return errors.NewGenericError(415, errorDomain, errorSubDomain, ErrorUnsupportedContentType, meta)
+		}
+		if err := c.ShouldBindWith(obj, bodyBinding); err != nil {
+			return newInvalidRequestBodyError(err)
+		}
+	}
+
+	// Sanitize result
+	return converters.SanitizeObject(obj)
+}
+
+// newInvalidRequestBodyError converts a decode error to a GenericError.
+// The field is added to the meta if the error is caused by a value with a wrong type.
+func newInvalidRequestBodyError(err error) *errors.GenericError {
+	meta := map[string]string{"reason": err.Error()}
+	if typeErr, ok := err.(*json.UnmarshalTypeError); ok && typeErr.Field != "" {
+		meta = map[string]string{"field": typeErr.Field, "reason": "expected " + typeErr.Type.String()}
+	}
+	return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidRequestBody, meta)
+}
+
+// abortWithGenericError aborts the request and writes the error as micro error JSON
+func abortWithGenericError(c *gin.Context, genErr *errors.GenericError) {
+	_ = c.Error(genErr)
+	c.AbortWithStatusJSON(genErr.Code, genErr.ToMicroError())
+}
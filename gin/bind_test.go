@@ -0,0 +1,103 @@
+package gin
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBindRequest struct {
+	Name  string `json:"name" form:"name" validate:"required"`
+	Email string `json:"email" form:"email" validate:"omitempty,email"`
+	Seats int    `json:"seats" form:"seats" validate:"min=1"`
+	Dry   bool   `json:"-" form:"dry"`
+}
+
+func testBindContext(method string, target string, contentType string, body string) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, target, strings.NewReader(body))
+	if contentType != "" {
+		c.Request.Header.Set("Content-Type", contentType)
+	}
+	return c, w
+}
+
+func Test_Bind_JSON_Success(t *testing.T) {
+	c, _ := testBindContext("POST", "/bookings?dry=true", "application/json", `{"name": "<b>John</b>", "seats": 2}`)
+	var request testBindRequest
+	genErr := Bind(c, &request)
+	require.Nil(t, genErr)
+	assert.Equal(t, testBindRequest{Name: "John", Seats: 2, Dry: true}, request)
+	assert.False(t, c.IsAborted())
+}
+
+func Test_Bind_Form_Success(t *testing.T) {
+	c, _ := testBindContext("POST", "/bookings", "application/x-www-form-urlencoded", "name=John&seats=3")
+	var request testBindRequest
+	genErr := Bind(c, &request)
+	require.Nil(t, genErr)
+	assert.Equal(t, testBindRequest{Name: "John", Seats: 3}, request)
+}
+
+func Test_Bind_Query_Success(t *testing.T) {
+	c, _ := testBindContext("GET", "/bookings?name=John&seats=1", "", "")
+	var request testBindRequest
+	genErr := Bind(c, &request)
+	require.Nil(t, genErr)
+	assert.Equal(t, testBindRequest{Name: "John", Seats: 1}, request)
+}
+
+func Test_Bind_InvalidBody_Failure(t *testing.T) {
+	c, w := testBindContext("POST", "/bookings", "application/json", `{"name": "John", "seats": "two"}`)
+	var request testBindRequest
+	genErr := Bind(c, &request)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidRequestBody, map[string]string{"field": "seats", "reason": "expected int"})
+	assert.True(t, c.IsAborted())
+	assert.Equal(t, 400, w.Code)
+
+	c, _ = testBindContext("POST", "/bookings", "application/json", `{"name":`)
+	genErr = Bind(c, &request)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidRequestBody, nil)
+}
+
+func Test_Bind_UnsupportedContentType_Failure(t *testing.T) {
+	c, w := testBindContext("POST", "/bookings", "text/plain", "John")
+	var request testBindRequest
+	genErr := Bind(c, &request)
+	errors.AssertGenericError(t, genErr, 415, ErrorUnsupportedContentType, map[string]string{"content_type": "text/plain"})
+	assert.Equal(t, 415, w.Code)
+}
+
+func Test_BindAndValidate_Success(t *testing.T) {
+	c, _ := testBindContext("POST", "/bookings", "application/json", `{"name": "John", "email": "john@example.com", "seats": 1}`)
+	var request testBindRequest
+	genErr := BindAndValidate(c, &request)
+	require.Nil(t, genErr)
+	assert.Equal(t, testBindRequest{Name: "John", Email: "john@example.com", Seats: 1}, request)
+}
+
+func Test_BindAndValidate_ValidationFailed_Failure(t *testing.T) {
+	c, w := testBindContext("POST", "/bookings", "application/json", `{"name": "<b></b>", "email": "john", "seats": 0}`)
+	var request testBindRequest
+	genErr := BindAndValidate(c, &request)
+
+	// All field errors are listed in a single error
+	expectedMeta := map[string]string{"name": "required", "email": "email", "seats": "min"}
+	errors.AssertGenericError(t, genErr, 400, validation.ErrorValidationFailed, expectedMeta)
+	assert.Equal(t, expectedMeta, genErr.Meta)
+	assert.True(t, c.IsAborted())
+
+	// Response contains the error as micro error
+	assert.Equal(t, 400, w.Code)
+	var response map[string]interface{}
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Contains(t, response["detail"], "go_utils/validation/validation_failed/")
+}
@@ -0,0 +1,42 @@
+package gin
+
+const errorDomain = "go_utils"
+const errorSubDomain = "gin"
+
+// ErrorInvalidPageParameter indicates a pagination query parameter has an invalid value.
+const ErrorInvalidPageParameter = "invalid_page_parameter"
+
+// ErrorInvalidPageCursor indicates the provided cursor could not be decoded
+// or was created for another endpoint.
+const ErrorInvalidPageCursor = "invalid_page_cursor"
+
+// ErrorInvalidRequestBody indicates the query parameters or body of the request could not be decoded.
+const ErrorInvalidRequestBody = "invalid_request_body"
+
+// ErrorUnsupportedContentType indicates the content type of the request body is not supported.
+const ErrorUnsupportedContentType = "unsupported_content_type"
+
+// ErrorHealthCheckTimeout indicates a health check didn't complete within the timeout.
+const ErrorHealthCheckTimeout = "health_check_timeout"
+
+// ErrorHealthCheckPanicked indicates a health check panicked.
+const ErrorHealthCheckPanicked = "health_check_panicked"
+
+// ErrorDependencyUnreachable indicates a HTTP dependency is not reachable or returned a server error.
+const ErrorDependencyUnreachable = "dependency_unreachable"
+
+// ErrorRateLimitExceeded indicates the client sent too many requests. Meta contains the policy and retry_after in seconds.
+const ErrorRateLimitExceeded = "rate_limit_exceeded"
+
+// ErrorInvalidIdempotencyKey indicates the Idempotency-Key header is too long.
+const ErrorInvalidIdempotencyKey = "invalid_idempotency_key"
+
+// ErrorIdempotencyKeyReused indicates the Idempotency-Key was already used for a request with a different payload.
+const ErrorIdempotencyKeyReused = "idempotency_key_reused"
+
+// ErrorIdempotentRequestInProgress indicates a request with the same Idempotency-Key is still being processed.
+const ErrorIdempotentRequestInProgress = "idempotent_request_in_progress"
+
+// ErrorIdempotencyLockExpired indicates the response can't be stored, since the lock on the Idempotency-Key expired
+// and the key was taken by another request.
+const ErrorIdempotencyLockExpired = "idempotency_lock_expired"
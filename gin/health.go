@@ -0,0 +1,287 @@
+package gin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/manifest"
+)
+
+// HealthStatus is the status of a health check or the service
+type HealthStatus string
+
+const (
+	// HealthStatusOK indicates the check passed
+	HealthStatusOK HealthStatus = "ok"
+
+	// HealthStatusUnavailable indicates the check failed
+	HealthStatusUnavailable HealthStatus = "unavailable"
+)
+
+// HealthCheck checks if a dependency of the service is available
+type HealthCheck struct {
+	// Name is used as key in the readiness report
+	Name string
+
+	// Check returns an error if the dependency is not available.
+	// The context is cancelled when the timeout expires.
+	Check func(ctx context.Context) *errors.GenericError
+}
+
+// HealthCheckResult is the result of a single health check
+type HealthCheckResult struct {
+	Status     HealthStatus `json:"status"`
+	DurationMs int64        `json:"duration_ms"`
+	Error      string       `json:"error,omitempty"`
+}
+
+// HealthReport is the response of the readiness endpoint
+type HealthReport struct {
+	Status    HealthStatus                 `json:"status"`
+	CheckedAt time.Time                    `json:"checked_at"`
+	Checks    map[string]HealthCheckResult `json:"checks"`
+}
+
+// VersionInfo is the response of the version endpoint
+type VersionInfo struct {
+	ServiceName string   `json:"service_name"`
+	Name        string   `json:"name"`
+	Version     string   `json:"version"`
+	Depends     []string `json:"depends"`
+}
+
+// HealthOptions defines the endpoints and behaviour of RegisterHealth
+type HealthOptions struct {
+	// LivenessPath responds 200 as long as the service is running. Not registered if empty.
+	LivenessPath string
+
+	// ReadinessPath runs all checks and responds 503 if at least one check fails. Not registered if empty.
+	ReadinessPath string
+
+	// VersionPath responds with the name, version and dependencies of the manifest. Not registered if empty.
+	VersionPath string
+
+	// Timeout is the maximum duration of a single check
+	Timeout time.Duration
+
+	// CacheDuration is the duration the readiness report is reused, to protect the dependencies from frequent probes.
+	// 0 disables caching.
+	CacheDuration time.Duration
+
+	// Manifest is returned by the version endpoint. Loaded with manifest.LoadManifest if nil.
+	Manifest *manifest.Manifest
+}
+
+// DefaultHealthOptions registers /health, /ready and /version with a timeout of 5 seconds and a cache of 10 seconds
+var DefaultHealthOptions = HealthOptions{
+	LivenessPath:  "/health",
+	ReadinessPath: "/ready",
+	VersionPath:   "/version",
+	Timeout:       5 * time.Second,
+	CacheDuration: 10 * time.Second,
+}
+
+// RegisterHealth registers the liveness, readiness and version endpoints using DefaultHealthOptions.
+// The checks are run concurrently on each readiness probe (unless cached).
+//
+//	gin.RegisterHealth(router,
+//	    gin.NewMongoHealthCheck(repository),
+//	    gin.NewHTTPHealthCheck("pricing-api", "https://pricing.skipr.co/health"),
+//	)
+func RegisterHealth(router gin.IRoutes, checks ...HealthCheck) {
+	RegisterHealthWithOptions(router, DefaultHealthOptions, checks...)
+}
+
+// RegisterHealthWithOptions is equal to RegisterHealth, but registers the endpoints according to the provided options
+func RegisterHealthWithOptions(router gin.IRoutes, options HealthOptions, checks ...HealthCheck) {
+	if options.LivenessPath != "" {
+		router.GET(options.LivenessPath, func(c *gin.Context) {
+			c.JSON(200, gin.H{"status": HealthStatusOK})
+		})
+	}
+
+	if options.ReadinessPath != "" {
+		checker := &healthChecker{options: options, checks: checks}
+		router.GET(options.ReadinessPath, func(c *gin.Context) {
+			report := checker.report(c.Request.Context())
+			code := 200
+			if report.Status != HealthStatusOK {
+				code = 503
+			}
+			c.JSON(code, report)
+		})
+	}
+
+	if options.VersionPath != "" {
+		serviceManifest := options.Manifest
+		var genErr *errors.GenericError
+		if serviceManifest == nil {
+			serviceManifest, genErr = manifest.LoadManifest()
+		}
+		router.GET(options.VersionPath, func(c *gin.Context) {
+			if genErr != nil {
+				abortWithGenericError(c, genErr)
+				return
+			}
+			c.JSON(200, VersionInfo{
+				ServiceName: serviceManifest.ServiceName,
+				Name:        serviceManifest.Name,
+				Version:     serviceManifest.Version,
+				Depends:     serviceManifest.Depends,
+			})
+		})
+	}
+}
+
+// NewHealthCheck creates a health check from a custom function
+func NewHealthCheck(name string, check func(ctx context.Context) *errors.GenericError) HealthCheck {
+	return HealthCheck{Name: name, Check: check}
+}
+
+// Pinger is implemented by dependencies which can be pinged (e.g. mongo.IMongoRepository)
+type Pinger interface {
+	Ping(ctx context.Context) *errors.GenericError
+}
+
+// NewMongoHealthCheck creates a health check named "mongo" which pings the database through the repository
+func NewMongoHealthCheck(repository Pinger) HealthCheck {
+	return NewHealthCheck("mongo", repository.Ping)
+}
+
+// NewHTTPHealthCheck creates a health check which sends a GET request to the URL.
+// The dependency is reachable if it responds with a status code below 500.
+//
+// Raises (by Check)
+//
+// - 503/dependency_unreachable: Request failed or server responded with a status code >= 500
+func NewHTTPHealthCheck(name string, url string) HealthCheck {
+	return NewHealthCheck(name, func(ctx context.Context) *errors.GenericError {
+		meta := map[string]string{"url": url}
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err == nil {
+			var res *http.Response
+			res, err = http.DefaultClient.Do(req)
+			if err == nil {
+				res.Body.Close()
+				if res.StatusCode < 500 {
+					return nil
+				}
+				meta["status_code"] = strconv.Itoa(res.StatusCode)
+			}
+		}
+		if err != nil {
+			meta["reason"] = err.Error()
+		}
+		return errors.NewGenericError(503, errorDomain, errorSubDomain, ErrorDependencyUnreachable, meta)
+	})
+}
+
+// healthChecker runs the checks and caches the report
+type healthChecker struct {
+	options    HealthOptions
+	checks     []HealthCheck
+	mutex      sync.Mutex
+	lastReport *HealthReport
+	running    chan struct{} // Closed when the running checks are finished. Nil if no checks are running.
+}
+
+// report returns the cached report or runs the checks.
+// Checks run in the background, so they are not cancelled when the probe which started them disconnects.
+// Concurrent probes wait for the running checks instead of starting new ones.
+// If the context of the probe is done before the checks are finished, an uncached unavailable report is returned.
+func (h *healthChecker) report(ctx context.Context) HealthReport {
+	h.mutex.Lock()
+	if h.lastReport != nil && time.Since(h.lastReport.CheckedAt) < h.options.CacheDuration {
+		report := *h.lastReport
+		h.mutex.Unlock()
+		return report
+	}
+	if h.running == nil {
+		h.running = make(chan struct{})
+		go h.runChecks(h.running)
+	}
+	running := h.running
+	h.mutex.Unlock()
+
+	select {
+	case <-running:
+		h.mutex.Lock()
+		defer h.mutex.Unlock()
+		return *h.lastReport
+	case <-ctx.Done():
+		return HealthReport{Status: HealthStatusUnavailable, CheckedAt: time.Now().UTC(), Checks: map[string]HealthCheckResult{}}
+	}
+}
+
+// runChecks runs all checks concurrently, stores the report and closes done
+func (h *healthChecker) runChecks(done chan struct{}) {
+	results := make([]HealthCheckResult, len(h.checks))
+	var wg sync.WaitGroup
+	for i, check := range h.checks {
+		wg.Add(1)
+		go func(i int, check HealthCheck) {
+			defer wg.Done()
+			results[i] = h.run(context.Background(), check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	// Build report
+	report := HealthReport{Status: HealthStatusOK, CheckedAt: time.Now().UTC(), Checks: map[string]HealthCheckResult{}}
+	for i, check := range h.checks {
+		report.Checks[check.Name] = results[i]
+		if results[i].Status != HealthStatusOK {
+			report.Status = HealthStatusUnavailable
+		}
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.lastReport = &report
+	h.running = nil
+	close(done)
+}
+
+// run executes a single check within the timeout
+func (h *healthChecker) run(ctx context.Context, check HealthCheck) HealthCheckResult {
+	start := time.Now()
+	if h.options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.options.Timeout)
+		defer cancel()
+	}
+
+	// Run check in background to stop waiting when the timeout expires
+	done := make(chan *errors.GenericError, 1)
+	go func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				meta := map[string]string{"check": check.Name, "reason": fmt.Sprint(recovered)}
+				done <- errors.NewGenericError(500, errorDomain, errorSubDomain, ErrorHealthCheckPanicked, meta)
+			}
+		}()
+		done <- check.Check(ctx)
+	}()
+
+	var genErr *errors.GenericError
+	select {
+	case genErr = <-done:
+	case <-ctx.Done():
+		meta := map[string]string{"check": check.Name}
+		genErr = errors.NewGenericError(503, errorDomain, errorSubDomain, ErrorHealthCheckTimeout, meta)
+	}
+
+	// Build result
+	result := HealthCheckResult{Status: HealthStatusOK, DurationMs: time.Since(start).Milliseconds()}
+	if genErr != nil {
+		result.Status = HealthStatusUnavailable
+		result.Error = genErr.GetDetailString()
+	}
+	return result
+}
@@ -0,0 +1,192 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/manifest"
+	"github.com/skiprco/go-utils/v2/mongo/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testHealthRouter(options HealthOptions, checks ...HealthCheck) *gin.Engine {
+	router := gin.New()
+	RegisterHealthWithOptions(router, options, checks...)
+	return router
+}
+
+func testHealthRequest(t *testing.T, router *gin.Engine, path string, response interface{}) int {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), response))
+	return w.Code
+}
+
+func testHealthServer(code int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(code)
+	}))
+}
+
+func Test_RegisterHealth_Liveness_Success(t *testing.T) {
+	failing := NewHealthCheck("failing", func(ctx context.Context) *errors.GenericError {
+		return errors.NewGenericError(503, "test", "test", "failing", nil)
+	})
+	router := testHealthRouter(DefaultHealthOptions, failing)
+
+	var response map[string]string
+	code := testHealthRequest(t, router, "/health", &response)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, map[string]string{"status": "ok"}, response)
+}
+
+func Test_RegisterHealth_Readiness_Success(t *testing.T) {
+	repository := &mocks.IMongoRepository{}
+	repository.On("Ping", mock.Anything).Return(nil)
+	custom := NewHealthCheck("custom", func(ctx context.Context) *errors.GenericError { return nil })
+	router := testHealthRouter(DefaultHealthOptions, NewMongoHealthCheck(repository), custom)
+
+	var report HealthReport
+	code := testHealthRequest(t, router, "/ready", &report)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, HealthStatusOK, report.Status)
+	assert.Len(t, report.Checks, 2)
+	assert.Equal(t, HealthStatusOK, report.Checks["mongo"].Status)
+	assert.Equal(t, HealthStatusOK, report.Checks["custom"].Status)
+	repository.AssertExpectations(t)
+}
+
+func Test_RegisterHealth_Readiness_Failure(t *testing.T) {
+	options := DefaultHealthOptions
+	options.Timeout = 10 * time.Millisecond
+	checks := []HealthCheck{
+		NewHealthCheck("ok", func(ctx context.Context) *errors.GenericError { return nil }),
+		NewHealthCheck("failing", func(ctx context.Context) *errors.GenericError {
+			return errors.NewGenericError(503, "test", "test", "failing", nil)
+		}),
+		NewHealthCheck("slow", func(ctx context.Context) *errors.GenericError {
+			time.Sleep(time.Second)
+			return nil
+		}),
+		NewHealthCheck("panicking", func(ctx context.Context) *errors.GenericError {
+			panic("test-panic")
+		}),
+	}
+	router := testHealthRouter(options, checks...)
+
+	var report HealthReport
+	code := testHealthRequest(t, router, "/ready", &report)
+	assert.Equal(t, 503, code)
+	assert.Equal(t, HealthStatusUnavailable, report.Status)
+	assert.Equal(t, HealthStatusOK, report.Checks["ok"].Status)
+	assert.Equal(t, HealthStatusUnavailable, report.Checks["failing"].Status)
+	assert.Contains(t, report.Checks["failing"].Error, "failing")
+	assert.Contains(t, report.Checks["slow"].Error, ErrorHealthCheckTimeout)
+	assert.Contains(t, report.Checks["panicking"].Error, ErrorHealthCheckPanicked)
+}
+
+func Test_RegisterHealth_Readiness_Cached(t *testing.T) {
+	calls := 0
+	check := NewHealthCheck("counted", func(ctx context.Context) *errors.GenericError {
+		calls++
+		return nil
+	})
+
+	// Cached
+	router := testHealthRouter(DefaultHealthOptions, check)
+	var report HealthReport
+	testHealthRequest(t, router, "/ready", &report)
+	testHealthRequest(t, router, "/ready", &report)
+	assert.Equal(t, 1, calls)
+
+	// Not cached
+	options := DefaultHealthOptions
+	options.CacheDuration = 0
+	router = testHealthRouter(options, check)
+	testHealthRequest(t, router, "/ready", &report)
+	testHealthRequest(t, router, "/ready", &report)
+	assert.Equal(t, 3, calls)
+}
+
+func Test_RegisterHealth_Readiness_ProbeCancelled(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var checkErr error
+	check := NewHealthCheck("blocking", func(ctx context.Context) *errors.GenericError {
+		close(started)
+		<-release
+		checkErr = ctx.Err()
+		return nil
+	})
+	router := testHealthRouter(DefaultHealthOptions, check)
+
+	// Cancel probe while the check is running
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/ready", nil).WithContext(ctx))
+	assert.Equal(t, 503, w.Code)
+
+	// Next probe gets the result of the same checks
+	close(release)
+	var report HealthReport
+	code := testHealthRequest(t, router, "/ready", &report)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, HealthStatusOK, report.Checks["blocking"].Status)
+	assert.Nil(t, checkErr) // Context of the check is not cancelled with the probe
+}
+
+func Test_NewHTTPHealthCheck_Success(t *testing.T) {
+	ts := testHealthServer(404)
+	defer ts.Close()
+	genErr := NewHTTPHealthCheck("test", ts.URL).Check(context.Background())
+	assert.Nil(t, genErr)
+}
+
+func Test_NewHTTPHealthCheck_Failure(t *testing.T) {
+	ts := testHealthServer(500)
+	genErr := NewHTTPHealthCheck("test", ts.URL).Check(context.Background())
+	errors.AssertGenericError(t, genErr, 503, ErrorDependencyUnreachable, map[string]string{"url": ts.URL, "status_code": "500"})
+
+	// Unreachable
+	ts.Close()
+	genErr = NewHTTPHealthCheck("test", ts.URL).Check(context.Background())
+	errors.AssertGenericError(t, genErr, 503, ErrorDependencyUnreachable, map[string]string{"url": ts.URL})
+}
+
+func Test_RegisterHealth_Version_Success(t *testing.T) {
+	options := DefaultHealthOptions
+	options.Manifest = &manifest.Manifest{
+		ServiceName: "go.micro.srv.booking",
+		Name:        "booking",
+		Version:     "1.2.3",
+		Depends:     []string{"pricing"},
+	}
+	router := testHealthRouter(options)
+
+	var response VersionInfo
+	code := testHealthRequest(t, router, "/version", &response)
+	assert.Equal(t, 200, code)
+	expected := VersionInfo{ServiceName: "go.micro.srv.booking", Name: "booking", Version: "1.2.3", Depends: []string{"pricing"}}
+	assert.Equal(t, expected, response)
+}
+
+func Test_RegisterHealth_Version_ManifestNotFound(t *testing.T) {
+	router := testHealthRouter(DefaultHealthOptions)
+
+	var response map[string]interface{}
+	code := testHealthRequest(t, router, "/version", &response)
+	assert.Equal(t, 404, code)
+	assert.Contains(t, response["detail"], "manifest_file_not_found")
+}
@@ -0,0 +1,251 @@
+package gin
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/skiprco/go-utils/v2/collections"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/metadata"
+)
+
+// IdempotencyKeyHeader is the request header containing the key chosen by the client
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotencyReplayedHeader is set to "true" on responses which are replayed from the store
+const IdempotencyReplayedHeader = "Idempotent-Replayed"
+
+// IdempotencyRecord contains the stored response of a request
+type IdempotencyRecord struct {
+	// Key is the Idempotency-Key, prefixed with the user_id in the metadata (if any)
+	Key string `bson:"_id" json:"key"`
+
+	// RequestHash is the SHA-256 of the method, path and body to detect reuse of the key for another request
+	RequestHash string `bson:"request_hash" json:"request_hash"`
+
+	// Completed is false while the first request is processed
+	Completed bool `bson:"completed" json:"completed"`
+
+	StatusCode int                 `bson:"status_code" json:"status_code"`
+	Header     map[string][]string `bson:"header" json:"header"`
+	Body       []byte              `bson:"body" json:"body"`
+
+	// ExpiresAt is the moment after which the key can be reused.
+	// Incomplete records expire after IdempotencyOptions.LockTTL, completed records after IdempotencyOptions.TTL.
+	ExpiresAt time.Time `bson:"expires_at" json:"expires_at"`
+}
+
+// IdempotencyStore keeps the records of the idempotent requests.
+// Implement this interface to use another backend. Start should be atomic for a single key.
+type IdempotencyStore interface {
+	// Start stores the incomplete record if no record with the same key exists (or it's expired)
+	// and returns nil. Otherwise the existing record is returned.
+	Start(ctx context.Context, record IdempotencyRecord) (*IdempotencyRecord, *errors.GenericError)
+
+	// Complete replaces the incomplete record with the same key and request hash by the completed record.
+	// Should fail with 409/idempotency_lock_expired if no such record exists, since the lock expired
+	// and the key was taken by another request in the meantime.
+	Complete(ctx context.Context, record IdempotencyRecord) *errors.GenericError
+
+	// Delete removes the incomplete record with the same key and request hash, so the request can be retried.
+	// Completed records and records of other requests are kept.
+	Delete(ctx context.Context, record IdempotencyRecord) *errors.GenericError
+}
+
+// IdempotencyOptions defines which requests are handled by the IdempotencyMiddleware
+type IdempotencyOptions struct {
+	// TTL is the duration a response is replayed
+	TTL time.Duration
+
+	// LockTTL is the duration a key is locked while the first request is processed.
+	// Limits the duration a key is blocked if the instance crashes during the request.
+	// If the request takes longer and the key is taken by another request, the response isn't stored.
+	LockTTL time.Duration
+
+	// StoreTimeout is the timeout to store or release the response. The request context isn't used,
+	// since it's cancelled when the client disconnects while the response should still be stored.
+	StoreTimeout time.Duration
+
+	// Methods are the HTTP methods which honour the Idempotency-Key header
+	Methods []string
+
+	// MaxKeyLength is the maximum length of the Idempotency-Key header
+	MaxKeyLength int
+}
+
+// DefaultIdempotencyOptions replays responses of POST and PATCH requests for 24 hours
+// and locks keys for at most 1 minute while the first request is processed
+var DefaultIdempotencyOptions = IdempotencyOptions{
+	TTL:          24 * time.Hour,
+	LockTTL:      time.Minute,
+	StoreTimeout: 5 * time.Second,
+	Methods:      []string{"POST", "PATCH"},
+	MaxKeyLength: 255,
+}
+
+// IdempotencyMiddleware replays the response of the first request for retries with the same Idempotency-Key header,
+// using DefaultIdempotencyOptions. Keys are scoped per user_id in the metadata, so the authentication
+// middleware should be registered before this middleware. Responses with status code >= 500 are not stored,
+// so the request can be retried. Requests without the header are not affected.
+//
+// Raises
+//
+// - 400/invalid_idempotency_key: Idempotency-Key header is too long
+//
+// - 409/idempotent_request_in_progress: A request with the same key is still being processed
+//
+// - 422/idempotency_key_reused: The key was already used for a request with a different method, path or body
+//
+// - All errors of the store
+func IdempotencyMiddleware(store IdempotencyStore) gin.HandlerFunc {
+	return IdempotencyMiddlewareWithOptions(store, DefaultIdempotencyOptions)
+}
+
+// IdempotencyMiddlewareWithOptions is equal to IdempotencyMiddleware, but handles the requests according to the provided options
+//
+// Raises
+//
+// - See IdempotencyMiddleware
+func IdempotencyMiddlewareWithOptions(store IdempotencyStore, options IdempotencyOptions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Check if request is idempotent
+		idempotencyKey := c.GetHeader(IdempotencyKeyHeader)
+		if idempotencyKey == "" || !collections.StringSliceContains(options.Methods, c.Request.Method) {
+			c.Next()
+			return
+		}
+		if options.MaxKeyLength > 0 && len(idempotencyKey) > options.MaxKeyLength {
+			meta := map[string]string{"max_length": strconv.Itoa(options.MaxKeyLength)}
+			abortWithGenericError(c, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidIdempotencyKey, meta))
+			return
+		}
+
+		// Build record
+		requestHash, genErr := hashIdempotentRequest(c.Request)
+		if genErr != nil {
+			abortWithGenericError(c, genErr)
+			return
+		}
+		record := IdempotencyRecord{
+			Key:         metadata.GetGinMetadata(c).Get("user_id") + ":" + idempotencyKey,
+			RequestHash: requestHash,
+			ExpiresAt:   time.Now().UTC().Add(options.LockTTL),
+		}
+
+		// Start request
+		existing, genErr := store.Start(c.Request.Context(), record)
+		if genErr != nil {
+			abortWithGenericError(c, genErr)
+			return
+		}
+		if existing != nil {
+			replayIdempotentRequest(c, record, *existing)
+			return
+		}
+
+		// Release key if handler panics
+		completed := false
+		defer func() {
+			if !completed {
+				deleteIdempotencyRecord(store, options, record)
+			}
+		}()
+
+		// Capture response
+		blw := &bodyLogWriter{ResponseWriter: c.Writer, capture: &payloadCapture{}}
+		c.Writer = blw
+		c.Next()
+
+		// Release key for server errors and responses which aren't captured (hijacked connections)
+		completed = true
+		if c.Writer.Status() >= 500 || blw.capture.omitted {
+			deleteIdempotencyRecord(store, options, record)
+			return
+		}
+
+		// Store response
+		record.Completed = true
+		record.StatusCode = c.Writer.Status()
+		record.Header = c.Writer.Header().Clone()
+		record.Body = blw.capture.body.Bytes()
+		record.ExpiresAt = time.Now().UTC().Add(options.TTL)
+		ctx, cancel := storeContext(options)
+		defer cancel()
+		if genErr := store.Complete(ctx, record); genErr != nil {
+			log.WithFields(log.Fields{"error": genErr, "idempotency_key": record.Key}).Warn("Failed to store idempotent response")
+			deleteIdempotencyRecord(store, options, record)
+		}
+	}
+}
+
+// hashIdempotentRequest returns the SHA-256 of the method, path and body. The body is replaced, so it can be read again.
+//
+// Raises
+//
+// - 400/invalid_request_body: Failed to read the body
+func hashIdempotentRequest(req *http.Request) (string, *errors.GenericError) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		if err != nil {
+			return "", newInvalidRequestBodyError(err)
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(req.Method + " " + req.URL.Path + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// replayIdempotentRequest writes the stored response or aborts with an error if it can't be replayed
+//
+// Raises
+//
+// - 409/idempotent_request_in_progress: A request with the same key is still being processed
+//
+// - 422/idempotency_key_reused: The key was already used for a request with a different method, path or body
+func replayIdempotentRequest(c *gin.Context, record IdempotencyRecord, existing IdempotencyRecord) {
+	meta := map[string]string{"idempotency_key": c.GetHeader(IdempotencyKeyHeader)}
+	switch {
+	case existing.RequestHash != record.RequestHash:
+		abortWithGenericError(c, errors.NewGenericError(422, errorDomain, errorSubDomain, ErrorIdempotencyKeyReused, meta))
+	case !existing.Completed:
+		abortWithGenericError(c, errors.NewGenericError(409, errorDomain, errorSubDomain, ErrorIdempotentRequestInProgress, meta))
+	default:
+		for key, values := range existing.Header {
+			c.Writer.Header()[key] = values
+		}
+		c.Header(IdempotencyReplayedHeader, "true")
+		c.Status(existing.StatusCode)
+		c.Writer.Write(existing.Body)
+		c.Abort()
+	}
+}
+
+// storeContext returns a context for the store which is detached from the request and limited by StoreTimeout
+func storeContext(options IdempotencyOptions) (context.Context, context.CancelFunc) {
+	if options.StoreTimeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), options.StoreTimeout)
+}
+
+// deleteIdempotencyRecord removes the incomplete record on a detached context. Failures are logged, since the lock expires anyway.
+func deleteIdempotencyRecord(store IdempotencyStore, options IdempotencyOptions, record IdempotencyRecord) {
+	ctx, cancel := storeContext(options)
+	defer cancel()
+	if genErr := store.Delete(ctx, record); genErr != nil {
+		log.WithFields(log.Fields{"error": genErr, "idempotency_key": record.Key}).Warn("Failed to delete idempotency record")
+	}
+}
@@ -0,0 +1,170 @@
+package gin
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/converters"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/mongo"
+)
+
+// MemoryIdempotencyStore keeps the idempotency records in memory. The records are not shared between instances.
+// A MemoryIdempotencyStore is safe for concurrent use and should be created with NewMemoryIdempotencyStore.
+type MemoryIdempotencyStore struct {
+	mutex   sync.Mutex
+	records map[string]IdempotencyRecord
+	now     func() time.Time
+}
+
+// NewMemoryIdempotencyStore creates a new empty in-memory store
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{
+		records: map[string]IdempotencyRecord{},
+		now:     time.Now,
+	}
+}
+
+// Start stores the incomplete record if no record with the same key exists (or it's expired).
+// Otherwise the existing record is returned. Expired records are removed.
+func (s *MemoryIdempotencyStore) Start(ctx context.Context, record IdempotencyRecord) (*IdempotencyRecord, *errors.GenericError) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Remove expired records
+	now := s.now()
+	for key, existing := range s.records {
+		if now.After(existing.ExpiresAt) {
+			delete(s.records, key)
+		}
+	}
+
+	// Return existing record
+	if existing, exists := s.records[record.Key]; exists {
+		return &existing, nil
+	}
+	s.records[record.Key] = record
+	return nil, nil
+}
+
+// Complete replaces the incomplete record with the same key and request hash by the completed record
+//
+// Raises
+//
+// - 409/idempotency_lock_expired: No incomplete record with the same key and request hash exists
+func (s *MemoryIdempotencyStore) Complete(ctx context.Context, record IdempotencyRecord) *errors.GenericError {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if !s.isLocked(record) {
+		return newIdempotencyLockExpiredError(record.Key)
+	}
+	s.records[record.Key] = record
+	return nil
+}
+
+// Delete removes the incomplete record with the same key and request hash
+func (s *MemoryIdempotencyStore) Delete(ctx context.Context, record IdempotencyRecord) *errors.GenericError {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isLocked(record) {
+		delete(s.records, record.Key)
+	}
+	return nil
+}
+
+// isLocked returns true if the stored record with the same key is incomplete and has the same request hash.
+// The mutex should be locked by the caller.
+func (s *MemoryIdempotencyStore) isLocked(record IdempotencyRecord) bool {
+	existing, exists := s.records[record.Key]
+	return exists && !existing.Completed && existing.RequestHash == record.RequestHash
+}
+
+// MongoIdempotencyStore keeps the idempotency records in a collection of a mongo repository, shared between instances.
+// Expired records are removed when the key is reused. Add a TTL index on expires_at to remove the others.
+type MongoIdempotencyStore struct {
+	repository     mongo.IMongoRepository
+	collectionName string
+}
+
+// NewMongoIdempotencyStore creates a store which uses the collection of the repository
+func NewMongoIdempotencyStore(repository mongo.IMongoRepository, collectionName string) *MongoIdempotencyStore {
+	return &MongoIdempotencyStore{repository: repository, collectionName: collectionName}
+}
+
+// mongoIdempotencySaveOption prevents the stored headers from being sanitized
+var mongoIdempotencySaveOption = &mongo.SaveOption{SanitizePolicy: converters.SanitizePolicyNone}
+
+// Start stores the incomplete record if no record with the same key exists (or it's expired).
+// Otherwise the existing record is returned.
+//
+// Raises
+//
+// - All errors of mongo.IMongoRepository.Create, GetOne and DeleteOne
+func (s *MongoIdempotencyStore) Start(ctx context.Context, record IdempotencyRecord) (*IdempotencyRecord, *errors.GenericError) {
+	// Retry once if the existing record is expired or removed in the meantime
+	for attempt := 0; attempt < 2; attempt++ {
+		// Create record
+		genErr := s.repository.Create(ctx, s.collectionName, record, "idempotency_start", mongoIdempotencySaveOption)
+		if genErr == nil {
+			return nil, nil
+		}
+		if genErr.Code != 409 {
+			return nil, genErr
+		}
+
+		// Fetch existing record
+		existing := &IdempotencyRecord{}
+		query := map[string]interface{}{"_id": record.Key}
+		genErr = s.repository.GetOne(ctx, s.collectionName, query, true, existing, "idempotency_start")
+		if genErr != nil {
+			return nil, genErr
+		}
+		if existing.Key != "" && time.Now().Before(existing.ExpiresAt) {
+			return existing, nil
+		}
+
+		// Remove expired record, unless it's replaced in the meantime
+		if existing.Key != "" {
+			query := map[string]interface{}{"_id": record.Key, "expires_at": existing.ExpiresAt}
+			if genErr = s.repository.DeleteOne(ctx, s.collectionName, query, "idempotency_start"); genErr != nil {
+				return nil, genErr
+			}
+		}
+	}
+	return nil, errors.NewGenericError(409, errorDomain, errorSubDomain, ErrorIdempotentRequestInProgress, map[string]string{"idempotency_key": record.Key})
+}
+
+// Complete replaces the incomplete record with the same key and request hash by the completed record
+//
+// Raises
+//
+// - 409/idempotency_lock_expired: No incomplete record with the same key and request hash exists
+//
+// - All errors of mongo.IMongoRepository.UpdateOne
+func (s *MongoIdempotencyStore) Complete(ctx context.Context, record IdempotencyRecord) *errors.GenericError {
+	genErr := s.repository.UpdateOne(ctx, s.collectionName, mongoIdempotencyLockQuery(record), record, "idempotency_complete", mongoIdempotencySaveOption)
+	if genErr != nil && genErr.Code == 404 {
+		return newIdempotencyLockExpiredError(record.Key)
+	}
+	return genErr
+}
+
+// Delete removes the incomplete record with the same key and request hash
+//
+// Raises
+//
+// - All errors of mongo.IMongoRepository.DeleteOne
+func (s *MongoIdempotencyStore) Delete(ctx context.Context, record IdempotencyRecord) *errors.GenericError {
+	return s.repository.DeleteOne(ctx, s.collectionName, mongoIdempotencyLockQuery(record), "idempotency_delete")
+}
+
+// mongoIdempotencyLockQuery matches the incomplete record with the same key and request hash
+func mongoIdempotencyLockQuery(record IdempotencyRecord) map[string]interface{} {
+	return map[string]interface{}{"_id": record.Key, "request_hash": record.RequestHash, "completed": false}
+}
+
+// newIdempotencyLockExpiredError creates a 409/idempotency_lock_expired error
+func newIdempotencyLockExpiredError(key string) *errors.GenericError {
+	return errors.NewGenericError(409, errorDomain, errorSubDomain, ErrorIdempotencyLockExpired, map[string]string{"idempotency_key": key})
+}
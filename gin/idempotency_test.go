@@ -0,0 +1,345 @@
+package gin
+
+import (
+	"context"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/metadata"
+	"github.com/skiprco/go-utils/v2/mongo/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testIdempotencyRouter returns a router which counts the calls to the handler
+func testIdempotencyRouter(store IdempotencyStore, code int) (*gin.Engine, *int) {
+	calls := 0
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		metadata.SetGinMetadata(c, "user_id", c.GetHeader("X-User"))
+	})
+	router.Use(IdempotencyMiddleware(store))
+	handler := func(c *gin.Context) {
+		calls++
+		c.Header("Location", "/bookings/"+strconv.Itoa(calls))
+		c.JSON(code, gin.H{"calls": calls})
+	}
+	router.POST("/bookings", handler)
+	router.GET("/bookings", handler)
+	return router, &calls
+}
+
+func testIdempotencyRequest(router *gin.Engine, method string, key string, user string, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, "/bookings", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	req.Header.Set("X-User", user)
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func Test_IdempotencyMiddleware_Replayed_Success(t *testing.T) {
+	router, calls := testIdempotencyRouter(NewMemoryIdempotencyStore(), 201)
+
+	first := testIdempotencyRequest(router, "POST", "test-key", "user-1", `{"seats": 2}`)
+	assert.Equal(t, 201, first.Code)
+	assert.Equal(t, "", first.Header().Get(IdempotencyReplayedHeader))
+
+	second := testIdempotencyRequest(router, "POST", "test-key", "user-1", `{"seats": 2}`)
+	assert.Equal(t, 201, second.Code)
+	assert.Equal(t, "true", second.Header().Get(IdempotencyReplayedHeader))
+	assert.Equal(t, first.Header().Get("Location"), second.Header().Get("Location"))
+	assert.Equal(t, first.Header().Get("Content-Type"), second.Header().Get("Content-Type"))
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, 1, *calls)
+}
+
+func Test_IdempotencyMiddleware_NotIdempotent_Success(t *testing.T) {
+	router, calls := testIdempotencyRouter(NewMemoryIdempotencyStore(), 201)
+
+	// Without header
+	testIdempotencyRequest(router, "POST", "", "user-1", `{}`)
+	testIdempotencyRequest(router, "POST", "", "user-1", `{}`)
+	assert.Equal(t, 2, *calls)
+
+	// Not an idempotent method
+	testIdempotencyRequest(router, "GET", "test-key", "user-1", ``)
+	testIdempotencyRequest(router, "GET", "test-key", "user-1", ``)
+	assert.Equal(t, 4, *calls)
+
+	// Keys are scoped per user
+	testIdempotencyRequest(router, "POST", "test-key", "user-1", `{}`)
+	testIdempotencyRequest(router, "POST", "test-key", "user-2", `{}`)
+	assert.Equal(t, 6, *calls)
+}
+
+// testContextIdempotencyStore fails like a database client when the context is cancelled
+type testContextIdempotencyStore struct {
+	*MemoryIdempotencyStore
+}
+
+func (s testContextIdempotencyStore) Complete(ctx context.Context, record IdempotencyRecord) *errors.GenericError {
+	if ctx.Err() != nil {
+		return errors.NewGenericError(500, "test", "test", "context_cancelled", nil)
+	}
+	return s.MemoryIdempotencyStore.Complete(ctx, record)
+}
+
+func (s testContextIdempotencyStore) Delete(ctx context.Context, record IdempotencyRecord) *errors.GenericError {
+	if ctx.Err() != nil {
+		return errors.NewGenericError(500, "test", "test", "context_cancelled", nil)
+	}
+	return s.MemoryIdempotencyStore.Delete(ctx, record)
+}
+
+func Test_IdempotencyMiddleware_ClientDisconnected_Success(t *testing.T) {
+	store := testContextIdempotencyStore{MemoryIdempotencyStore: NewMemoryIdempotencyStore()}
+	calls := 0
+	router := gin.New()
+	router.Use(IdempotencyMiddleware(store))
+	router.POST("/bookings", func(c *gin.Context) {
+		calls++
+		if cancel, ok := c.Request.Context().Value(testCancelKey{}).(context.CancelFunc); ok {
+			cancel() // Client disconnects before the handler returns
+		}
+		c.JSON(201, gin.H{"calls": calls})
+	})
+
+	// First request is cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = context.WithValue(ctx, testCancelKey{}, cancel)
+	req := httptest.NewRequest("POST", "/bookings", strings.NewReader(`{}`)).WithContext(ctx)
+	req.Header.Set(IdempotencyKeyHeader, "test-key")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	// Retry is replayed
+	w := testIdempotencyRequest(router, "POST", "test-key", "", `{}`)
+	assert.Equal(t, 201, w.Code)
+	assert.Equal(t, "true", w.Header().Get(IdempotencyReplayedHeader))
+	assert.Equal(t, 1, calls)
+}
+
+type testCancelKey struct{}
+
+func Test_IdempotencyMiddleware_LockExpired_Success(t *testing.T) {
+	now := time.Now()
+	store := NewMemoryIdempotencyStore()
+	store.now = func() time.Time { return now }
+	router, calls := testIdempotencyRouter(store, 201)
+
+	// Simulate crashed request
+	req := httptest.NewRequest("POST", "/bookings", strings.NewReader(`{}`))
+	requestHash, _ := hashIdempotentRequest(req)
+	lock := IdempotencyRecord{Key: "user-1:test-key", RequestHash: requestHash, ExpiresAt: now.Add(DefaultIdempotencyOptions.LockTTL)}
+	_, genErr := store.Start(context.Background(), lock)
+	require.Nil(t, genErr)
+	assert.Equal(t, 409, testIdempotencyRequest(router, "POST", "test-key", "user-1", `{}`).Code)
+
+	// Lock expired
+	now = now.Add(2 * DefaultIdempotencyOptions.LockTTL)
+	assert.Equal(t, 201, testIdempotencyRequest(router, "POST", "test-key", "user-1", `{}`).Code)
+	assert.Equal(t, 1, *calls)
+
+	// Response is kept for TTL
+	now = now.Add(time.Hour)
+	w := testIdempotencyRequest(router, "POST", "test-key", "user-1", `{}`)
+	assert.Equal(t, "true", w.Header().Get(IdempotencyReplayedHeader))
+}
+
+func Test_IdempotencyMiddleware_ServerError_Success(t *testing.T) {
+	router, calls := testIdempotencyRouter(NewMemoryIdempotencyStore(), 503)
+	testIdempotencyRequest(router, "POST", "test-key", "user-1", `{}`)
+	w := testIdempotencyRequest(router, "POST", "test-key", "user-1", `{}`)
+	assert.Equal(t, 503, w.Code)
+	assert.Equal(t, 2, *calls)
+}
+
+func Test_IdempotencyMiddleware_KeyReused_Failure(t *testing.T) {
+	router, calls := testIdempotencyRouter(NewMemoryIdempotencyStore(), 201)
+	testIdempotencyRequest(router, "POST", "test-key", "user-1", `{"seats": 2}`)
+	w := testIdempotencyRequest(router, "POST", "test-key", "user-1", `{"seats": 3}`)
+	assert.Equal(t, 422, w.Code)
+	assert.Contains(t, w.Body.String(), ErrorIdempotencyKeyReused)
+	assert.Equal(t, 1, *calls)
+}
+
+func Test_IdempotencyMiddleware_InProgress_Failure(t *testing.T) {
+	store := NewMemoryIdempotencyStore()
+	router, _ := testIdempotencyRouter(store, 201)
+
+	// Simulate request in progress
+	req := httptest.NewRequest("POST", "/bookings", strings.NewReader(`{}`))
+	requestHash, genErr := hashIdempotentRequest(req)
+	require.Nil(t, genErr)
+	_, genErr = store.Start(context.Background(), IdempotencyRecord{Key: "user-1:test-key", RequestHash: requestHash, ExpiresAt: time.Now().Add(time.Hour)})
+	require.Nil(t, genErr)
+
+	w := testIdempotencyRequest(router, "POST", "test-key", "user-1", `{}`)
+	assert.Equal(t, 409, w.Code)
+	assert.Contains(t, w.Body.String(), ErrorIdempotentRequestInProgress)
+}
+
+func Test_IdempotencyMiddleware_InvalidKey_Failure(t *testing.T) {
+	router, calls := testIdempotencyRouter(NewMemoryIdempotencyStore(), 201)
+	w := testIdempotencyRequest(router, "POST", strings.Repeat("a", 256), "user-1", `{}`)
+	assert.Equal(t, 400, w.Code)
+	assert.Contains(t, w.Body.String(), ErrorInvalidIdempotencyKey)
+	assert.Equal(t, 0, *calls)
+}
+
+func Test_MemoryIdempotencyStore_Expired_Success(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryIdempotencyStore()
+	store.now = func() time.Time { return now }
+	record := IdempotencyRecord{Key: "test-key", ExpiresAt: now.Add(time.Hour)}
+
+	existing, genErr := store.Start(context.Background(), record)
+	require.Nil(t, genErr)
+	assert.Nil(t, existing)
+	existing, _ = store.Start(context.Background(), record)
+	assert.Equal(t, &record, existing)
+
+	now = now.Add(2 * time.Hour)
+	existing, _ = store.Start(context.Background(), record)
+	assert.Nil(t, existing)
+}
+
+func Test_MemoryIdempotencyStore_CompleteAfterLockExpired_Failure(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryIdempotencyStore()
+	store.now = func() time.Time { return now }
+	lock := IdempotencyRecord{Key: "test-key", RequestHash: "hash-1", ExpiresAt: now.Add(time.Minute)}
+	_, genErr := store.Start(context.Background(), lock)
+	require.Nil(t, genErr)
+
+	// Lock expired and key taken by another request
+	now = now.Add(2 * time.Minute)
+	other := IdempotencyRecord{Key: "test-key", RequestHash: "hash-2", ExpiresAt: now.Add(time.Minute)}
+	existing, genErr := store.Start(context.Background(), other)
+	require.Nil(t, genErr)
+	require.Nil(t, existing)
+
+	// Late complete and delete don't affect the other request
+	completed := lock
+	completed.Completed = true
+	completed.StatusCode = 201
+	genErr = store.Complete(context.Background(), completed)
+	errors.AssertGenericError(t, genErr, 409, ErrorIdempotencyLockExpired, map[string]string{"idempotency_key": "test-key"})
+	genErr = store.Delete(context.Background(), lock)
+	assert.Nil(t, genErr)
+	assert.Equal(t, other, store.records["test-key"])
+
+	// Other request completes
+	completed = other
+	completed.Completed = true
+	assert.Nil(t, store.Complete(context.Background(), completed))
+	assert.Nil(t, store.Delete(context.Background(), other))
+	assert.Equal(t, completed, store.records["test-key"])
+}
+
+func Test_IdempotencyMiddleware_CompleteAfterLockExpired_Success(t *testing.T) {
+	now := time.Now()
+	store := NewMemoryIdempotencyStore()
+	store.now = func() time.Time { return now }
+	other := IdempotencyRecord{Key: "user-1:test-key", RequestHash: "other-hash", ExpiresAt: now.Add(time.Hour)}
+
+	// Handler takes longer than LockTTL and another request takes the key
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		metadata.SetGinMetadata(c, "user_id", c.GetHeader("X-User"))
+	})
+	router.Use(IdempotencyMiddleware(store))
+	router.POST("/bookings", func(c *gin.Context) {
+		now = now.Add(2 * DefaultIdempotencyOptions.LockTTL)
+		existing, genErr := store.Start(context.Background(), other)
+		require.Nil(t, genErr)
+		require.Nil(t, existing)
+		c.JSON(201, gin.H{})
+	})
+
+	w := testIdempotencyRequest(router, "POST", "test-key", "user-1", `{}`)
+	assert.Equal(t, 201, w.Code)
+	assert.Equal(t, other, store.records["user-1:test-key"])
+}
+
+func Test_MongoIdempotencyStore_Complete_Success(t *testing.T) {
+	repository := &mocks.IMongoRepository{}
+	store := NewMongoIdempotencyStore(repository, "idempotency")
+	record := IdempotencyRecord{Key: "test-key", RequestHash: "hash-1", Completed: true, StatusCode: 201, ExpiresAt: time.Now().Add(time.Hour)}
+	query := map[string]interface{}{"_id": "test-key", "request_hash": "hash-1", "completed": false}
+	repository.On("UpdateOne", mock.Anything, "idempotency", query, record, "idempotency_complete", mongoIdempotencySaveOption).Return(nil).Once()
+	repository.On("DeleteOne", mock.Anything, "idempotency", query, "idempotency_delete").Return(nil).Once()
+
+	assert.Nil(t, store.Complete(context.Background(), record))
+	assert.Nil(t, store.Delete(context.Background(), record))
+	repository.AssertExpectations(t)
+}
+
+func Test_MongoIdempotencyStore_CompleteAfterLockExpired_Failure(t *testing.T) {
+	repository := &mocks.IMongoRepository{}
+	store := NewMongoIdempotencyStore(repository, "idempotency")
+	record := IdempotencyRecord{Key: "test-key", RequestHash: "hash-1", Completed: true, StatusCode: 201, ExpiresAt: time.Now().Add(time.Hour)}
+	query := map[string]interface{}{"_id": "test-key", "request_hash": "hash-1", "completed": false}
+	noEntity := errors.NewGenericError(404, "go-util", "idempotency_complete", "no_entity", nil)
+	repository.On("UpdateOne", mock.Anything, "idempotency", query, record, "idempotency_complete", mongoIdempotencySaveOption).Return(noEntity).Once()
+
+	genErr := store.Complete(context.Background(), record)
+	errors.AssertGenericError(t, genErr, 409, ErrorIdempotencyLockExpired, map[string]string{"idempotency_key": "test-key"})
+	repository.AssertExpectations(t)
+}
+
+func Test_MongoIdempotencyStore_Start_Success(t *testing.T) {
+	repository := &mocks.IMongoRepository{}
+	store := NewMongoIdempotencyStore(repository, "idempotency")
+	record := IdempotencyRecord{Key: "test-key", ExpiresAt: time.Now().Add(time.Hour)}
+	repository.On("Create", mock.Anything, "idempotency", record, "idempotency_start", mongoIdempotencySaveOption).Return(nil).Once()
+
+	existing, genErr := store.Start(context.Background(), record)
+	assert.Nil(t, genErr)
+	assert.Nil(t, existing)
+	repository.AssertExpectations(t)
+}
+
+func Test_MongoIdempotencyStore_Start_Existing(t *testing.T) {
+	repository := &mocks.IMongoRepository{}
+	store := NewMongoIdempotencyStore(repository, "idempotency")
+	record := IdempotencyRecord{Key: "test-key", ExpiresAt: time.Now().Add(time.Hour)}
+	stored := IdempotencyRecord{Key: "test-key", Completed: true, StatusCode: 201, ExpiresAt: time.Now().Add(time.Minute)}
+	alreadyExists := errors.NewGenericError(409, "go-util", "idempotency_start", "entity_already_exists", nil)
+	repository.On("Create", mock.Anything, "idempotency", record, "idempotency_start", mongoIdempotencySaveOption).Return(alreadyExists)
+	repository.On("GetOne", mock.Anything, "idempotency", map[string]interface{}{"_id": "test-key"}, true, mock.Anything, "idempotency_start").
+		Run(func(args mock.Arguments) { *args.Get(4).(*IdempotencyRecord) = stored }).
+		Return(nil)
+
+	existing, genErr := store.Start(context.Background(), record)
+	assert.Nil(t, genErr)
+	assert.Equal(t, &stored, existing)
+}
+
+func Test_MongoIdempotencyStore_Start_Expired(t *testing.T) {
+	repository := &mocks.IMongoRepository{}
+	store := NewMongoIdempotencyStore(repository, "idempotency")
+	record := IdempotencyRecord{Key: "test-key", ExpiresAt: time.Now().Add(time.Hour)}
+	expired := IdempotencyRecord{Key: "test-key", Completed: true, ExpiresAt: time.Now().Add(-time.Minute)}
+	alreadyExists := errors.NewGenericError(409, "go-util", "idempotency_start", "entity_already_exists", nil)
+	repository.On("Create", mock.Anything, "idempotency", record, "idempotency_start", mongoIdempotencySaveOption).Return(alreadyExists).Once()
+	repository.On("GetOne", mock.Anything, "idempotency", map[string]interface{}{"_id": "test-key"}, true, mock.Anything, "idempotency_start").
+		Run(func(args mock.Arguments) { *args.Get(4).(*IdempotencyRecord) = expired }).
+		Return(nil).Once()
+	query := map[string]interface{}{"_id": "test-key", "expires_at": expired.ExpiresAt}
+	repository.On("DeleteOne", mock.Anything, "idempotency", query, "idempotency_start").Return(nil).Once()
+	repository.On("Create", mock.Anything, "idempotency", record, "idempotency_start", mongoIdempotencySaveOption).Return(nil).Once()
+
+	existing, genErr := store.Start(context.Background(), record)
+	assert.Nil(t, genErr)
+	assert.Nil(t, existing)
+	repository.AssertExpectations(t)
+}
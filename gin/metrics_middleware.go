@@ -0,0 +1,33 @@
+package gin
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/skiprco/go-utils/v2/metrics"
+)
+
+// MetricsUnmatchedRoute is the route label of requests which don't match a registered route
+const MetricsUnmatchedRoute = "unmatched"
+
+// MetricsMiddleware records the count, duration, in-flight requests and response size of each request
+// in metrics.DefaultRegistry, labelled by route template (e.g. /bookings/:id), method and status.
+// Expose the metrics with metrics.Handler:
+//
+//	router.Use(gin.MetricsMiddleware())
+//	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+func MetricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			route = MetricsUnmatchedRoute
+		}
+
+		finish := metrics.StartHTTPServerRequest(c.Request.Method, route)
+		c.Next()
+
+		size := c.Writer.Size()
+		if size < 0 {
+			size = 0
+		}
+		finish(c.Writer.Status(), size)
+	}
+}
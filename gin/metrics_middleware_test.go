@@ -0,0 +1,38 @@
+package gin
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skiprco/go-utils/v2/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func testScrapeMetrics() string {
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	return w.Body.String()
+}
+
+func Test_MetricsMiddleware_Success(t *testing.T) {
+	// Setup router
+	router := gin.New()
+	router.Use(MetricsMiddleware())
+	router.GET("/test-metrics/:id", func(c *gin.Context) {
+		c.String(201, "created")
+	})
+
+	// Send requests
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/test-metrics/1", nil))
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/test-metrics/2", nil))
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/test-metrics-unknown", nil))
+
+	// Assert metrics
+	result := testScrapeMetrics()
+	assert.Contains(t, result, `http_server_requests_total{method="GET",route="/test-metrics/:id",status="201"} 2`)
+	assert.Contains(t, result, `http_server_requests_total{method="GET",route="unmatched",status="404"} 1`)
+	assert.Contains(t, result, `http_server_request_duration_seconds_count{method="GET",route="/test-metrics/:id",status="201"} 2`)
+	assert.Contains(t, result, `http_server_response_size_bytes_sum{method="GET",route="/test-metrics/:id",status="201"} 14`)
+	assert.Contains(t, result, `http_server_requests_in_flight{method="GET",route="/test-metrics/:id"} 0`)
+}
@@ -0,0 +1,86 @@
+package gin
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skiprco/go-utils/v2/collections"
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// ParsePageRequest parses the pagination query parameters (limit, offset, cursor and include_total).
+// Missing parameters are filled with the defaults in the provided options.
+// A limit above options.MaxLimit is reduced to options.MaxLimit.
+// Offset is ignored in cursor mode and cursor is ignored in offset mode.
+//
+// Raises
+//
+// - 400/invalid_page_parameter: Limit, offset or include_total has an invalid value
+//
+// - 400/invalid_page_cursor: Provided cursor could not be decoded or was created for another endpoint
+func ParsePageRequest(c *gin.Context, options collections.PageOptions) (collections.PageRequest, *errors.GenericError) {
+	// Set defaults
+	if options.Mode == "" {
+		options.Mode = collections.PageModeOffset
+	}
+	request := collections.PageRequest{
+		Mode:             options.Mode,
+		Limit:            options.DefaultLimit,
+		CursorField:      options.CursorField,
+		CursorDescending: options.CursorDescending,
+	}
+
+	// Parse limit
+	if value, ok := c.GetQuery(collections.PageQueryLimit); ok {
+		limit, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || limit < 1 {
+			return request, newInvalidPageParameterError(collections.PageQueryLimit, value)
+		}
+		request.Limit = limit
+	}
+	if options.MaxLimit > 0 && request.Limit > options.MaxLimit {
+		request.Limit = options.MaxLimit
+	}
+
+	// Parse include_total
+	if value, ok := c.GetQuery(collections.PageQueryIncludeTotal); ok {
+		includeTotal, err := strconv.ParseBool(value)
+		if err != nil {
+			return request, newInvalidPageParameterError(collections.PageQueryIncludeTotal, value)
+		}
+		request.IncludeTotal = includeTotal
+	}
+
+	// Parse position
+	switch request.Mode {
+	case collections.PageModeCursor:
+		value := c.Query(collections.PageQueryCursor)
+		if value == "" {
+			// First page
+			break
+		}
+		cursor, err := collections.DecodePageCursor(value)
+		if err != nil || cursor.Field != options.CursorField {
+			meta := map[string]string{"cursor": value}
+			return request, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidPageCursor, meta)
+		}
+		request.Cursor = cursor
+
+	default:
+		if value, ok := c.GetQuery(collections.PageQueryOffset); ok {
+			offset, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || offset < 0 {
+				return request, newInvalidPageParameterError(collections.PageQueryOffset, value)
+			}
+			request.Offset = offset
+		}
+	}
+
+	// Parse successful
+	return request, nil
+}
+
+func newInvalidPageParameterError(parameter string, value string) *errors.GenericError {
+	meta := map[string]string{"parameter": parameter, "value": value}
+	return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidPageParameter, meta)
+}
@@ -0,0 +1,82 @@
+package gin
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skiprco/go-utils/v2/collections"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPageContext(query string) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/bookings?"+query, nil)
+	return c
+}
+
+func Test_ParsePageRequest_Defaults_Success(t *testing.T) {
+	request, genErr := ParsePageRequest(testPageContext(""), collections.DefaultPageOptions)
+	require.Nil(t, genErr)
+	expected := collections.PageRequest{
+		Mode:        collections.PageModeOffset,
+		Limit:       20,
+		CursorField: "_id",
+	}
+	assert.Equal(t, expected, request)
+}
+
+func Test_ParsePageRequest_Offset_Success(t *testing.T) {
+	c := testPageContext("limit=500&offset=40&include_total=true")
+	request, genErr := ParsePageRequest(c, collections.DefaultPageOptions)
+	require.Nil(t, genErr)
+	assert.Equal(t, int64(100), request.Limit) // Reduced to max limit
+	assert.Equal(t, int64(40), request.Offset)
+	assert.True(t, request.IncludeTotal)
+	assert.Nil(t, request.Cursor)
+}
+
+func Test_ParsePageRequest_Cursor_Success(t *testing.T) {
+	options := collections.DefaultPageOptions
+	options.Mode = collections.PageModeCursor
+	cursor := collections.PageCursor{Field: "_id", Value: "test-id"}
+	c := testPageContext("limit=10&offset=40&cursor=" + cursor.Encode())
+
+	request, genErr := ParsePageRequest(c, options)
+	require.Nil(t, genErr)
+	assert.Equal(t, collections.PageModeCursor, request.Mode)
+	assert.Equal(t, int64(10), request.Limit)
+	assert.Equal(t, int64(0), request.Offset) // Ignored in cursor mode
+	assert.Equal(t, &cursor, request.Cursor)
+}
+
+func Test_ParsePageRequest_InvalidParameter_Failure(t *testing.T) {
+	tests := []struct {
+		query     string
+		parameter string
+	}{
+		{"limit=abc", "limit"},
+		{"limit=0", "limit"},
+		{"offset=-1", "offset"},
+		{"include_total=maybe", "include_total"},
+	}
+
+	for _, test := range tests {
+		_, genErr := ParsePageRequest(testPageContext(test.query), collections.DefaultPageOptions)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidPageParameter, map[string]string{"parameter": test.parameter})
+	}
+}
+
+func Test_ParsePageRequest_InvalidCursor_Failure(t *testing.T) {
+	options := collections.DefaultPageOptions
+	options.Mode = collections.PageModeCursor
+
+	_, genErr := ParsePageRequest(testPageContext("cursor=invalid!"), options)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidPageCursor, nil)
+
+	otherField := collections.PageCursor{Field: "created_at", Value: "test"}
+	_, genErr = ParsePageRequest(testPageContext("cursor="+otherField.Encode()), options)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidPageCursor, nil)
+}
@@ -0,0 +1,169 @@
+package gin
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/metadata"
+)
+
+// RateLimitAlgorithm defines how requests are counted
+type RateLimitAlgorithm string
+
+const (
+	// RateLimitTokenBucket refills Limit tokens per Window and allows bursts up to Burst requests
+	RateLimitTokenBucket RateLimitAlgorithm = "token_bucket"
+
+	// RateLimitSlidingWindow allows Limit requests in any Window, estimated from the current and previous window
+	RateLimitSlidingWindow RateLimitAlgorithm = "sliding_window"
+)
+
+// RateLimitKeyFunc returns the key of the client for a policy. The policy is not applied if the key is empty.
+type RateLimitKeyFunc func(c *gin.Context) string
+
+// RateLimitPolicy limits the number of requests per key
+type RateLimitPolicy struct {
+	// Name identifies the policy in the store keys, the RateLimit-Policy header and the error meta
+	Name string
+
+	// Limit is the number of allowed requests per Window
+	Limit int
+
+	// Window is the period of Limit
+	Window time.Duration
+
+	// Burst is the capacity of the bucket for RateLimitTokenBucket. Defaults to Limit.
+	Burst int
+
+	// Algorithm defaults to RateLimitTokenBucket
+	Algorithm RateLimitAlgorithm
+
+	// Key returns the key of the client (e.g. RateLimitByIP)
+	Key RateLimitKeyFunc
+}
+
+// RateLimitResult is the outcome of a single request for a policy
+type RateLimitResult struct {
+	// Allowed is false if the request should be rejected
+	Allowed bool
+
+	// Remaining is the number of requests which are still allowed
+	Remaining int
+
+	// Reset is the duration until the quota is fully restored
+	Reset time.Duration
+
+	// RetryAfter is the duration until the next request is allowed. Only set if not allowed.
+	RetryAfter time.Duration
+}
+
+// RateLimitStore keeps the state of the policies per key.
+// Implement this interface to share the state between instances (e.g. in Redis).
+// Take should be atomic for a single key.
+type RateLimitStore interface {
+	// Take counts a request for the key and returns if it's allowed according to the policy
+	Take(ctx context.Context, key string, policy RateLimitPolicy) (RateLimitResult, error)
+}
+
+// RateLimitByIP uses the client IP as key
+func RateLimitByIP(c *gin.Context) string {
+	return c.ClientIP()
+}
+
+// RateLimitByUserID uses the user_id in the metadata as key. Requests without user are not limited.
+// The authentication middleware should be registered before the rate limit middleware.
+func RateLimitByUserID(c *gin.Context) string {
+	return metadata.GetGinMetadata(c).Get("user_id")
+}
+
+// RateLimitByRoute uses the method and route template as key, limiting the total number of requests to an endpoint
+func RateLimitByRoute(c *gin.Context) string {
+	return c.Request.Method + " " + c.FullPath()
+}
+
+// RateLimitByHeader uses the value of the header (e.g. an API key) as key. Requests without the header are not limited.
+func RateLimitByHeader(header string) RateLimitKeyFunc {
+	return func(c *gin.Context) string {
+		return c.GetHeader(header)
+	}
+}
+
+// RateLimitMiddleware rejects requests which exceed one of the policies with 429/rate_limit_exceeded.
+// The RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset and RateLimit-Policy headers contain the most restrictive policy.
+// Requests are allowed if the store fails, since rate limiting shouldn't break the API.
+// Panics if a policy has no name or key or if the limit or window isn't positive.
+//
+//	store := gin.NewMemoryRateLimitStore()
+//	router.Use(gin.RateLimitMiddleware(store,
+//	    gin.RateLimitPolicy{Name: "ip", Limit: 100, Window: time.Minute, Key: gin.RateLimitByIP},
+//	    gin.RateLimitPolicy{Name: "user", Limit: 1000, Window: time.Hour, Key: gin.RateLimitByUserID},
+//	))
+//
+// Raises
+//
+// - 429/rate_limit_exceeded: Too many requests. Meta contains the policy and retry_after in seconds.
+func RateLimitMiddleware(store RateLimitStore, policies ...RateLimitPolicy) gin.HandlerFunc {
+	for i, policy := range policies {
+		if policy.Name == "" || policy.Key == nil || policy.Limit <= 0 || policy.Window <= 0 {
+			panic("gin: invalid rate limit policy " + strconv.Itoa(i) + " (" + policy.Name + "): name, key, limit and window are required")
+		}
+	}
+
+	return func(c *gin.Context) {
+		var restrictive *RateLimitResult
+		var restrictivePolicy RateLimitPolicy
+		for _, policy := range policies {
+			// Get key
+			key := policy.Key(c)
+			if key == "" {
+				continue
+			}
+
+			// Take request from store
+			result, err := store.Take(c.Request.Context(), policy.Name+":"+key, policy)
+			if err != nil {
+				log.WithFields(log.Fields{"error": err, "policy": policy.Name}).Warn("Failed to check rate limit")
+				continue
+			}
+
+			// Keep most restrictive result
+			if restrictive == nil || !result.Allowed || (restrictive.Allowed && result.Remaining < restrictive.Remaining) {
+				restrictive = &result
+				restrictivePolicy = policy
+			}
+			if !result.Allowed {
+				break
+			}
+		}
+		if restrictive == nil {
+			c.Next()
+			return
+		}
+
+		// Set headers
+		c.Header("RateLimit-Limit", strconv.Itoa(restrictivePolicy.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(restrictive.Remaining))
+		c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(restrictive.Reset)))
+		c.Header("RateLimit-Policy", strconv.Itoa(restrictivePolicy.Limit)+";w="+strconv.Itoa(ceilSeconds(restrictivePolicy.Window)))
+		if restrictive.Allowed {
+			c.Next()
+			return
+		}
+
+		// Reject request
+		retryAfter := strconv.Itoa(ceilSeconds(restrictive.RetryAfter))
+		c.Header("Retry-After", retryAfter)
+		meta := map[string]string{"policy": restrictivePolicy.Name, "retry_after": retryAfter}
+		abortWithGenericError(c, errors.NewGenericError(429, errorDomain, errorSubDomain, ErrorRateLimitExceeded, meta))
+	}
+}
+
+// ceilSeconds rounds the duration up to whole seconds
+func ceilSeconds(duration time.Duration) int {
+	return int(math.Ceil(duration.Seconds()))
+}
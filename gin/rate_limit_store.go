@@ -0,0 +1,148 @@
+package gin
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// rateLimitSweepInterval is the interval at which expired entries are removed from the MemoryRateLimitStore
+const rateLimitSweepInterval = time.Minute
+
+// MemoryRateLimitStore keeps the rate limit state in memory. The state is not shared between instances.
+// A MemoryRateLimitStore is safe for concurrent use and should be created with NewMemoryRateLimitStore.
+type MemoryRateLimitStore struct {
+	mutex     sync.Mutex
+	entries   map[string]*rateLimitEntry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type rateLimitEntry struct {
+	// Token bucket
+	tokens     float64
+	lastRefill time.Time
+
+	// Sliding window
+	windowStart   time.Time
+	currentCount  int
+	previousCount int
+
+	expiresAt time.Time // Entry is equal to a new entry after this time
+}
+
+// NewMemoryRateLimitStore creates a new empty in-memory store
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		entries: map[string]*rateLimitEntry{},
+		now:     time.Now,
+	}
+}
+
+// Take counts a request for the key and returns if it's allowed according to the policy
+func (s *MemoryRateLimitStore) Take(ctx context.Context, key string, policy RateLimitPolicy) (RateLimitResult, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := s.now()
+	s.sweep(now)
+
+	entry, exists := s.entries[key]
+	if !exists || now.After(entry.expiresAt) {
+		entry = &rateLimitEntry{}
+		s.entries[key] = entry
+	}
+
+	if policy.Algorithm == RateLimitSlidingWindow {
+		return entry.takeSlidingWindow(policy, now), nil
+	}
+	return entry.takeTokenBucket(policy, now), nil
+}
+
+// sweep removes the expired entries, at most once per rateLimitSweepInterval
+func (s *MemoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, entry := range s.entries {
+		if now.After(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// takeTokenBucket refills the bucket and takes a single token
+func (e *rateLimitEntry) takeTokenBucket(policy RateLimitPolicy, now time.Time) RateLimitResult {
+	capacity := float64(policy.Burst)
+	if policy.Burst <= 0 {
+		capacity = float64(policy.Limit)
+	}
+	perSecond := float64(policy.Limit) / policy.Window.Seconds()
+
+	// Refill bucket
+	if e.lastRefill.IsZero() {
+		e.tokens = capacity
+	} else {
+		e.tokens = math.Min(capacity, e.tokens+now.Sub(e.lastRefill).Seconds()*perSecond)
+	}
+	e.lastRefill = now
+
+	// Take token
+	result := RateLimitResult{Allowed: e.tokens >= 1}
+	if result.Allowed {
+		e.tokens--
+	} else {
+		result.RetryAfter = secondsToDuration((1 - e.tokens) / perSecond)
+	}
+	result.Remaining = int(math.Floor(e.tokens))
+	result.Reset = secondsToDuration((capacity - e.tokens) / perSecond)
+	e.expiresAt = now.Add(result.Reset)
+	return result
+}
+
+// takeSlidingWindow estimates the number of requests in the last window
+// by weighting the count of the previous window with its overlap
+func (e *rateLimitEntry) takeSlidingWindow(policy RateLimitPolicy, now time.Time) RateLimitResult {
+	// Shift windows
+	windowStart := now.Truncate(policy.Window)
+	switch {
+	case windowStart.Equal(e.windowStart):
+		// Same window
+	case windowStart.Equal(e.windowStart.Add(policy.Window)):
+		e.previousCount, e.currentCount = e.currentCount, 0
+	default:
+		e.previousCount, e.currentCount = 0, 0
+	}
+	e.windowStart = windowStart
+	windowEnd := windowStart.Add(policy.Window)
+
+	// Estimate count
+	elapsed := now.Sub(windowStart)
+	weight := 1 - elapsed.Seconds()/policy.Window.Seconds()
+	estimated := float64(e.previousCount)*weight + float64(e.currentCount)
+
+	// Count request
+	result := RateLimitResult{Allowed: estimated+1 <= float64(policy.Limit)}
+	if result.Allowed {
+		e.currentCount++
+		estimated++
+	} else {
+		// Wait until the weighted previous window leaves room for a request, otherwise until the next window
+		result.RetryAfter = windowEnd.Sub(now)
+		room := float64(policy.Limit - 1 - e.currentCount)
+		if e.previousCount > 0 && room >= 0 {
+			target := policy.Window.Seconds() * (1 - room/float64(e.previousCount))
+			result.RetryAfter = secondsToDuration(target - elapsed.Seconds())
+		}
+	}
+	result.Remaining = int(math.Max(0, math.Floor(float64(policy.Limit)-estimated)))
+	result.Reset = windowEnd.Add(policy.Window).Sub(now)
+	e.expiresAt = windowEnd.Add(policy.Window)
+	return result
+}
+
+// secondsToDuration converts seconds to a duration
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
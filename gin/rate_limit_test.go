@@ -0,0 +1,171 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	goErrors "errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRateLimitStore returns a memory store with a manual clock
+func testRateLimitStore() (*MemoryRateLimitStore, *time.Time) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryRateLimitStore()
+	store.now = func() time.Time { return now }
+	return store, &now
+}
+
+func testRateLimitRouter(store RateLimitStore, policies ...RateLimitPolicy) *gin.Engine {
+	router := gin.New()
+	router.Use(RateLimitMiddleware(store, policies...))
+	router.GET("/test", func(c *gin.Context) {
+		c.String(200, "ok")
+	})
+	return router
+}
+
+func testRateLimitRequest(router *gin.Engine, ip string, apiKey string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.RemoteAddr = ip + ":1234"
+	if apiKey != "" {
+		req.Header.Set("X-Api-Key", apiKey)
+	}
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func Test_MemoryRateLimitStore_TokenBucket_Success(t *testing.T) {
+	store, now := testRateLimitStore()
+	policy := RateLimitPolicy{Name: "test", Limit: 60, Window: time.Minute, Burst: 2}
+
+	// Burst
+	result, err := store.Take(context.Background(), "key", policy)
+	require.Nil(t, err)
+	assert.Equal(t, RateLimitResult{Allowed: true, Remaining: 1, Reset: time.Second}, result)
+	result, _ = store.Take(context.Background(), "key", policy)
+	assert.Equal(t, RateLimitResult{Allowed: true, Remaining: 0, Reset: 2 * time.Second}, result)
+
+	// Bucket empty
+	*now = now.Add(500 * time.Millisecond)
+	result, _ = store.Take(context.Background(), "key", policy)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 500*time.Millisecond, result.RetryAfter)
+
+	// Refilled
+	*now = now.Add(500 * time.Millisecond)
+	result, _ = store.Take(context.Background(), "key", policy)
+	assert.True(t, result.Allowed)
+
+	// Other key
+	result, _ = store.Take(context.Background(), "other", policy)
+	assert.True(t, result.Allowed)
+}
+
+func Test_MemoryRateLimitStore_SlidingWindow_Success(t *testing.T) {
+	store, now := testRateLimitStore()
+	policy := RateLimitPolicy{Name: "test", Limit: 2, Window: time.Minute, Algorithm: RateLimitSlidingWindow}
+
+	// Fill window
+	result, _ := store.Take(context.Background(), "key", policy)
+	assert.Equal(t, RateLimitResult{Allowed: true, Remaining: 1, Reset: 2 * time.Minute}, result)
+	result, _ = store.Take(context.Background(), "key", policy)
+	assert.True(t, result.Allowed)
+	result, _ = store.Take(context.Background(), "key", policy)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, time.Minute, result.RetryAfter)
+
+	// Halfway next window: 2 * 0.5 previous requests are counted
+	*now = now.Add(90 * time.Second)
+	result, _ = store.Take(context.Background(), "key", policy)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+	result, _ = store.Take(context.Background(), "key", policy)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 30*time.Second, result.RetryAfter)
+
+	// Window expired
+	*now = now.Add(2 * time.Minute)
+	result, _ = store.Take(context.Background(), "key", policy)
+	assert.Equal(t, 1, result.Remaining)
+}
+
+func Test_MemoryRateLimitStore_Sweep_Success(t *testing.T) {
+	store, now := testRateLimitStore()
+	policy := RateLimitPolicy{Name: "test", Limit: 60, Window: time.Minute}
+	store.Take(context.Background(), "key", policy)
+	*now = now.Add(2 * time.Minute)
+	store.Take(context.Background(), "other", policy)
+	assert.Len(t, store.entries, 1)
+}
+
+func Test_RateLimitMiddleware_Success(t *testing.T) {
+	store, _ := testRateLimitStore()
+	router := testRateLimitRouter(store, RateLimitPolicy{Name: "ip", Limit: 10, Window: time.Minute, Key: RateLimitByIP})
+
+	w := testRateLimitRequest(router, "10.0.0.1", "")
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "10", w.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "9", w.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "6", w.Header().Get("RateLimit-Reset"))
+	assert.Equal(t, "10;w=60", w.Header().Get("RateLimit-Policy"))
+	assert.Equal(t, "", w.Header().Get("Retry-After"))
+}
+
+func Test_RateLimitMiddleware_Exceeded_Failure(t *testing.T) {
+	store, _ := testRateLimitStore()
+	router := testRateLimitRouter(store,
+		RateLimitPolicy{Name: "ip", Limit: 10, Window: time.Minute, Key: RateLimitByIP},
+		RateLimitPolicy{Name: "api_key", Limit: 1, Window: time.Minute, Key: RateLimitByHeader("X-Api-Key")},
+	)
+
+	// Requests without API key are only limited by IP
+	assert.Equal(t, 200, testRateLimitRequest(router, "10.0.0.1", "").Code)
+	assert.Equal(t, 200, testRateLimitRequest(router, "10.0.0.1", "").Code)
+
+	// API key exceeded
+	w := testRateLimitRequest(router, "10.0.0.2", "test-key")
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+	w = testRateLimitRequest(router, "10.0.0.3", "test-key")
+	assert.Equal(t, 429, w.Code)
+	assert.Equal(t, "1", w.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "60", w.Header().Get("Retry-After"))
+
+	// Assert error
+	var response map[string]interface{}
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, float64(429), response["code"])
+	assert.Contains(t, response["detail"], ErrorRateLimitExceeded)
+	assert.Contains(t, response["detail"], "retry_after")
+}
+
+// testFailingRateLimitStore always returns an error
+type testFailingRateLimitStore struct{}
+
+func (s testFailingRateLimitStore) Take(ctx context.Context, key string, policy RateLimitPolicy) (RateLimitResult, error) {
+	return RateLimitResult{}, goErrors.New("test-error")
+}
+
+func Test_RateLimitMiddleware_StoreFailed_Success(t *testing.T) {
+	router := testRateLimitRouter(testFailingRateLimitStore{}, RateLimitPolicy{Name: "ip", Limit: 1, Window: time.Minute, Key: RateLimitByIP})
+	w := testRateLimitRequest(router, "10.0.0.1", "")
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "", w.Header().Get("RateLimit-Limit"))
+}
+
+func Test_RateLimitMiddleware_InvalidPolicy_Failure(t *testing.T) {
+	store, _ := testRateLimitStore()
+	assert.Panics(t, func() {
+		RateLimitMiddleware(store, RateLimitPolicy{Name: "ip", Limit: 0, Window: time.Minute, Key: RateLimitByIP})
+	})
+	assert.Panics(t, func() {
+		RateLimitMiddleware(store, RateLimitPolicy{Name: "ip", Limit: 1, Window: time.Minute})
+	})
+}
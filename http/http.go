@@ -6,11 +6,15 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/skiprco/go-utils/v2/errors"
 	"github.com/skiprco/go-utils/v2/logging"
+	"github.com/skiprco/go-utils/v2/metrics"
+	"github.com/skiprco/go-utils/v2/tracing"
 )
 
 // Call marshals the body to JSON and sends a HTTP request. Response is parsed as JSON.
@@ -33,6 +37,17 @@ import (
 //
 // - 421/parse_response_body_failed: Failed to parse JSON response into provided response interface
 func Call(method string, rootURL string, path string, body interface{}, response interface{}, query map[string]string, headers map[string]string) (*http.Response, *errors.GenericError) {
+	return CallWithContext(context.Background(), method, rootURL, path, body, response, query, headers)
+}
+
+// CallWithContext is equal to Call, but sends the request within a client span.
+// The trace of the span in the go-micro metadata of the context is continued
+// and propagated to the server with the traceparent header.
+//
+// Raises
+//
+// - See Call
+func CallWithContext(ctx context.Context, method string, rootURL string, path string, body interface{}, response interface{}, query map[string]string, headers map[string]string) (*http.Response, *errors.GenericError) {
 	// Set Content-Type to JSON
 	if headers == nil {
 		headers = map[string]string{}
@@ -60,10 +75,10 @@ func Call(method string, rootURL string, path string, body interface{}, response
 	}
 
 	// Create request
-	req := createRequest(method, rootURL, path, bodyBytes, query, headers)
+	req := createRequest(ctx, method, rootURL, path, bodyBytes, query, headers)
 
 	// Send request to API
-	res, genErr := sendRequest(req)
+	res, genErr := sendInstrumentedRequest(ctx, req)
 	if genErr != nil {
 		defaultLog.WithField("error", genErr.GetDetailString()).Error("Failed to send request")
 		return res, genErr
@@ -105,11 +120,22 @@ func Call(method string, rootURL string, path string, body interface{}, response
 // specific error in an easy way. When you miss a translation, the full body is present on the
 // error for easier debugging.
 func CallRaw(method string, rootURL string, path string, body []byte, query map[string]string, headers map[string]string) (*http.Response, *errors.GenericError) {
+	return CallRawWithContext(context.Background(), method, rootURL, path, body, query, headers)
+}
+
+// CallRawWithContext is equal to CallRaw, but sends the request within a client span.
+// The trace of the span in the go-micro metadata of the context is continued
+// and propagated to the server with the traceparent header.
+//
+// Raises
+//
+// - See CallRaw
+func CallRawWithContext(ctx context.Context, method string, rootURL string, path string, body []byte, query map[string]string, headers map[string]string) (*http.Response, *errors.GenericError) {
 	// Create request
-	req := createRequest(method, rootURL, path, body, query, headers)
+	req := createRequest(ctx, method, rootURL, path, body, query, headers)
 
 	// Send request to API
-	res, genErr := sendRequest(req)
+	res, genErr := sendInstrumentedRequest(ctx, req)
 	if genErr != nil {
 		log.WithFields(log.Fields{
 			"method":  method,
@@ -125,9 +151,9 @@ func CallRaw(method string, rootURL string, path string, body []byte, query map[
 	return res, nil
 }
 
-func createRequest(method string, rootURL string, path string, body []byte, query map[string]string, headers map[string]string) *http.Request {
+func createRequest(ctx context.Context, method string, rootURL string, path string, body []byte, query map[string]string, headers map[string]string) *http.Request {
 	// Create HTTP request
-	req, _ := http.NewRequest(method, rootURL+path, bytes.NewBuffer(body))
+	req, _ := http.NewRequestWithContext(ctx, method, rootURL+path, bytes.NewBuffer(body))
 
 	// Apply custom query
 	if query != nil {
@@ -146,6 +172,31 @@ func createRequest(method string, rootURL string, path string, body []byte, quer
 	return req
 }
 
+// sendInstrumentedRequest sends the request within a client span, sets the traceparent header
+// and records the request in the client metrics
+func sendInstrumentedRequest(ctx context.Context, req *http.Request) (*http.Response, *errors.GenericError) {
+	// Start span
+	_, span := tracing.StartSpan(ctx, req.Method+" "+req.URL.Host, tracing.SpanKindClient)
+	span.SetAttribute("http.method", req.Method)
+	span.SetAttribute("http.url", req.URL.String())
+	req.Header.Set(tracing.TraceParentHeader, span.TraceContext().TraceParent())
+
+	// Send request
+	start := time.Now()
+	res, genErr := sendRequest(req)
+	statusCode := 0
+	if res != nil {
+		statusCode = res.StatusCode
+		span.SetAttribute("http.status_code", strconv.Itoa(statusCode))
+	}
+	metrics.ObserveHTTPClientRequest(req.Method, req.URL.Host, statusCode, start)
+	if genErr != nil {
+		span.SetStatus(tracing.SpanStatusError, genErr.GetDetailString())
+	}
+	span.End()
+	return res, genErr
+}
+
 func sendRequest(req *http.Request) (*http.Response, *errors.GenericError) {
 	// Send request to API
 	client := &http.Client{}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -9,7 +10,10 @@ import (
 	"testing"
 
 	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/metadata"
+	"github.com/skiprco/go-utils/v2/tracing"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // ########################################
@@ -171,6 +175,46 @@ func Test_Call_ParseResponseBodyFailed_Failure(t *testing.T) {
 	errors.AssertGenericError(t, genErr, 421, ErrorParseResponseBodyFailed, nil)
 }
 
+func Test_CallWithContext_TraceParent_Success(t *testing.T) {
+	// Setup context with trace
+	ctx, _, genErr := metadata.UpdateGoMicroMetadata(context.Background(), metadata.Metadata{
+		tracing.MetadataKeyTraceID:    "4bf92f3577b34da6a3ce929d0e0e4736",
+		tracing.MetadataKeySpanID:     "00f067aa0ba902b7",
+		tracing.MetadataKeyTraceFlags: "01",
+	})
+	require.Nil(t, genErr)
+
+	// Setup mock handlers
+	var traceParent string
+	testFunc := func(t *testing.T, res http.ResponseWriter, req *http.Request) bool {
+		switch req.URL.Path {
+		case "/test":
+			traceParent = req.Header.Get(tracing.TraceParentHeader)
+			fmt.Fprint(res, `{"Response": "response sample"}`)
+			return true
+		}
+
+		// Call not handled
+		return false
+	}
+
+	// Create mock server
+	ts := getAPIServerMock(t, testFunc)
+	defer ts.Close()
+
+	// Call helper
+	response := responseSample{}
+	_, genErr = CallWithContext(ctx, "GET", ts.URL, "/test", nil, &response, nil, nil)
+
+	// Assert results
+	assert.Nil(t, genErr)
+	traceContext, genErr := tracing.ParseTraceParent(traceParent)
+	require.Nil(t, genErr)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", traceContext.TraceID)
+	assert.NotEqual(t, "00f067aa0ba902b7", traceContext.SpanID)
+	assert.True(t, traceContext.Sampled)
+}
+
 // ########################################
 // #                CallRaw               #
 // ########################################
@@ -83,6 +83,9 @@ func AuditFail(ctx context.Context, attemptName string, additionalData map[strin
 // =                HELPERS               =
 // ========================================
 
+// logEvent logs the audit message with the metadata of the context and the additional data as fields.
+// Keys are converted with converters.ToSnakeCase, so all characters which are not a letter
+// or digit become an underscore (e.g. "booking.id" => "booking_id", "x-request-id" => "x_request_id").
 func logEvent(ctx context.Context, message string, category AuditCategory, additionalData map[string]interface{}) {
 	// Log priority
 	// A lower priority (e.g. 3) will be overwritten by higher priority (e.g. 1)
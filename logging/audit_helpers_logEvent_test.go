@@ -45,6 +45,46 @@ func Test_logEvent_WithContextAndAdditionalData(t *testing.T) {
 	hook.Reset()
 }
 
+func Test_logEvent_SnakeCaseKeys(t *testing.T) {
+	// Keys are converted with converters.ToSnakeCase, which treats all characters
+	// which are not a letter or digit as separators. Changing this renames audit log keys.
+
+	// Setup test
+	hook := logTest.NewGlobal()
+	meta := metadata.Metadata{
+		"Micro-From-Service": "test-service",
+		"booking.id":         "test-booking",
+	}
+	ctx, _, _ := metadata.UpdateGoMicroMetadata(context.Background(), meta)
+	additional := map[string]interface{}{
+		"userIDs":          1,
+		"HTTPStatus":       2,
+		"x-request-id":     3,
+		"payment.provider": 4,
+		"with space":       5,
+		"already_snake":    6,
+	}
+
+	// Call helper
+	logEvent(ctx, "test-message", AuditCategoryFact, additional)
+
+	// Assert result
+	require.Len(t, hook.Entries, 1)
+	expectedData := log.Fields{
+		"category":           AuditCategoryFact,
+		"micro_from_service": "test-service",
+		"booking_id":         "test-booking",
+		"user_ids":           1,
+		"http_status":        2,
+		"x_request_id":       3,
+		"payment_provider":   4,
+		"with_space":         5,
+		"already_snake":      6,
+	}
+	assert.Equal(t, expectedData, hook.LastEntry().Data)
+	hook.Reset()
+}
+
 func Test_logEvent_Minimal(t *testing.T) {
 	// Setup test
 	hook := logTest.NewGlobal()
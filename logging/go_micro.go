@@ -56,6 +56,7 @@ func AuditHandlerWrapper(fn server.HandlerFunc) server.HandlerFunc {
 }
 
 // AddAuditInfo prefixes the key with the service name, converts it to snake_case and adds the result to the context.
+// All characters which are not a letter or digit become an underscore (e.g. "booking.id" => "<service>_booking_id").
 //
 // Raises
 //
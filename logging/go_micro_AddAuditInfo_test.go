@@ -29,6 +29,20 @@ func Test_AddAuditInfo_New(t *testing.T) {
 	assert.Equal(t, expected, meta)
 }
 
+func Test_AddAuditInfo_SnakeCaseKey(t *testing.T) {
+	// Setup test data
+	ctx, _, _ := metadata.SetGoMicroMetadata(context.Background(), "service_name", "srv-test")
+
+	// Call helper
+	ctx, genErr := AddAuditInfo(ctx, "booking.ID", "test_value")
+
+	// Assert results
+	require.Nil(t, genErr)
+	meta, genErr := metadata.GetGoMicroMetadata(ctx)
+	require.Nil(t, genErr)
+	assert.Equal(t, "test_value", meta["srv_test_booking_id"])
+}
+
 func Test_AddAuditInfo_Overwrite(t *testing.T) {
 	// Setup test data
 	ctx, _, _ := metadata.UpdateGoMicroMetadata(context.Background(), fixtureMetadata())
@@ -1,15 +1,30 @@
 package logging
 
 import (
+	"context"
 	"net/http"
 	"net/http/httputil"
 
-	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/metadata"
+	"github.com/skiprco/go-utils/v2/tracing"
 )
 
+// WithTrace returns a log entry with the trace ID and span ID of the go-micro metadata in the context.
+// Use it to correlate regular logs with the audit logs and spans of the same trace.
+func WithTrace(ctx context.Context) *log.Entry {
+	meta, _ := metadata.GetGoMicroMetadata(ctx) // Trace is omitted if metadata is corrupted
+	fields := log.Fields{}
+	if traceContext, exists := tracing.TraceContextFromMetadata(meta); exists {
+		fields[tracing.MetadataKeyTraceID] = traceContext.TraceID
+		fields[tracing.MetadataKeySpanID] = traceContext.SpanID
+	}
+	return log.WithFields(fields)
+}
+
 // LogHTTPRequestResponse logs a HTTP request and response. Returns a trace ID to link follow-up logs.
+// The trace ID is taken from the traceparent header of the request if available.
 //
 // Raises
 //
@@ -17,9 +32,16 @@ import (
 //
 // - 500/unable_to_dump_response: Failed to dump to HTTP response
 func LogHTTPRequestResponse(request *http.Request, response *http.Response, logLevel log.Level, message string) (string, *errors.GenericError) {
-	// Generate trace ID
-	traceID := uuid.New()
+	// Extract or generate trace ID
+	traceContext, genErr := tracing.ParseTraceParent(request.Header.Get(tracing.TraceParentHeader))
+	if genErr != nil {
+		traceContext = tracing.NewTraceContext()
+	}
+	traceID := traceContext.TraceID
 	traceLog := log.WithField("trace_id", traceID)
+	if genErr == nil {
+		traceLog = traceLog.WithField("span_id", traceContext.SpanID)
+	}
 
 	// Dump request
 	dumpReq, err := httputil.DumpRequest(request, true)
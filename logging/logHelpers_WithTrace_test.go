@@ -0,0 +1,28 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WithTrace_Success(t *testing.T) {
+	ctx, _, genErr := metadata.UpdateGoMicroMetadata(context.Background(), metadata.Metadata{
+		"trace_id":    "4bf92f3577b34da6a3ce929d0e0e4736",
+		"span_id":     "00f067aa0ba902b7",
+		"trace_flags": "01",
+	})
+	require.Nil(t, genErr)
+
+	entry := WithTrace(ctx)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", entry.Data["trace_id"])
+	assert.Equal(t, "00f067aa0ba902b7", entry.Data["span_id"])
+}
+
+func Test_WithTrace_WithoutTrace(t *testing.T) {
+	entry := WithTrace(context.Background())
+	assert.Empty(t, entry.Data)
+}
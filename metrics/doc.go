@@ -0,0 +1,4 @@
+// Package metrics contains counters, gauges and histograms which are exposed
+// in the Prometheus text format (https://prometheus.io/docs/instrumenting/exposition_formats/).
+// Gin, go-micro, http and mongo are instrumented with the metrics in DefaultRegistry.
+package metrics
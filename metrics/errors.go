@@ -0,0 +1,13 @@
+package metrics
+
+const errorDomain = "go_utils"
+const errorSubDomain = "metrics"
+
+// ErrorInvalidMetricName indicates the metric name or one of the label names contains invalid characters
+const ErrorInvalidMetricName = "invalid_metric_name"
+
+// ErrorInvalidBuckets indicates the buckets of a histogram are not strictly increasing
+const ErrorInvalidBuckets = "invalid_buckets"
+
+// ErrorMetricAlreadyRegistered indicates a metric with the same name, but a different type, labels or buckets is already registered
+const ErrorMetricAlreadyRegistered = "metric_already_registered"
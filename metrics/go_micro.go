@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/micro/go-micro/v2/server"
+)
+
+// HandlerWrapper records the count, duration and in-flight requests of each incoming go-micro request,
+// labelled by service, endpoint and error code.
+//
+// Usage:
+//
+//	service.Server().Init(
+//	    server.WrapHandler(metrics.HandlerWrapper),
+//	    server.WrapHandler(logging.AuditHandlerWrapper),
+//	)
+func HandlerWrapper(fn server.HandlerFunc) server.HandlerFunc {
+	return func(ctx context.Context, req server.Request, rsp interface{}) error {
+		finish := StartMicroServerRequest(req.Service(), req.Endpoint())
+		err := fn(ctx, req, rsp)
+		finish(err)
+		return err
+	}
+}
@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	microErrors "github.com/micro/go-micro/v2/errors"
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// Metrics of the instrumented packages in DefaultRegistry
+var (
+	httpServerRequests        = mustNewCounter("http_server_requests_total", "Number of handled HTTP requests", "method", "route", "status")
+	httpServerRequestDuration = mustNewHistogram("http_server_request_duration_seconds", "Duration of handled HTTP requests in seconds", DefaultBuckets, "method", "route", "status")
+	httpServerResponseSize    = mustNewHistogram("http_server_response_size_bytes", "Size of HTTP response bodies in bytes", SizeBuckets, "method", "route", "status")
+	httpServerInFlight        = mustNewGauge("http_server_requests_in_flight", "Number of HTTP requests being handled", "method", "route")
+
+	microServerRequests        = mustNewCounter("micro_server_requests_total", "Number of handled go-micro requests", "service", "endpoint", "error_code")
+	microServerRequestDuration = mustNewHistogram("micro_server_request_duration_seconds", "Duration of handled go-micro requests in seconds", DefaultBuckets, "service", "endpoint", "error_code")
+	microServerInFlight        = mustNewGauge("micro_server_requests_in_flight", "Number of go-micro requests being handled", "service", "endpoint")
+
+	httpClientRequests        = mustNewCounter("http_client_requests_total", "Number of sent HTTP requests", "method", "host", "status")
+	httpClientRequestDuration = mustNewHistogram("http_client_request_duration_seconds", "Duration of sent HTTP requests in seconds", DefaultBuckets, "method", "host", "status")
+
+	mongoOperations        = mustNewCounter("mongo_operations_total", "Number of mongo operations", "collection", "operation", "status")
+	mongoOperationDuration = mustNewHistogram("mongo_operation_duration_seconds", "Duration of mongo operations in seconds", DefaultBuckets, "collection", "operation", "status")
+)
+
+// StartHTTPServerRequest marks the start of a handled HTTP request.
+// The returned function should be called when the response is written.
+//
+// Arguments
+//
+// - route: Route template (e.g. /bookings/:id) to prevent a series per ID
+func StartHTTPServerRequest(method string, route string) (finish func(status int, responseSize int)) {
+	start := time.Now()
+	httpServerInFlight.Inc(method, route)
+	return func(status int, responseSize int) {
+		httpServerInFlight.Dec(method, route)
+		statusLabel := strconv.Itoa(status)
+		httpServerRequests.Inc(method, route, statusLabel)
+		httpServerRequestDuration.ObserveDuration(start, method, route, statusLabel)
+		httpServerResponseSize.Observe(float64(responseSize), method, route, statusLabel)
+	}
+}
+
+// StartMicroServerRequest marks the start of a handled go-micro request.
+// The returned function should be called with the result of the handler.
+// The error code label is empty on success, otherwise it contains the code of the error.
+func StartMicroServerRequest(service string, endpoint string) (finish func(err error)) {
+	start := time.Now()
+	microServerInFlight.Inc(service, endpoint)
+	return func(err error) {
+		microServerInFlight.Dec(service, endpoint)
+		errorCode := ""
+		if err != nil {
+			errorCode = strconv.Itoa(errorCodeOf(err))
+		}
+		microServerRequests.Inc(service, endpoint, errorCode)
+		microServerRequestDuration.ObserveDuration(start, service, endpoint, errorCode)
+	}
+}
+
+// ObserveHTTPClientRequest records a sent HTTP request.
+// Status code 0 indicates no response is received and is recorded as status "error".
+func ObserveHTTPClientRequest(method string, host string, statusCode int, start time.Time) {
+	status := "error"
+	if statusCode > 0 {
+		status = strconv.Itoa(statusCode)
+	}
+	httpClientRequests.Inc(method, host, status)
+	httpClientRequestDuration.ObserveDuration(start, method, host, status)
+}
+
+// ObserveMongoOperation records a mongo operation.
+// The status is "ok" on success, otherwise it contains the code of the error.
+func ObserveMongoOperation(collection string, operation string, start time.Time, genErr *errors.GenericError) {
+	status := "ok"
+	if genErr != nil {
+		status = strconv.Itoa(genErr.Code)
+	}
+	mongoOperations.Inc(collection, operation, status)
+	mongoOperationDuration.ObserveDuration(start, collection, operation, status)
+}
+
+// errorCodeOf returns the code of a GenericError or micro error. Defaults to 500 for other errors.
+func errorCodeOf(err error) int {
+	if genErr, ok := err.(*errors.GenericError); ok {
+		return genErr.Code
+	}
+	if code := int(microErrors.Parse(err.Error()).Code); code > 0 {
+		return code
+	}
+	return 500
+}
+
+// mustNewCounter registers a counter of go-utils in DefaultRegistry. Panics on failure, since the definitions are static.
+func mustNewCounter(name string, help string, labelNames ...string) *Counter {
+	counter, genErr := NewCounter(name, help, labelNames...)
+	if genErr != nil {
+		panic(genErr)
+	}
+	return counter
+}
+
+// mustNewGauge registers a gauge of go-utils in DefaultRegistry. Panics on failure, since the definitions are static.
+func mustNewGauge(name string, help string, labelNames ...string) *Gauge {
+	gauge, genErr := NewGauge(name, help, labelNames...)
+	if genErr != nil {
+		panic(genErr)
+	}
+	return gauge
+}
+
+// mustNewHistogram registers a histogram of go-utils in DefaultRegistry. Panics on failure, since the definitions are static.
+func mustNewHistogram(name string, help string, buckets []float64, labelNames ...string) *Histogram {
+	histogram, genErr := NewHistogram(name, help, buckets, labelNames...)
+	if genErr != nil {
+		panic(genErr)
+	}
+	return histogram
+}
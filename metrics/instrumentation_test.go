@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"context"
+	goErrors "errors"
+	"testing"
+	"time"
+
+	"github.com/micro/go-micro/v2/codec"
+	"github.com/micro/go-micro/v2/server"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type testMicroRequest struct{}
+
+func (req testMicroRequest) Service() string           { return "test-service" }
+func (req testMicroRequest) Method() string            { return "Test.Method" }
+func (req testMicroRequest) Endpoint() string          { return "Test.Endpoint" }
+func (req testMicroRequest) ContentType() string       { return "application/json" }
+func (req testMicroRequest) Header() map[string]string { return nil }
+func (req testMicroRequest) Body() interface{}         { return nil }
+func (req testMicroRequest) Read() ([]byte, error)     { return nil, nil }
+func (req testMicroRequest) Codec() codec.Reader       { return nil }
+func (req testMicroRequest) Stream() bool              { return false }
+
+func Test_HandlerWrapper_Success(t *testing.T) {
+	results := []error{
+		nil,
+		errors.NewGenericError(404, "test", "test", "not_found", nil),
+		errors.NewGenericError(409, "test", "test", "conflict", nil).ToMicroError(),
+		goErrors.New("test-error"),
+	}
+	for _, result := range results {
+		handler := HandlerWrapper(func(ctx context.Context, req server.Request, rsp interface{}) error {
+			return result
+		})
+		assert.Equal(t, result, handler(context.Background(), testMicroRequest{}, nil))
+	}
+
+	text := testWriteText(t, DefaultRegistry)
+	assert.Contains(t, text, `micro_server_requests_total{service="test-service",endpoint="Test.Endpoint",error_code=""} 1`)
+	assert.Contains(t, text, `micro_server_requests_total{service="test-service",endpoint="Test.Endpoint",error_code="404"} 1`)
+	assert.Contains(t, text, `micro_server_requests_total{service="test-service",endpoint="Test.Endpoint",error_code="409"} 1`)
+	assert.Contains(t, text, `micro_server_requests_total{service="test-service",endpoint="Test.Endpoint",error_code="500"} 1`)
+	assert.Contains(t, text, `micro_server_requests_in_flight{service="test-service",endpoint="Test.Endpoint"} 0`)
+}
+
+func Test_ObserveHTTPClientRequest_Success(t *testing.T) {
+	ObserveHTTPClientRequest("GET", "test-client.skipr.co", 200, time.Now())
+	ObserveHTTPClientRequest("GET", "test-client.skipr.co", 0, time.Now())
+
+	text := testWriteText(t, DefaultRegistry)
+	assert.Contains(t, text, `http_client_requests_total{method="GET",host="test-client.skipr.co",status="200"} 1`)
+	assert.Contains(t, text, `http_client_requests_total{method="GET",host="test-client.skipr.co",status="error"} 1`)
+}
+
+func Test_ObserveMongoOperation_Success(t *testing.T) {
+	ObserveMongoOperation("test_bookings", "get_one", time.Now(), nil)
+	ObserveMongoOperation("test_bookings", "get_one", time.Now(), errors.NewGenericError(404, "test", "test", "no_entity", nil))
+
+	text := testWriteText(t, DefaultRegistry)
+	assert.Contains(t, text, `mongo_operations_total{collection="test_bookings",operation="get_one",status="ok"} 1`)
+	assert.Contains(t, text, `mongo_operations_total{collection="test_bookings",operation="get_one",status="404"} 1`)
+	assert.Contains(t, text, `mongo_operation_duration_seconds_count{collection="test_bookings",operation="get_one",status="ok"} 1`)
+}
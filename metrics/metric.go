@@ -0,0 +1,170 @@
+package metrics
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// MetricType defines how the values of a metric are aggregated
+type MetricType string
+
+const (
+	// MetricTypeCounter only goes up (e.g. number of requests)
+	MetricTypeCounter MetricType = "counter"
+
+	// MetricTypeGauge goes up and down (e.g. requests in progress)
+	MetricTypeGauge MetricType = "gauge"
+
+	// MetricTypeHistogram counts observations in buckets (e.g. request durations)
+	MetricTypeHistogram MetricType = "histogram"
+)
+
+// DefaultBuckets are the histogram buckets for durations in seconds
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// SizeBuckets are the histogram buckets for payload sizes in bytes
+var SizeBuckets = []float64{100, 1000, 10000, 100000, 1000000, 10000000}
+
+// metric contains the series of a metric, one for each combination of label values
+type metric struct {
+	name       string
+	help       string
+	metricType MetricType
+	labelNames []string
+	buckets    []float64 // Upper bounds, only for histograms
+	mutex      sync.Mutex
+	series     map[string]*series
+}
+
+// series contains the value of a metric for a single combination of label values
+type series struct {
+	labelValues  []string
+	value        float64  // Counters and gauges
+	bucketCounts []uint64 // Histograms, not cumulative
+	sum          float64  // Histograms
+	count        uint64   // Histograms
+}
+
+// update calls updateFunc with the series of the label values while holding the lock.
+// Updates with a wrong number of label values are logged and ignored, since metrics shouldn't break operations.
+func (m *metric) update(labelValues []string, updateFunc func(s *series)) {
+	if len(labelValues) != len(m.labelNames) {
+		log.WithFields(log.Fields{
+			"metric":         m.name,
+			"label_names":    m.labelNames,
+			"label_values":   labelValues,
+			"expected_count": len(m.labelNames),
+		}).Warn("Metric updated with wrong number of label values")
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	key := strings.Join(labelValues, "\xff")
+	s, exists := m.series[key]
+	if !exists {
+		s = &series{labelValues: append([]string{}, labelValues...)}
+		if m.metricType == MetricTypeHistogram {
+			s.bucketCounts = make([]uint64, len(m.buckets))
+		}
+		m.series[key] = s
+	}
+	updateFunc(s)
+}
+
+// snapshot returns a copy of all series, sorted by label values
+func (m *metric) snapshot() []series {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	result := make([]series, 0, len(m.series))
+	for _, s := range m.series {
+		copied := *s
+		copied.bucketCounts = append([]uint64{}, s.bucketCounts...)
+		result = append(result, copied)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return strings.Join(result[i].labelValues, "\xff") < strings.Join(result[j].labelValues, "\xff")
+	})
+	return result
+}
+
+// Counter is a metric which only goes up
+type Counter struct {
+	metric *metric
+}
+
+// Inc increments the counter by 1
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add increments the counter by the provided value. Negative values are ignored.
+func (c *Counter) Add(value float64, labelValues ...string) {
+	if value < 0 {
+		log.WithField("metric", c.metric.name).Warn("Counter can't be decreased")
+		return
+	}
+	c.metric.update(labelValues, func(s *series) { s.value += value })
+}
+
+// Gauge is a metric which goes up and down
+type Gauge struct {
+	metric *metric
+}
+
+// Set sets the gauge to the provided value
+func (g *Gauge) Set(value float64, labelValues ...string) {
+	g.metric.update(labelValues, func(s *series) { s.value = value })
+}
+
+// Add adds the provided value (can be negative) to the gauge
+func (g *Gauge) Add(value float64, labelValues ...string) {
+	g.metric.update(labelValues, func(s *series) { s.value += value })
+}
+
+// Inc increments the gauge by 1
+func (g *Gauge) Inc(labelValues ...string) {
+	g.Add(1, labelValues...)
+}
+
+// Dec decrements the gauge by 1
+func (g *Gauge) Dec(labelValues ...string) {
+	g.Add(-1, labelValues...)
+}
+
+// Histogram counts observations in configurable buckets
+type Histogram struct {
+	metric *metric
+}
+
+// Observe adds a single observation to the histogram
+func (h *Histogram) Observe(value float64, labelValues ...string) {
+	h.metric.update(labelValues, func(s *series) {
+		index := sort.SearchFloat64s(h.metric.buckets, value)
+		if index < len(s.bucketCounts) {
+			s.bucketCounts[index]++
+		}
+		s.sum += value
+		s.count++
+	})
+}
+
+// ObserveDuration adds the time since start in seconds to the histogram
+func (h *Histogram) ObserveDuration(start time.Time, labelValues ...string) {
+	h.Observe(time.Since(start).Seconds(), labelValues...)
+}
+
+// validBuckets returns true if the buckets are strictly increasing and finite
+func validBuckets(buckets []float64) bool {
+	for i, bucket := range buckets {
+		if math.IsNaN(bucket) || math.IsInf(bucket, 0) || (i > 0 && bucket <= buckets[i-1]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,270 @@
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// TextContentType is the content type of the Prometheus text format
+const TextContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var metricNameRegex = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+var labelNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Registry contains a set of metrics which are exposed together
+type Registry struct {
+	mutex   sync.RWMutex
+	metrics map[string]*metric
+}
+
+// DefaultRegistry contains the metrics of go-utils and is used by the package level functions
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry creates a new empty registry
+func NewRegistry() *Registry {
+	return &Registry{metrics: map[string]*metric{}}
+}
+
+// NewCounter registers a new counter. If an identical counter is already registered, the existing one is returned.
+//
+// Raises
+//
+// - 500/invalid_metric_name: Name or one of the label names contains invalid characters
+//
+// - 500/metric_already_registered: Metric with the same name, but a different type or labels is already registered
+func (r *Registry) NewCounter(name string, help string, labelNames ...string) (*Counter, *errors.GenericError) {
+	m, genErr := r.register(name, help, MetricTypeCounter, nil, labelNames)
+	if genErr != nil {
+		return nil, genErr
+	}
+	return &Counter{metric: m}, nil
+}
+
+// NewGauge registers a new gauge. If an identical gauge is already registered, the existing one is returned.
+//
+// Raises
+//
+// - 500/invalid_metric_name: Name or one of the label names contains invalid characters
+//
+// - 500/metric_already_registered: Metric with the same name, but a different type or labels is already registered
+func (r *Registry) NewGauge(name string, help string, labelNames ...string) (*Gauge, *errors.GenericError) {
+	m, genErr := r.register(name, help, MetricTypeGauge, nil, labelNames)
+	if genErr != nil {
+		return nil, genErr
+	}
+	return &Gauge{metric: m}, nil
+}
+
+// NewHistogram registers a new histogram. DefaultBuckets are used if buckets is empty.
+// If an identical histogram is already registered, the existing one is returned.
+//
+// Raises
+//
+// - 500/invalid_metric_name: Name or one of the label names contains invalid characters
+//
+// - 500/invalid_buckets: Buckets are not strictly increasing or not finite
+//
+// - 500/metric_already_registered: Metric with the same name, but a different type, labels or buckets is already registered
+func (r *Registry) NewHistogram(name string, help string, buckets []float64, labelNames ...string) (*Histogram, *errors.GenericError) {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	if !validBuckets(buckets) {
+		meta := map[string]string{"metric": name}
+		return nil, errors.NewGenericError(500, errorDomain, errorSubDomain, ErrorInvalidBuckets, meta)
+	}
+	m, genErr := r.register(name, help, MetricTypeHistogram, buckets, labelNames)
+	if genErr != nil {
+		return nil, genErr
+	}
+	return &Histogram{metric: m}, nil
+}
+
+// register adds the metric to the registry or returns the existing identical metric
+//
+// Raises
+//
+// - 500/invalid_metric_name: Name or one of the label names contains invalid characters
+//
+// - 500/metric_already_registered: Metric with the same name, but a different type, labels or buckets is already registered
+func (r *Registry) register(name string, help string, metricType MetricType, buckets []float64, labelNames []string) (*metric, *errors.GenericError) {
+	// Validate names
+	meta := map[string]string{"metric": name}
+	if !metricNameRegex.MatchString(name) {
+		return nil, errors.NewGenericError(500, errorDomain, errorSubDomain, ErrorInvalidMetricName, meta)
+	}
+	for _, labelName := range labelNames {
+		if !labelNameRegex.MatchString(labelName) || labelName == "le" {
+			meta["label"] = labelName
+			return nil, errors.NewGenericError(500, errorDomain, errorSubDomain, ErrorInvalidMetricName, meta)
+		}
+	}
+
+	// Return existing metric
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if existing, exists := r.metrics[name]; exists {
+		if existing.metricType != metricType || !equalStrings(existing.labelNames, labelNames) || !reflect.DeepEqual(existing.buckets, buckets) {
+			return nil, errors.NewGenericError(500, errorDomain, errorSubDomain, ErrorMetricAlreadyRegistered, meta)
+		}
+		return existing, nil
+	}
+
+	// Register new metric
+	m := &metric{
+		name:       name,
+		help:       help,
+		metricType: metricType,
+		labelNames: append([]string{}, labelNames...),
+		buckets:    append([]float64(nil), buckets...),
+		series:     map[string]*series{},
+	}
+	r.metrics[name] = m
+	return m, nil
+}
+
+// WriteText writes all metrics in the Prometheus text format, sorted by name.
+// Metrics without any series are skipped.
+func (r *Registry) WriteText(w io.Writer) error {
+	// Sort metrics
+	r.mutex.RLock()
+	metrics := make([]*metric, 0, len(r.metrics))
+	for _, m := range r.metrics {
+		metrics = append(metrics, m)
+	}
+	r.mutex.RUnlock()
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].name < metrics[j].name })
+
+	// Write metrics
+	writer := bufio.NewWriter(w)
+	for _, m := range metrics {
+		allSeries := m.snapshot()
+		if len(allSeries) == 0 {
+			continue
+		}
+		writer.WriteString("# HELP " + m.name + " " + escapeHelp(m.help) + "\n")
+		writer.WriteString("# TYPE " + m.name + " " + string(m.metricType) + "\n")
+		for _, s := range allSeries {
+			labels := formatLabels(m.labelNames, s.labelValues)
+			if m.metricType != MetricTypeHistogram {
+				writer.WriteString(m.name + wrapLabels(labels) + " " + formatFloat(s.value) + "\n")
+				continue
+			}
+			var cumulative uint64
+			for i, bucket := range m.buckets {
+				cumulative += s.bucketCounts[i]
+				bucketLabels := appendLabel(labels, "le", formatFloat(bucket))
+				writer.WriteString(m.name + "_bucket" + wrapLabels(bucketLabels) + " " + strconv.FormatUint(cumulative, 10) + "\n")
+			}
+			writer.WriteString(m.name + "_bucket" + wrapLabels(appendLabel(labels, "le", "+Inf")) + " " + strconv.FormatUint(s.count, 10) + "\n")
+			writer.WriteString(m.name + "_sum" + wrapLabels(labels) + " " + formatFloat(s.sum) + "\n")
+			writer.WriteString(m.name + "_count" + wrapLabels(labels) + " " + strconv.FormatUint(s.count, 10) + "\n")
+		}
+	}
+	return writer.Flush()
+}
+
+// Handler returns a HTTP handler which exposes the metrics in the Prometheus text format
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", TextContentType)
+		if err := r.WriteText(w); err != nil {
+			log.WithField("error", err).Warn("Failed to write metrics")
+		}
+	})
+}
+
+// NewCounter registers a new counter in DefaultRegistry. See Registry.NewCounter.
+func NewCounter(name string, help string, labelNames ...string) (*Counter, *errors.GenericError) {
+	return DefaultRegistry.NewCounter(name, help, labelNames...)
+}
+
+// NewGauge registers a new gauge in DefaultRegistry. See Registry.NewGauge.
+func NewGauge(name string, help string, labelNames ...string) (*Gauge, *errors.GenericError) {
+	return DefaultRegistry.NewGauge(name, help, labelNames...)
+}
+
+// NewHistogram registers a new histogram in DefaultRegistry. See Registry.NewHistogram.
+func NewHistogram(name string, help string, buckets []float64, labelNames ...string) (*Histogram, *errors.GenericError) {
+	return DefaultRegistry.NewHistogram(name, help, buckets, labelNames...)
+}
+
+// Handler returns a HTTP handler which exposes the metrics of DefaultRegistry
+//
+// Usage with Gin:
+//
+//	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+func Handler() http.Handler {
+	return DefaultRegistry.Handler()
+}
+
+// formatLabels returns the labels as name="value" pairs
+func formatLabels(names []string, values []string) []string {
+	labels := make([]string, len(names))
+	for i, name := range names {
+		labels[i] = name + `="` + escapeLabelValue(values[i]) + `"`
+	}
+	return labels
+}
+
+// appendLabel returns a copy of labels with the additional label
+func appendLabel(labels []string, name string, value string) []string {
+	return append(append([]string{}, labels...), name+`="`+value+`"`)
+}
+
+// wrapLabels joins the labels and wraps them in curly braces
+func wrapLabels(labels []string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(labels, ",") + "}"
+}
+
+// formatFloat formats the value as expected by Prometheus
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
+
+var helpReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func escapeHelp(help string) string {
+	return helpReplacer.Replace(help)
+}
+
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
+
+// equalStrings returns true if both slices contain the same strings in the same order
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+package metrics
+
+import (
+	"bytes"
+	"math"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWriteText(t *testing.T, registry *Registry) string {
+	var buffer bytes.Buffer
+	require.Nil(t, registry.WriteText(&buffer))
+	return buffer.String()
+}
+
+func Test_Registry_Counter_Success(t *testing.T) {
+	registry := NewRegistry()
+	counter, genErr := registry.NewCounter("test_requests_total", "Test requests\nwith newline", "method", "path")
+	require.Nil(t, genErr)
+	counter.Inc("GET", "/b")
+	counter.Add(2, "GET", "/a")
+	counter.Inc("GET", `/"quoted"\`)
+	counter.Add(-1, "GET", "/a") // Ignored
+	counter.Inc("GET")           // Ignored
+
+	expected := `# HELP test_requests_total Test requests\nwith newline
+# TYPE test_requests_total counter
+test_requests_total{method="GET",path="/\"quoted\"\\"} 1
+test_requests_total{method="GET",path="/a"} 2
+test_requests_total{method="GET",path="/b"} 1
+`
+	assert.Equal(t, expected, testWriteText(t, registry))
+}
+
+func Test_Registry_Gauge_Success(t *testing.T) {
+	registry := NewRegistry()
+	gauge, genErr := registry.NewGauge("test_in_flight", "Test in flight")
+	require.Nil(t, genErr)
+	gauge.Inc()
+	gauge.Inc()
+	gauge.Dec()
+	gauge.Add(0.5)
+
+	expected := "# HELP test_in_flight Test in flight\n# TYPE test_in_flight gauge\ntest_in_flight 1.5\n"
+	assert.Equal(t, expected, testWriteText(t, registry))
+
+	gauge.Set(math.Inf(1))
+	assert.Contains(t, testWriteText(t, registry), "test_in_flight +Inf\n")
+}
+
+func Test_Registry_Histogram_Success(t *testing.T) {
+	registry := NewRegistry()
+	histogram, genErr := registry.NewHistogram("test_duration_seconds", "Test duration", []float64{0.1, 1}, "route")
+	require.Nil(t, genErr)
+	histogram.Observe(0.05, "/a")
+	histogram.Observe(0.1, "/a")
+	histogram.Observe(0.5, "/a")
+	histogram.Observe(2, "/a")
+
+	expected := `# HELP test_duration_seconds Test duration
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{route="/a",le="0.1"} 2
+test_duration_seconds_bucket{route="/a",le="1"} 3
+test_duration_seconds_bucket{route="/a",le="+Inf"} 4
+test_duration_seconds_sum{route="/a"} 2.65
+test_duration_seconds_count{route="/a"} 4
+`
+	assert.Equal(t, expected, testWriteText(t, registry))
+}
+
+func Test_Registry_Empty_Success(t *testing.T) {
+	registry := NewRegistry()
+	_, genErr := registry.NewCounter("test_unused_total", "Unused")
+	require.Nil(t, genErr)
+	assert.Equal(t, "", testWriteText(t, registry))
+}
+
+func Test_Registry_Existing_Success(t *testing.T) {
+	registry := NewRegistry()
+	counter1, genErr := registry.NewCounter("test_total", "Test", "method")
+	require.Nil(t, genErr)
+	counter2, genErr := registry.NewCounter("test_total", "Test", "method")
+	require.Nil(t, genErr)
+	counter1.Inc("GET")
+	counter2.Inc("GET")
+	assert.Contains(t, testWriteText(t, registry), `test_total{method="GET"} 2`)
+}
+
+func Test_Registry_AlreadyRegistered_Failure(t *testing.T) {
+	registry := NewRegistry()
+	_, genErr := registry.NewCounter("test_total", "Test", "method")
+	require.Nil(t, genErr)
+
+	_, genErr = registry.NewGauge("test_total", "Test", "method")
+	errors.AssertGenericError(t, genErr, 500, ErrorMetricAlreadyRegistered, map[string]string{"metric": "test_total"})
+	_, genErr = registry.NewCounter("test_total", "Test", "route")
+	errors.AssertGenericError(t, genErr, 500, ErrorMetricAlreadyRegistered, map[string]string{"metric": "test_total"})
+}
+
+func Test_Registry_InvalidMetricName_Failure(t *testing.T) {
+	registry := NewRegistry()
+	_, genErr := registry.NewCounter("test-total", "Test")
+	errors.AssertGenericError(t, genErr, 500, ErrorInvalidMetricName, map[string]string{"metric": "test-total"})
+	_, genErr = registry.NewCounter("test_total", "Test", "1method")
+	errors.AssertGenericError(t, genErr, 500, ErrorInvalidMetricName, map[string]string{"label": "1method"})
+	_, genErr = registry.NewHistogram("test_seconds", "Test", nil, "le")
+	errors.AssertGenericError(t, genErr, 500, ErrorInvalidMetricName, map[string]string{"label": "le"})
+}
+
+func Test_Registry_InvalidBuckets_Failure(t *testing.T) {
+	registry := NewRegistry()
+	_, genErr := registry.NewHistogram("test_seconds", "Test", []float64{1, 0.5})
+	errors.AssertGenericError(t, genErr, 500, ErrorInvalidBuckets, map[string]string{"metric": "test_seconds"})
+}
+
+func Test_Registry_Handler_Success(t *testing.T) {
+	registry := NewRegistry()
+	counter, _ := registry.NewCounter("test_total", "Test")
+	counter.Inc()
+
+	w := httptest.NewRecorder()
+	registry.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, TextContentType, w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "test_total 1\n")
+}
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// Allocate splits the amount according to the provided ratios without losing minor units.
+// Remaining minor units are given to the parts with the largest remainder, so the sum
+// of the parts always equals the original amount (e.g. 100 with ratios 1, 1, 1 => 34, 33, 33).
+//
+// Raises
+//
+// - 400/invalid_ratios: No ratios provided, a ratio is negative or all ratios are zero
+//
+// - 400/amount_overflow: A part is too large
+func (m Money) Allocate(ratios ...int64) ([]Money, *errors.GenericError) {
+	// Validate ratios
+	total := big.NewInt(0)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			meta := map[string]string{"ratio": strconv.FormatInt(ratio, 10)}
+			return nil, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidRatios, meta)
+		}
+		total.Add(total, big.NewInt(ratio))
+	}
+	if total.Sign() == 0 {
+		return nil, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidRatios, nil)
+	}
+
+	// Calculate parts, rounded towards zero.
+	// Parts are kept as big.Int, since the absolute value of the minimum int64 doesn't fit in an int64.
+	amount := new(big.Int).Abs(big.NewInt(m.Amount))
+	parts := make([]*big.Int, len(ratios))
+	remainders := make([]*big.Int, len(ratios))
+	leftover := new(big.Int).Set(amount)
+	for i, ratio := range ratios {
+		part, remainder := new(big.Int).QuoRem(new(big.Int).Mul(amount, big.NewInt(ratio)), total, new(big.Int))
+		parts[i] = part
+		remainders[i] = remainder
+		leftover.Sub(leftover, part)
+	}
+
+	// Distribute leftover to parts with the largest remainder
+	order := make([]int, len(ratios))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+	for i := int64(0); i < leftover.Int64(); i++ {
+		parts[order[i]].Add(parts[order[i]], big.NewInt(1))
+	}
+
+	// Build result
+	result := make([]Money, len(ratios))
+	for i, part := range parts {
+		if m.Amount < 0 {
+			part.Neg(part)
+		}
+		if !part.IsInt64() {
+			return nil, m.overflowError()
+		}
+		result[i] = Money{Amount: part.Int64(), Currency: m.Currency}
+	}
+	return result, nil
+}
+
+// Split divides the amount in equal parts without losing minor units.
+// See Allocate on how remaining minor units are divided.
+//
+// Raises
+//
+// - 400/invalid_ratios: Provided number of parts is less than 1
+func (m Money) Split(parts int) ([]Money, *errors.GenericError) {
+	if parts < 1 {
+		meta := map[string]string{"parts": strconv.Itoa(parts)}
+		return nil, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidRatios, meta)
+	}
+	ratios := make([]int64, parts)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
@@ -0,0 +1,70 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAmounts(parts []Money) []int64 {
+	result := make([]int64, len(parts))
+	for i, part := range parts {
+		result[i] = part.Amount
+	}
+	return result
+}
+
+func Test_Allocate_Success(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		ratios   []int64
+		expected []int64
+	}{
+		{100, []int64{1, 1, 1}, []int64{34, 33, 33}},
+		{-100, []int64{1, 1, 1}, []int64{-34, -33, -33}},
+		{5, []int64{3, 7}, []int64{2, 3}},
+		{1000, []int64{70, 20, 10}, []int64{700, 200, 100}},
+		{1, []int64{1, 2}, []int64{0, 1}},
+		{100, []int64{0, 1}, []int64{0, 100}},
+		{math.MinInt64, []int64{1}, []int64{math.MinInt64}},
+		{math.MinInt64, []int64{0, 1}, []int64{0, math.MinInt64}},
+		{math.MinInt64, []int64{1, 1}, []int64{math.MinInt64 / 2, math.MinInt64 / 2}},
+		{math.MaxInt64, []int64{1, 1}, []int64{math.MaxInt64/2 + 1, math.MaxInt64 / 2}},
+	}
+
+	for _, test := range tests {
+		result, genErr := testMoney(t, test.amount, "EUR").Allocate(test.ratios...)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.expected, testAmounts(result), "%d %v", test.amount, test.ratios)
+		for _, part := range result {
+			assert.Equal(t, "EUR", part.Currency)
+		}
+	}
+}
+
+func Test_Allocate_Failure(t *testing.T) {
+	money := testMoney(t, 100, "EUR")
+
+	_, genErr := money.Allocate()
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidRatios, nil)
+
+	_, genErr = money.Allocate(0, 0)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidRatios, nil)
+
+	_, genErr = money.Allocate(1, -1)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidRatios, map[string]string{"ratio": "-1"})
+}
+
+func Test_Split_Success(t *testing.T) {
+	result, genErr := testMoney(t, 1000, "EUR").Split(3)
+	require.Nil(t, genErr)
+	assert.Equal(t, []int64{334, 333, 333}, testAmounts(result))
+}
+
+func Test_Split_Failure(t *testing.T) {
+	_, genErr := testMoney(t, 1000, "EUR").Split(0)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidRatios, map[string]string{"parts": "0"})
+}
@@ -0,0 +1,52 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// moneyFields prevents infinite recursion when (un)marshalling Money
+type moneyFields Money
+
+// UnmarshalJSON decodes Money from {"amount": 1050, "currency": "EUR"}.
+// Decoding fails if the currency is unknown or the amount is not an integer.
+// The zero value (no currency and amount 0) is accepted and null is ignored, to support optional fields.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+	var fields moneyFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	return m.setValidated(fields)
+}
+
+// UnmarshalBSON decodes Money from {amount: NumberLong(1050), currency: "EUR"}.
+// Decoding fails if the currency is unknown.
+// The zero value (no currency and amount 0) is accepted and null is ignored, to support optional fields.
+func (m *Money) UnmarshalBSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var fields moneyFields
+	if err := bson.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	return m.setValidated(fields)
+}
+
+// setValidated stores the fields if they are the zero value or contain a valid currency
+func (m *Money) setValidated(fields moneyFields) error {
+	if fields == (moneyFields{}) {
+		*m = Money{}
+		return nil
+	}
+	if genErr := ValidateCurrencyCode(fields.Currency); genErr != nil {
+		return genErr
+	}
+	*m = Money(fields)
+	return nil
+}
@@ -0,0 +1,95 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func Test_JSON_Success(t *testing.T) {
+	data, err := json.Marshal(testMoney(t, 1050, "EUR"))
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"amount": 1050, "currency": "EUR"}`, string(data))
+
+	var result Money
+	require.Nil(t, json.Unmarshal(data, &result))
+	assert.Equal(t, testMoney(t, 1050, "EUR"), result)
+}
+
+func Test_JSON_Failure(t *testing.T) {
+	var result Money
+	assert.NotNil(t, json.Unmarshal([]byte(`{"amount": 10.5, "currency": "EUR"}`), &result))
+	assert.NotNil(t, json.Unmarshal([]byte(`{"amount": 1050, "currency": "XXX"}`), &result))
+	assert.Equal(t, Money{}, result)
+}
+
+func Test_BSON_Success(t *testing.T) {
+	type booking struct {
+		Price Money `bson:"price"`
+	}
+	data, err := bson.Marshal(booking{Price: testMoney(t, 1050, "EUR")})
+	require.Nil(t, err)
+
+	var raw bson.M
+	require.Nil(t, bson.Unmarshal(data, &raw))
+	assert.Equal(t, bson.M{"amount": int64(1050), "currency": "EUR"}, raw["price"])
+
+	var result booking
+	require.Nil(t, bson.Unmarshal(data, &result))
+	assert.Equal(t, testMoney(t, 1050, "EUR"), result.Price)
+}
+
+func Test_BSON_Failure(t *testing.T) {
+	data, err := bson.Marshal(bson.M{"amount": 1050, "currency": "XXX"})
+	require.Nil(t, err)
+
+	var result Money
+	assert.NotNil(t, bson.Unmarshal(data, &result))
+}
+
+func Test_JSON_ZeroAndNull_Success(t *testing.T) {
+	type booking struct {
+		Price    Money  `json:"price"`
+		Discount Money  `json:"discount"`
+		Deposit  *Money `json:"deposit"`
+	}
+
+	// Zero value round-trip
+	data, err := json.Marshal(booking{})
+	require.Nil(t, err)
+	var result booking
+	require.Nil(t, json.Unmarshal(data, &result))
+	assert.Equal(t, booking{}, result)
+
+	// Null
+	result = booking{}
+	require.Nil(t, json.Unmarshal([]byte(`{"price": null, "discount": {"amount": 0, "currency": ""}, "deposit": null}`), &result))
+	assert.Equal(t, booking{}, result)
+
+	// Amount without currency is invalid
+	assert.NotNil(t, json.Unmarshal([]byte(`{"price": {"amount": 100, "currency": ""}}`), &result))
+}
+
+func Test_BSON_ZeroAndNull_Success(t *testing.T) {
+	type booking struct {
+		Price   Money  `bson:"price"`
+		Deposit *Money `bson:"deposit"`
+	}
+
+	// Zero value round-trip
+	data, err := bson.Marshal(booking{})
+	require.Nil(t, err)
+	var result booking
+	require.Nil(t, bson.Unmarshal(data, &result))
+	assert.Equal(t, booking{}, result)
+
+	// Null
+	data, err = bson.Marshal(bson.M{"price": nil, "deposit": nil})
+	require.Nil(t, err)
+	result = booking{}
+	require.Nil(t, bson.Unmarshal(data, &result))
+	assert.Equal(t, booking{}, result)
+}
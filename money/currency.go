@@ -0,0 +1,75 @@
+package money
+
+import (
+	"strings"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// Currency contains the ISO 4217 data of a currency
+type Currency struct {
+	// Code is the ISO 4217 alphabetic code (e.g. EUR)
+	Code string
+
+	// Numeric is the ISO 4217 numeric code, including leading zeros (e.g. 978)
+	Numeric string
+
+	// MinorUnits is the number of decimals of the currency (e.g. 2 for EUR, 0 for JPY)
+	MinorUnits int
+
+	// Symbol is the commonly used symbol (e.g. €).
+	// Empty if the currency has no unambiguous symbol, in which case the code should be used.
+	Symbol string
+}
+
+var currenciesByCode = map[string]*Currency{}
+
+func init() {
+	for i := range currencies {
+		currenciesByCode[currencies[i].Code] = &currencies[i]
+	}
+}
+
+// Currencies returns a copy of all active currencies, sorted by code
+func Currencies() []Currency {
+	result := make([]Currency, len(currencies))
+	copy(result, currencies)
+	return result
+}
+
+// CurrencyByCode returns the currency for an ISO 4217 alphabetic code (e.g. EUR), ignoring casing.
+//
+// Raises
+//
+// - 404/currency_not_found: Provided currency code was not found
+func CurrencyByCode(code string) (Currency, *errors.GenericError) {
+	currency, exists := currenciesByCode[strings.ToUpper(code)]
+	if !exists {
+		meta := map[string]string{"code": code}
+		return Currency{}, errors.NewGenericError(404, errorDomain, errorSubDomain, ErrorCurrencyNotFound, meta)
+	}
+	return *currency, nil
+}
+
+// ValidateCurrencyCode checks if the code is a known ISO 4217 alphabetic code (e.g. EUR).
+// Code should be in upper case.
+//
+// Raises
+//
+// - 400/invalid_currency_code: Provided currency code is unknown
+func ValidateCurrencyCode(code string) *errors.GenericError {
+	if _, exists := currenciesByCode[code]; !exists {
+		meta := map[string]string{"currency": code}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidCurrencyCode, meta)
+	}
+	return nil
+}
+
+// minorUnits returns the number of decimals for the currency.
+// Defaults to 2 for unknown currencies.
+func minorUnits(code string) int {
+	if currency, exists := currenciesByCode[code]; exists {
+		return currency.MinorUnits
+	}
+	return 2
+}
@@ -0,0 +1,163 @@
+package money
+
+// currencies contains all active ISO 4217 currencies, sorted by code.
+// Based on https://www.six-group.com/en/products-services/financial-information/data-standards.html
+var currencies = []Currency{
+	{Code: "AED", Numeric: "784", MinorUnits: 2},
+	{Code: "AFN", Numeric: "971", MinorUnits: 2},
+	{Code: "ALL", Numeric: "008", MinorUnits: 2},
+	{Code: "AMD", Numeric: "051", MinorUnits: 2},
+	{Code: "AOA", Numeric: "973", MinorUnits: 2},
+	{Code: "ARS", Numeric: "032", MinorUnits: 2},
+	{Code: "AUD", Numeric: "036", MinorUnits: 2, Symbol: "A$"},
+	{Code: "AWG", Numeric: "533", MinorUnits: 2},
+	{Code: "AZN", Numeric: "944", MinorUnits: 2},
+	{Code: "BAM", Numeric: "977", MinorUnits: 2},
+	{Code: "BBD", Numeric: "052", MinorUnits: 2},
+	{Code: "BDT", Numeric: "050", MinorUnits: 2},
+	{Code: "BHD", Numeric: "048", MinorUnits: 3},
+	{Code: "BIF", Numeric: "108", MinorUnits: 0},
+	{Code: "BMD", Numeric: "060", MinorUnits: 2},
+	{Code: "BND", Numeric: "096", MinorUnits: 2},
+	{Code: "BOB", Numeric: "068", MinorUnits: 2},
+	{Code: "BRL", Numeric: "986", MinorUnits: 2, Symbol: "R$"},
+	{Code: "BSD", Numeric: "044", MinorUnits: 2},
+	{Code: "BTN", Numeric: "064", MinorUnits: 2},
+	{Code: "BWP", Numeric: "072", MinorUnits: 2},
+	{Code: "BYN", Numeric: "933", MinorUnits: 2},
+	{Code: "BZD", Numeric: "084", MinorUnits: 2},
+	{Code: "CAD", Numeric: "124", MinorUnits: 2, Symbol: "CA$"},
+	{Code: "CDF", Numeric: "976", MinorUnits: 2},
+	{Code: "CHF", Numeric: "756", MinorUnits: 2, Symbol: "CHF"},
+	{Code: "CLF", Numeric: "990", MinorUnits: 4},
+	{Code: "CLP", Numeric: "152", MinorUnits: 0},
+	{Code: "CNY", Numeric: "156", MinorUnits: 2, Symbol: "CN¥"},
+	{Code: "COP", Numeric: "170", MinorUnits: 2},
+	{Code: "CRC", Numeric: "188", MinorUnits: 2},
+	{Code: "CUP", Numeric: "192", MinorUnits: 2},
+	{Code: "CVE", Numeric: "132", MinorUnits: 2},
+	{Code: "CZK", Numeric: "203", MinorUnits: 2},
+	{Code: "DJF", Numeric: "262", MinorUnits: 0},
+	{Code: "DKK", Numeric: "208", MinorUnits: 2},
+	{Code: "DOP", Numeric: "214", MinorUnits: 2},
+	{Code: "DZD", Numeric: "012", MinorUnits: 2},
+	{Code: "EGP", Numeric: "818", MinorUnits: 2},
+	{Code: "ERN", Numeric: "232", MinorUnits: 2},
+	{Code: "ETB", Numeric: "230", MinorUnits: 2},
+	{Code: "EUR", Numeric: "978", MinorUnits: 2, Symbol: "€"},
+	{Code: "FJD", Numeric: "242", MinorUnits: 2},
+	{Code: "FKP", Numeric: "238", MinorUnits: 2},
+	{Code: "GBP", Numeric: "826", MinorUnits: 2, Symbol: "£"},
+	{Code: "GEL", Numeric: "981", MinorUnits: 2},
+	{Code: "GHS", Numeric: "936", MinorUnits: 2},
+	{Code: "GIP", Numeric: "292", MinorUnits: 2},
+	{Code: "GMD", Numeric: "270", MinorUnits: 2},
+	{Code: "GNF", Numeric: "324", MinorUnits: 0},
+	{Code: "GTQ", Numeric: "320", MinorUnits: 2},
+	{Code: "GYD", Numeric: "328", MinorUnits: 2},
+	{Code: "HKD", Numeric: "344", MinorUnits: 2, Symbol: "HK$"},
+	{Code: "HNL", Numeric: "340", MinorUnits: 2},
+	{Code: "HTG", Numeric: "332", MinorUnits: 2},
+	{Code: "HUF", Numeric: "348", MinorUnits: 2},
+	{Code: "IDR", Numeric: "360", MinorUnits: 2},
+	{Code: "ILS", Numeric: "376", MinorUnits: 2, Symbol: "₪"},
+	{Code: "INR", Numeric: "356", MinorUnits: 2, Symbol: "₹"},
+	{Code: "IQD", Numeric: "368", MinorUnits: 3},
+	{Code: "IRR", Numeric: "364", MinorUnits: 2},
+	{Code: "ISK", Numeric: "352", MinorUnits: 0},
+	{Code: "JMD", Numeric: "388", MinorUnits: 2},
+	{Code: "JOD", Numeric: "400", MinorUnits: 3},
+	{Code: "JPY", Numeric: "392", MinorUnits: 0, Symbol: "¥"},
+	{Code: "KES", Numeric: "404", MinorUnits: 2},
+	{Code: "KGS", Numeric: "417", MinorUnits: 2},
+	{Code: "KHR", Numeric: "116", MinorUnits: 2},
+	{Code: "KMF", Numeric: "174", MinorUnits: 0},
+	{Code: "KPW", Numeric: "408", MinorUnits: 2},
+	{Code: "KRW", Numeric: "410", MinorUnits: 0, Symbol: "₩"},
+	{Code: "KWD", Numeric: "414", MinorUnits: 3},
+	{Code: "KYD", Numeric: "136", MinorUnits: 2},
+	{Code: "KZT", Numeric: "398", MinorUnits: 2},
+	{Code: "LAK", Numeric: "418", MinorUnits: 2},
+	{Code: "LBP", Numeric: "422", MinorUnits: 2},
+	{Code: "LKR", Numeric: "144", MinorUnits: 2},
+	{Code: "LRD", Numeric: "430", MinorUnits: 2},
+	{Code: "LSL", Numeric: "426", MinorUnits: 2},
+	{Code: "LYD", Numeric: "434", MinorUnits: 3},
+	{Code: "MAD", Numeric: "504", MinorUnits: 2},
+	{Code: "MDL", Numeric: "498", MinorUnits: 2},
+	{Code: "MGA", Numeric: "969", MinorUnits: 2},
+	{Code: "MKD", Numeric: "807", MinorUnits: 2},
+	{Code: "MMK", Numeric: "104", MinorUnits: 2},
+	{Code: "MNT", Numeric: "496", MinorUnits: 2},
+	{Code: "MOP", Numeric: "446", MinorUnits: 2},
+	{Code: "MRU", Numeric: "929", MinorUnits: 2},
+	{Code: "MUR", Numeric: "480", MinorUnits: 2},
+	{Code: "MVR", Numeric: "462", MinorUnits: 2},
+	{Code: "MWK", Numeric: "454", MinorUnits: 2},
+	{Code: "MXN", Numeric: "484", MinorUnits: 2, Symbol: "MX$"},
+	{Code: "MYR", Numeric: "458", MinorUnits: 2},
+	{Code: "MZN", Numeric: "943", MinorUnits: 2},
+	{Code: "NAD", Numeric: "516", MinorUnits: 2},
+	{Code: "NGN", Numeric: "566", MinorUnits: 2},
+	{Code: "NIO", Numeric: "558", MinorUnits: 2},
+	{Code: "NOK", Numeric: "578", MinorUnits: 2},
+	{Code: "NPR", Numeric: "524", MinorUnits: 2},
+	{Code: "NZD", Numeric: "554", MinorUnits: 2, Symbol: "NZ$"},
+	{Code: "OMR", Numeric: "512", MinorUnits: 3},
+	{Code: "PAB", Numeric: "590", MinorUnits: 2},
+	{Code: "PEN", Numeric: "604", MinorUnits: 2},
+	{Code: "PGK", Numeric: "598", MinorUnits: 2},
+	{Code: "PHP", Numeric: "608", MinorUnits: 2},
+	{Code: "PKR", Numeric: "586", MinorUnits: 2},
+	{Code: "PLN", Numeric: "985", MinorUnits: 2},
+	{Code: "PYG", Numeric: "600", MinorUnits: 0},
+	{Code: "QAR", Numeric: "634", MinorUnits: 2},
+	{Code: "RON", Numeric: "946", MinorUnits: 2},
+	{Code: "RSD", Numeric: "941", MinorUnits: 2},
+	{Code: "RUB", Numeric: "643", MinorUnits: 2},
+	{Code: "RWF", Numeric: "646", MinorUnits: 0},
+	{Code: "SAR", Numeric: "682", MinorUnits: 2},
+	{Code: "SBD", Numeric: "090", MinorUnits: 2},
+	{Code: "SCR", Numeric: "690", MinorUnits: 2},
+	{Code: "SDG", Numeric: "938", MinorUnits: 2},
+	{Code: "SEK", Numeric: "752", MinorUnits: 2},
+	{Code: "SGD", Numeric: "702", MinorUnits: 2},
+	{Code: "SHP", Numeric: "654", MinorUnits: 2},
+	{Code: "SLE", Numeric: "925", MinorUnits: 2},
+	{Code: "SOS", Numeric: "706", MinorUnits: 2},
+	{Code: "SRD", Numeric: "968", MinorUnits: 2},
+	{Code: "SSP", Numeric: "728", MinorUnits: 2},
+	{Code: "STN", Numeric: "930", MinorUnits: 2},
+	{Code: "SVC", Numeric: "222", MinorUnits: 2},
+	{Code: "SYP", Numeric: "760", MinorUnits: 2},
+	{Code: "SZL", Numeric: "748", MinorUnits: 2},
+	{Code: "THB", Numeric: "764", MinorUnits: 2},
+	{Code: "TJS", Numeric: "972", MinorUnits: 2},
+	{Code: "TMT", Numeric: "934", MinorUnits: 2},
+	{Code: "TND", Numeric: "788", MinorUnits: 3},
+	{Code: "TOP", Numeric: "776", MinorUnits: 2},
+	{Code: "TRY", Numeric: "949", MinorUnits: 2},
+	{Code: "TTD", Numeric: "780", MinorUnits: 2},
+	{Code: "TWD", Numeric: "901", MinorUnits: 2, Symbol: "NT$"},
+	{Code: "TZS", Numeric: "834", MinorUnits: 2},
+	{Code: "UAH", Numeric: "980", MinorUnits: 2},
+	{Code: "UGX", Numeric: "800", MinorUnits: 0},
+	{Code: "USD", Numeric: "840", MinorUnits: 2, Symbol: "$"},
+	{Code: "UYU", Numeric: "858", MinorUnits: 2},
+	{Code: "UYW", Numeric: "927", MinorUnits: 4},
+	{Code: "UZS", Numeric: "860", MinorUnits: 2},
+	{Code: "VED", Numeric: "926", MinorUnits: 2},
+	{Code: "VES", Numeric: "928", MinorUnits: 2},
+	{Code: "VND", Numeric: "704", MinorUnits: 0, Symbol: "₫"},
+	{Code: "VUV", Numeric: "548", MinorUnits: 0},
+	{Code: "WST", Numeric: "882", MinorUnits: 2},
+	{Code: "XAF", Numeric: "950", MinorUnits: 0, Symbol: "FCFA"},
+	{Code: "XCD", Numeric: "951", MinorUnits: 2, Symbol: "EC$"},
+	{Code: "XCG", Numeric: "532", MinorUnits: 2},
+	{Code: "XOF", Numeric: "952", MinorUnits: 0, Symbol: "F CFA"},
+	{Code: "XPF", Numeric: "953", MinorUnits: 0, Symbol: "CFPF"},
+	{Code: "YER", Numeric: "886", MinorUnits: 2},
+	{Code: "ZAR", Numeric: "710", MinorUnits: 2},
+	{Code: "ZMW", Numeric: "967", MinorUnits: 2},
+	{Code: "ZWG", Numeric: "924", MinorUnits: 2},
+}
@@ -0,0 +1,44 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/converters"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Currencies_Consistent(t *testing.T) {
+	codes := map[string]bool{}
+	for _, currency := range Currencies() {
+		assert.Len(t, currency.Code, 3)
+		assert.Len(t, currency.Numeric, 3)
+		assert.False(t, codes[currency.Code], "code should be unique")
+		codes[currency.Code] = true
+	}
+
+	// All currencies of countries should be known
+	for _, country := range converters.Countries() {
+		if country.Currency != "" {
+			assert.True(t, codes[country.Currency], country.Currency)
+		}
+	}
+}
+
+func Test_CurrencyByCode_Success(t *testing.T) {
+	result, genErr := CurrencyByCode("jpy")
+	require.Nil(t, genErr)
+	assert.Equal(t, Currency{Code: "JPY", Numeric: "392", MinorUnits: 0, Symbol: "¥"}, result)
+}
+
+func Test_CurrencyByCode_Failure(t *testing.T) {
+	_, genErr := CurrencyByCode("XXX")
+	errors.AssertGenericError(t, genErr, 404, ErrorCurrencyNotFound, map[string]string{"code": "XXX"})
+}
+
+func Test_ValidateCurrencyCode_Failure(t *testing.T) {
+	assert.Nil(t, ValidateCurrencyCode("EUR"))
+	genErr := ValidateCurrencyCode("eur")
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidCurrencyCode, map[string]string{"currency": "eur"})
+}
@@ -0,0 +1,3 @@
+// Package money contains helpers to safely handle amounts of money.
+// Amounts are stored as integer minor units (e.g. cents) to prevent floating point errors.
+package money
@@ -0,0 +1,34 @@
+package money
+
+const errorDomain = "go_utils"
+const errorSubDomain = "money"
+
+// ErrorCurrencyNotFound indicates the provided currency code was not found
+const ErrorCurrencyNotFound = "currency_not_found"
+
+// ErrorInvalidCurrencyCode indicates the provided currency code is not a known ISO 4217 code
+const ErrorInvalidCurrencyCode = "invalid_currency_code"
+
+// ErrorCurrencyMismatch indicates an operation was done on amounts with a different currency
+const ErrorCurrencyMismatch = "currency_mismatch"
+
+// ErrorInvalidAmount indicates the provided amount could not be parsed
+const ErrorInvalidAmount = "invalid_amount"
+
+// ErrorAmountOverflow indicates the result of an operation is too large to be stored
+const ErrorAmountOverflow = "amount_overflow"
+
+// ErrorDivisionByZero indicates an amount was divided by zero
+const ErrorDivisionByZero = "division_by_zero"
+
+// ErrorInvalidRatios indicates the provided ratios to allocate an amount are invalid
+const ErrorInvalidRatios = "invalid_ratios"
+
+// ErrorUnsupportedLocale indicates the provided locale is not supported for formatting
+const ErrorUnsupportedLocale = "unsupported_locale"
+
+// ErrorAmountBelowMinimum indicates the amount is less than the allowed minimum
+const ErrorAmountBelowMinimum = "amount_below_minimum"
+
+// ErrorAmountAboveMaximum indicates the amount is more than the allowed maximum
+const ErrorAmountAboveMaximum = "amount_above_maximum"
@@ -0,0 +1,79 @@
+package money
+
+import (
+	"strings"
+
+	"github.com/skiprco/go-utils/v2/converters"
+	"github.com/skiprco/go-utils/v2/errors"
+	"golang.org/x/text/language"
+)
+
+// symbolFormat defines where the currency symbol is placed in a language
+type symbolFormat struct {
+	symbolAfter bool
+	symbolSpace bool
+}
+
+// symbolFormats contains the symbol placement of the supported languages, based on CLDR.
+// Separators are taken from converters.GetNumberFormat.
+var symbolFormats = map[string]symbolFormat{
+	"en": {symbolAfter: false, symbolSpace: false},
+	"fr": {symbolAfter: true, symbolSpace: true},
+	"nl": {symbolAfter: false, symbolSpace: true},
+	"de": {symbolAfter: true, symbolSpace: true},
+	"es": {symbolAfter: true, symbolSpace: true},
+	"it": {symbolAfter: true, symbolSpace: true},
+}
+
+// Format formats the amount for display in the provided locale (e.g. "fr-BE").
+// Only the language of the locale is taken into account. Supported languages are en, fr, nl, de, es and it.
+// If the currency has no symbol, the currency code is used instead.
+// Spaces in the result are non-breaking spaces.
+//
+// Examples for 1234.50 EUR: en => "€1,234.50", nl => "€ 1.234,50", fr => "1 234,50 €"
+//
+// Raises
+//
+// - 400/unsupported_locale: Provided locale is not supported
+func (m Money) Format(locale string) (string, *errors.GenericError) {
+	// Fetch locale format
+	tag, _ := language.Parse(locale)
+	base, _ := tag.Base()
+	format, exists := symbolFormats[base.String()]
+	numberFormat, genErr := converters.GetNumberFormat(locale)
+	if genErr != nil || !exists {
+		meta := map[string]string{"locale": locale}
+		return "", errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorUnsupportedLocale, meta)
+	}
+
+	// Format number
+	number := strings.TrimPrefix(m.Decimal(), "-")
+	integer, fraction := number, ""
+	if index := strings.Index(number, "."); index >= 0 {
+		integer, fraction = number[:index], numberFormat.DecimalSeparator+number[index+1:]
+	}
+	number = converters.GroupNumberDigits(integer, numberFormat.GroupSeparator) + fraction
+
+	// Fetch symbol
+	symbol := m.Currency
+	if currency, exists := currenciesByCode[m.Currency]; exists && currency.Symbol != "" {
+		symbol = currency.Symbol
+	}
+	space := ""
+	if format.symbolSpace || symbol == m.Currency {
+		space = "\u00a0"
+	}
+
+	// Build result
+	sign := ""
+	if m.Amount < 0 {
+		sign = "-"
+	}
+	if format.symbolAfter {
+		return sign + number + space + symbol, nil
+	}
+	if space == "" {
+		return sign + symbol + number, nil
+	}
+	return symbol + space + sign + number, nil
+}
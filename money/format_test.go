@@ -0,0 +1,42 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Format_Success(t *testing.T) {
+	tests := []struct {
+		money    Money
+		locale   string
+		expected string
+	}{
+		{testMoney(t, 123450, "EUR"), "en", "€1,234.50"},
+		{testMoney(t, -123450, "EUR"), "en-US", "-€1,234.50"},
+		{testMoney(t, 123450, "EUR"), "nl-BE", "€ 1.234,50"},
+		{testMoney(t, -123450, "EUR"), "nl", "€ -1.234,50"},
+		{testMoney(t, 123450, "EUR"), "fr", "1 234,50 €"},
+		{testMoney(t, 123450, "EUR"), "de", "1.234,50 €"},
+		{testMoney(t, 1234567, "JPY"), "en", "¥1,234,567"},
+		{testMoney(t, 5, "EUR"), "it", "0,05 €"},
+		{testMoney(t, math.MinInt64, "EUR"), "en", "-€92,233,720,368,547,758.08"},
+		{testMoney(t, 123450, "SEK"), "en", "SEK 1,234.50"},
+	}
+
+	for _, test := range tests {
+		result, genErr := test.money.Format(test.locale)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.expected, result)
+	}
+}
+
+func Test_Format_Failure(t *testing.T) {
+	for _, locale := range []string{"pt", "invalid!"} {
+		_, genErr := testMoney(t, 100, "EUR").Format(locale)
+		errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedLocale, map[string]string{"locale": locale})
+	}
+}
@@ -0,0 +1,297 @@
+package money
+
+import (
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// Money is an amount in a specific currency.
+// The amount is stored in minor units of the currency (e.g. 1050 is 10.50 EUR).
+// All operations return a new Money, the original value is never changed.
+type Money struct {
+	// Amount in minor units of the currency (e.g. cents for EUR)
+	Amount int64 `json:"amount" bson:"amount"`
+
+	// Currency is the ISO 4217 alphabetic code (e.g. EUR)
+	Currency string `json:"currency" bson:"currency"`
+}
+
+var decimalRegex = regexp.MustCompile(`^[+-]?\d+(\.\d+)?$`)
+
+// New creates a new Money from an amount in minor units (e.g. cents)
+//
+// Raises
+//
+// - 400/invalid_currency_code: Provided currency code is unknown
+func New(amount int64, currency string) (Money, *errors.GenericError) {
+	currency = strings.ToUpper(currency)
+	if genErr := ValidateCurrencyCode(currency); genErr != nil {
+		return Money{}, genErr
+	}
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// Parse creates a new Money from a decimal amount in major units (e.g. "10.50").
+// Amounts with more decimals than the currency allows are rounded with the provided mode.
+//
+// Raises
+//
+// - 400/invalid_currency_code: Provided currency code is unknown
+//
+// - 400/invalid_amount: Provided amount is not a decimal number
+//
+// - 400/amount_overflow: Provided amount is too large
+func Parse(amount string, currency string, mode RoundingMode) (Money, *errors.GenericError) {
+	// Validate currency
+	result, genErr := New(0, currency)
+	if genErr != nil {
+		return Money{}, genErr
+	}
+
+	// Parse amount
+	value, ok := new(big.Rat).SetString(amount)
+	if !decimalRegex.MatchString(amount) || !ok {
+		meta := map[string]string{"amount": amount}
+		return Money{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidAmount, meta)
+	}
+
+	// Convert to minor units
+	value.Mul(value, minorUnitFactor(result.Currency))
+	result.Amount, genErr = roundToInt64(value, mode)
+	return result, genErr
+}
+
+// FromFloat creates a new Money from a float amount in major units (e.g. 10.5).
+// Should only be used to convert amounts received from legacy systems.
+// The shortest decimal representation of the float is used, so 0.1 is handled as exactly 0.1.
+//
+// Raises
+//
+// - 400/invalid_currency_code: Provided currency code is unknown
+//
+// - 400/invalid_amount: Provided amount is NaN or infinite
+//
+// - 400/amount_overflow: Provided amount is too large
+func FromFloat(amount float64, currency string, mode RoundingMode) (Money, *errors.GenericError) {
+	return Parse(strconv.FormatFloat(amount, 'f', -1, 64), currency, mode)
+}
+
+// Float returns the amount in major units as a float (e.g. 10.5).
+// Should only be used to send amounts to legacy systems.
+func (m Money) Float() float64 {
+	result, _ := new(big.Rat).SetFrac(big.NewInt(m.Amount), minorUnitFactor(m.Currency).Num()).Float64()
+	return result
+}
+
+// Decimal returns the amount in major units with all decimals of the currency (e.g. "10.50").
+// Unknown currencies are formatted with 2 decimals.
+func (m Money) Decimal() string {
+	decimals := minorUnits(m.Currency)
+	digits := strconv.FormatInt(m.Amount, 10)
+	sign := ""
+	if m.Amount < 0 {
+		sign = "-"
+		digits = digits[1:]
+	}
+	if decimals == 0 {
+		return sign + digits
+	}
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-decimals] + "." + digits[len(digits)-decimals:]
+}
+
+// String returns the amount in major units followed by the currency code (e.g. "10.50 EUR")
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+// IsZero returns true if the amount is zero
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// IsPositive returns true if the amount is more than zero
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+// IsNegative returns true if the amount is less than zero
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Negate returns the amount with the opposite sign
+//
+// Raises
+//
+// - 400/amount_overflow: Amount is the minimum int64, which has no positive counterpart
+func (m Money) Negate() (Money, *errors.GenericError) {
+	if m.Amount == math.MinInt64 {
+		return Money{}, m.overflowError()
+	}
+	return Money{Amount: -m.Amount, Currency: m.Currency}, nil
+}
+
+// Abs returns the absolute amount
+//
+// Raises
+//
+// - 400/amount_overflow: Amount is the minimum int64, which has no positive counterpart
+func (m Money) Abs() (Money, *errors.GenericError) {
+	if m.Amount < 0 {
+		return m.Negate()
+	}
+	return m, nil
+}
+
+// Compare returns -1 if m is less than other, 0 if both are equal and 1 if m is more than other
+//
+// Raises
+//
+// - 400/currency_mismatch: Currencies of both amounts are different
+func (m Money) Compare(other Money) (int, *errors.GenericError) {
+	if genErr := m.assertSameCurrency(other); genErr != nil {
+		return 0, genErr
+	}
+	switch {
+	case m.Amount < other.Amount:
+		return -1, nil
+	case m.Amount > other.Amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Add returns the sum of m and other
+//
+// Raises
+//
+// - 400/currency_mismatch: Currencies of both amounts are different
+//
+// - 400/amount_overflow: Result is too large
+func (m Money) Add(other Money) (Money, *errors.GenericError) {
+	if genErr := m.assertSameCurrency(other); genErr != nil {
+		return Money{}, genErr
+	}
+	sum := m.Amount + other.Amount
+	if (other.Amount > 0 && sum < m.Amount) || (other.Amount < 0 && sum > m.Amount) {
+		return Money{}, m.overflowError()
+	}
+	return Money{Amount: sum, Currency: m.Currency}, nil
+}
+
+// Subtract returns the difference between m and other
+//
+// Raises
+//
+// - 400/currency_mismatch: Currencies of both amounts are different
+//
+// - 400/amount_overflow: Result is too large
+func (m Money) Subtract(other Money) (Money, *errors.GenericError) {
+	if genErr := m.assertSameCurrency(other); genErr != nil {
+		return Money{}, genErr
+	}
+	difference := m.Amount - other.Amount
+	if (other.Amount > 0 && difference > m.Amount) || (other.Amount < 0 && difference < m.Amount) {
+		return Money{}, m.overflowError()
+	}
+	return Money{Amount: difference, Currency: m.Currency}, nil
+}
+
+// Multiply returns the amount multiplied by the factor (e.g. 1.21 to add 21% VAT).
+// The result is rounded to the minor unit with the provided mode.
+//
+// Raises
+//
+// - 400/invalid_amount: Provided factor is NaN or infinite
+//
+// - 400/amount_overflow: Result is too large
+func (m Money) Multiply(factor float64, mode RoundingMode) (Money, *errors.GenericError) {
+	value, genErr := floatToRat(factor)
+	if genErr != nil {
+		return Money{}, genErr
+	}
+	value.Mul(value, new(big.Rat).SetInt64(m.Amount))
+	amount, genErr := roundToInt64(value, mode)
+	return Money{Amount: amount, Currency: m.Currency}, genErr
+}
+
+// Divide returns the amount divided by the divisor.
+// The result is rounded to the minor unit with the provided mode.
+// Use Allocate or Split to divide an amount without losing minor units.
+//
+// Raises
+//
+// - 400/invalid_amount: Provided divisor is NaN or infinite
+//
+// - 400/division_by_zero: Provided divisor is zero
+//
+// - 400/amount_overflow: Result is too large
+func (m Money) Divide(divisor float64, mode RoundingMode) (Money, *errors.GenericError) {
+	value, genErr := floatToRat(divisor)
+	if genErr != nil {
+		return Money{}, genErr
+	}
+	if value.Sign() == 0 {
+		return Money{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorDivisionByZero, nil)
+	}
+	value.Quo(new(big.Rat).SetInt64(m.Amount), value)
+	amount, genErr := roundToInt64(value, mode)
+	return Money{Amount: amount, Currency: m.Currency}, genErr
+}
+
+func (m Money) assertSameCurrency(other Money) *errors.GenericError {
+	if m.Currency != other.Currency {
+		meta := map[string]string{"currency": m.Currency, "other_currency": other.Currency}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorCurrencyMismatch, meta)
+	}
+	return nil
+}
+
+func (m Money) overflowError() *errors.GenericError {
+	meta := map[string]string{"currency": m.Currency}
+	return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorAmountOverflow, meta)
+}
+
+// minorUnitFactor returns 10^MinorUnits of the currency
+func minorUnitFactor(currency string) *big.Rat {
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(minorUnits(currency))), nil)
+	return new(big.Rat).SetInt(factor)
+}
+
+// floatToRat converts the shortest decimal representation of a float to a rational number
+//
+// Raises
+//
+// - 400/invalid_amount: Provided value is NaN or infinite
+func floatToRat(value float64) (*big.Rat, *errors.GenericError) {
+	formatted := strconv.FormatFloat(value, 'f', -1, 64)
+	result, ok := new(big.Rat).SetString(formatted)
+	if !ok {
+		meta := map[string]string{"amount": formatted}
+		return nil, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidAmount, meta)
+	}
+	return result, nil
+}
+
+// roundToInt64 rounds the value with the provided mode
+//
+// Raises
+//
+// - 400/amount_overflow: Rounded value doesn't fit in an int64
+func roundToInt64(value *big.Rat, mode RoundingMode) (int64, *errors.GenericError) {
+	rounded := round(value, mode)
+	if !rounded.IsInt64() {
+		meta := map[string]string{"amount": value.FloatString(0)}
+		return 0, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorAmountOverflow, meta)
+	}
+	return rounded.Int64(), nil
+}
@@ -0,0 +1,185 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMoney(t *testing.T, amount int64, currency string) Money {
+	result, genErr := New(amount, currency)
+	require.Nil(t, genErr)
+	return result
+}
+
+func Test_New_Success(t *testing.T) {
+	result, genErr := New(1050, "eur")
+	require.Nil(t, genErr)
+	assert.Equal(t, Money{Amount: 1050, Currency: "EUR"}, result)
+}
+
+func Test_New_InvalidCurrency_Failure(t *testing.T) {
+	_, genErr := New(1050, "XXX")
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidCurrencyCode, nil)
+}
+
+func Test_Parse_Success(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		mode     RoundingMode
+		expected int64
+	}{
+		{"10.50", "EUR", RoundHalfUp, 1050},
+		{"10.5", "EUR", RoundHalfUp, 1050},
+		{"-0.01", "EUR", RoundHalfUp, -1},
+		{"10.005", "EUR", RoundHalfUp, 1001},
+		{"10.005", "EUR", RoundHalfEven, 1000},
+		{"10.015", "EUR", RoundHalfEven, 1002},
+		{"10.005", "EUR", RoundHalfDown, 1000},
+		{"10.001", "EUR", RoundUp, 1001},
+		{"10.009", "EUR", RoundDown, 1000},
+		{"-10.001", "EUR", RoundCeiling, -1000},
+		{"-10.001", "EUR", RoundFloor, -1001},
+		{"-10.005", "EUR", RoundHalfUp, -1001},
+		{"1500.5", "JPY", RoundHalfEven, 1500},
+		{"1.2345", "KWD", RoundHalfUp, 1235},
+	}
+
+	for _, test := range tests {
+		result, genErr := Parse(test.amount, test.currency, test.mode)
+		require.Nil(t, genErr, test.amount)
+		assert.Equal(t, test.expected, result.Amount, "%s %s", test.amount, test.mode)
+	}
+}
+
+func Test_Parse_Failure(t *testing.T) {
+	for _, amount := range []string{"", "abc", "1e3", "1/3", "10,50", ".5"} {
+		_, genErr := Parse(amount, "EUR", RoundHalfUp)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidAmount, map[string]string{"amount": amount})
+	}
+
+	_, genErr := Parse("100000000000000000000", "EUR", RoundHalfUp)
+	errors.AssertGenericError(t, genErr, 400, ErrorAmountOverflow, nil)
+}
+
+func Test_FromFloat_Success(t *testing.T) {
+	result, genErr := FromFloat(0.1+0.2, "EUR", RoundHalfUp)
+	require.Nil(t, genErr)
+	assert.Equal(t, int64(30), result.Amount)
+
+	result, genErr = FromFloat(1.005, "EUR", RoundHalfUp)
+	require.Nil(t, genErr)
+	assert.Equal(t, int64(101), result.Amount)
+	assert.Equal(t, 1.01, result.Float())
+}
+
+func Test_FromFloat_Failure(t *testing.T) {
+	_, genErr := FromFloat(math.NaN(), "EUR", RoundHalfUp)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidAmount, nil)
+}
+
+func Test_Decimal_Success(t *testing.T) {
+	assert.Equal(t, "10.50", testMoney(t, 1050, "EUR").Decimal())
+	assert.Equal(t, "0.05", testMoney(t, 5, "EUR").Decimal())
+	assert.Equal(t, "-0.05", testMoney(t, -5, "EUR").Decimal())
+	assert.Equal(t, "1050", testMoney(t, 1050, "JPY").Decimal())
+	assert.Equal(t, "1.050", testMoney(t, 1050, "KWD").Decimal())
+	assert.Equal(t, "10.50 EUR", testMoney(t, 1050, "EUR").String())
+}
+
+func Test_AddSubtract_Success(t *testing.T) {
+	a := testMoney(t, 1050, "EUR")
+	b := testMoney(t, 250, "EUR")
+
+	sum, genErr := a.Add(b)
+	require.Nil(t, genErr)
+	assert.Equal(t, int64(1300), sum.Amount)
+
+	difference, genErr := b.Subtract(a)
+	require.Nil(t, genErr)
+	assert.Equal(t, int64(-800), difference.Amount)
+	assert.True(t, difference.IsNegative())
+	abs, genErr := difference.Abs()
+	require.Nil(t, genErr)
+	assert.Equal(t, int64(800), abs.Amount)
+}
+
+func Test_Negate_Success(t *testing.T) {
+	result, genErr := testMoney(t, 1050, "EUR").Negate()
+	require.Nil(t, genErr)
+	assert.Equal(t, testMoney(t, -1050, "EUR"), result)
+
+	result, genErr = testMoney(t, math.MaxInt64, "EUR").Negate()
+	require.Nil(t, genErr)
+	assert.Equal(t, int64(-math.MaxInt64), result.Amount)
+}
+
+func Test_Negate_Overflow_Failure(t *testing.T) {
+	_, genErr := testMoney(t, math.MinInt64, "EUR").Negate()
+	errors.AssertGenericError(t, genErr, 400, ErrorAmountOverflow, map[string]string{"currency": "EUR"})
+
+	_, genErr = testMoney(t, math.MinInt64, "EUR").Abs()
+	errors.AssertGenericError(t, genErr, 400, ErrorAmountOverflow, map[string]string{"currency": "EUR"})
+}
+
+func Test_AddSubtract_Failure(t *testing.T) {
+	_, genErr := testMoney(t, 1050, "EUR").Add(testMoney(t, 250, "USD"))
+	errors.AssertGenericError(t, genErr, 400, ErrorCurrencyMismatch, map[string]string{"currency": "EUR", "other_currency": "USD"})
+
+	_, genErr = testMoney(t, math.MaxInt64, "EUR").Add(testMoney(t, 1, "EUR"))
+	errors.AssertGenericError(t, genErr, 400, ErrorAmountOverflow, nil)
+
+	_, genErr = testMoney(t, math.MinInt64, "EUR").Subtract(testMoney(t, 1, "EUR"))
+	errors.AssertGenericError(t, genErr, 400, ErrorAmountOverflow, nil)
+}
+
+func Test_Compare_Success(t *testing.T) {
+	a := testMoney(t, 1050, "EUR")
+	b := testMoney(t, 250, "EUR")
+	for _, test := range []struct {
+		left     Money
+		right    Money
+		expected int
+	}{{a, b, 1}, {b, a, -1}, {a, a, 0}} {
+		result, genErr := test.left.Compare(test.right)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.expected, result)
+	}
+}
+
+func Test_Multiply_Success(t *testing.T) {
+	result, genErr := testMoney(t, 1000, "EUR").Multiply(1.21, RoundHalfUp)
+	require.Nil(t, genErr)
+	assert.Equal(t, int64(1210), result.Amount)
+
+	result, genErr = testMoney(t, 105, "EUR").Multiply(0.1, RoundHalfEven)
+	require.Nil(t, genErr)
+	assert.Equal(t, int64(10), result.Amount) // 10.5 rounded to even
+}
+
+func Test_Multiply_Failure(t *testing.T) {
+	_, genErr := testMoney(t, math.MaxInt64, "EUR").Multiply(2, RoundHalfUp)
+	errors.AssertGenericError(t, genErr, 400, ErrorAmountOverflow, nil)
+
+	_, genErr = testMoney(t, 1000, "EUR").Multiply(math.Inf(1), RoundHalfUp)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidAmount, nil)
+}
+
+func Test_Divide_Success(t *testing.T) {
+	result, genErr := testMoney(t, 1000, "EUR").Divide(3, RoundHalfUp)
+	require.Nil(t, genErr)
+	assert.Equal(t, int64(333), result.Amount)
+
+	result, genErr = testMoney(t, 1000, "EUR").Divide(3, RoundUp)
+	require.Nil(t, genErr)
+	assert.Equal(t, int64(334), result.Amount)
+}
+
+func Test_Divide_Failure(t *testing.T) {
+	_, genErr := testMoney(t, 1000, "EUR").Divide(0, RoundHalfUp)
+	errors.AssertGenericError(t, genErr, 400, ErrorDivisionByZero, nil)
+}
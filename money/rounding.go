@@ -0,0 +1,69 @@
+package money
+
+import "math/big"
+
+// RoundingMode defines how amounts are rounded to the minor unit of a currency
+type RoundingMode string
+
+const (
+	// RoundHalfUp rounds to the nearest value, ties away from zero (e.g. 2.5 => 3, -2.5 => -3).
+	// Used when an empty rounding mode is provided.
+	RoundHalfUp RoundingMode = "half_up"
+
+	// RoundHalfDown rounds to the nearest value, ties towards zero (e.g. 2.5 => 2, -2.5 => -2)
+	RoundHalfDown RoundingMode = "half_down"
+
+	// RoundHalfEven rounds to the nearest value, ties to the nearest even value (e.g. 2.5 => 2, 3.5 => 4).
+	// Also known as banker's rounding.
+	RoundHalfEven RoundingMode = "half_even"
+
+	// RoundUp rounds away from zero (e.g. 2.1 => 3, -2.1 => -3)
+	RoundUp RoundingMode = "up"
+
+	// RoundDown rounds towards zero (e.g. 2.9 => 2, -2.9 => -2)
+	RoundDown RoundingMode = "down"
+
+	// RoundCeiling rounds towards positive infinity (e.g. 2.1 => 3, -2.9 => -2)
+	RoundCeiling RoundingMode = "ceiling"
+
+	// RoundFloor rounds towards negative infinity (e.g. 2.9 => 2, -2.1 => -3)
+	RoundFloor RoundingMode = "floor"
+)
+
+// round rounds the value to an integer using the provided mode
+func round(value *big.Rat, mode RoundingMode) *big.Int {
+	// Truncate value
+	quotient, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	// Compare remainder with half
+	twiceRemainder := new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2))
+	half := twiceRemainder.Cmp(value.Denom())
+
+	// Check if truncated value should be moved away from zero
+	sign := value.Sign()
+	awayFromZero := false
+	switch mode {
+	case RoundHalfDown:
+		awayFromZero = half > 0
+	case RoundHalfEven:
+		awayFromZero = half > 0 || (half == 0 && quotient.Bit(0) == 1)
+	case RoundUp:
+		awayFromZero = true
+	case RoundDown:
+		awayFromZero = false
+	case RoundCeiling:
+		awayFromZero = sign > 0
+	case RoundFloor:
+		awayFromZero = sign < 0
+	default:
+		awayFromZero = half >= 0
+	}
+
+	if awayFromZero {
+		quotient.Add(quotient, big.NewInt(int64(sign)))
+	}
+	return quotient
+}
@@ -0,0 +1,50 @@
+package money
+
+import "github.com/skiprco/go-utils/v2/errors"
+
+// Validate checks if the currency of the amount is a known ISO 4217 code
+//
+// Raises
+//
+// - 400/invalid_currency_code: Currency is unknown
+func (m Money) Validate() *errors.GenericError {
+	return ValidateCurrencyCode(m.Currency)
+}
+
+// ValidateRange checks if the amount is between min and max (both including)
+//
+// Raises
+//
+// - 400/invalid_currency_code: Currency is unknown
+//
+// - 400/currency_mismatch: Currency of min or max differs from the amount's currency
+//
+// - 400/amount_below_minimum: Amount is less than min
+//
+// - 400/amount_above_maximum: Amount is more than max
+func (m Money) ValidateRange(min Money, max Money) *errors.GenericError {
+	if genErr := m.Validate(); genErr != nil {
+		return genErr
+	}
+
+	// Check minimum
+	comparison, genErr := m.Compare(min)
+	if genErr != nil {
+		return genErr
+	}
+	if comparison < 0 {
+		meta := map[string]string{"amount": m.String(), "minimum": min.String()}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorAmountBelowMinimum, meta)
+	}
+
+	// Check maximum
+	comparison, genErr = m.Compare(max)
+	if genErr != nil {
+		return genErr
+	}
+	if comparison > 0 {
+		meta := map[string]string{"amount": m.String(), "maximum": max.String()}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorAmountAboveMaximum, meta)
+	}
+	return nil
+}
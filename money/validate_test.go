@@ -0,0 +1,34 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Validate_Failure(t *testing.T) {
+	assert.Nil(t, testMoney(t, 1050, "EUR").Validate())
+	errors.AssertGenericError(t, Money{}.Validate(), 400, ErrorInvalidCurrencyCode, nil)
+}
+
+func Test_ValidateRange_Success(t *testing.T) {
+	min := testMoney(t, 100, "EUR")
+	max := testMoney(t, 1000, "EUR")
+	assert.Nil(t, testMoney(t, 100, "EUR").ValidateRange(min, max))
+	assert.Nil(t, testMoney(t, 1000, "EUR").ValidateRange(min, max))
+}
+
+func Test_ValidateRange_Failure(t *testing.T) {
+	min := testMoney(t, 100, "EUR")
+	max := testMoney(t, 1000, "EUR")
+
+	genErr := testMoney(t, 99, "EUR").ValidateRange(min, max)
+	errors.AssertGenericError(t, genErr, 400, ErrorAmountBelowMinimum, map[string]string{"amount": "0.99 EUR", "minimum": "1.00 EUR"})
+
+	genErr = testMoney(t, 1001, "EUR").ValidateRange(min, max)
+	errors.AssertGenericError(t, genErr, 400, ErrorAmountAboveMaximum, map[string]string{"amount": "10.01 EUR", "maximum": "10.00 EUR"})
+
+	genErr = testMoney(t, 500, "USD").ValidateRange(min, max)
+	errors.AssertGenericError(t, genErr, 400, ErrorCurrencyMismatch, nil)
+}
@@ -39,6 +39,29 @@ func (_m *IMongoRepository) Count(ctx context.Context, collectionName string, qu
 	return r0, r1
 }
 
+// Create provides a mock function with given fields: ctx, collectionName, entity, methodName, opts
+func (_m *IMongoRepository) Create(ctx context.Context, collectionName string, entity interface{}, methodName string, opts ...*mongo.SaveOption) *errors.GenericError {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, collectionName, entity, methodName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *errors.GenericError
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, string, ...*mongo.SaveOption) *errors.GenericError); ok {
+		r0 = rf(ctx, collectionName, entity, methodName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*errors.GenericError)
+		}
+	}
+
+	return r0
+}
+
 // Delete provides a mock function with given fields: ctx, collectionName, entityId, methodName
 func (_m *IMongoRepository) Delete(ctx context.Context, collectionName string, entityId string, methodName string) *errors.GenericError {
 	ret := _m.Called(ctx, collectionName, entityId, methodName)
@@ -55,6 +78,22 @@ func (_m *IMongoRepository) Delete(ctx context.Context, collectionName string, e
 	return r0
 }
 
+// DeleteOne provides a mock function with given fields: ctx, collectionName, query, methodName
+func (_m *IMongoRepository) DeleteOne(ctx context.Context, collectionName string, query map[string]interface{}, methodName string) *errors.GenericError {
+	ret := _m.Called(ctx, collectionName, query, methodName)
+
+	var r0 *errors.GenericError
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}, string) *errors.GenericError); ok {
+		r0 = rf(ctx, collectionName, query, methodName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*errors.GenericError)
+		}
+	}
+
+	return r0
+}
+
 // GetMultiple provides a mock function with given fields: ctx, collectionName, query, responses, methodName, opts
 func (_m *IMongoRepository) GetMultiple(ctx context.Context, collectionName string, query map[string]interface{}, responses interface{}, methodName string, opts ...*mongo.GetMultipleOption) *errors.GenericError {
 	_va := make([]interface{}, len(opts))
@@ -94,13 +133,59 @@ func (_m *IMongoRepository) GetOne(ctx context.Context, collectionName string, q
 	return r0
 }
 
-// Save provides a mock function with given fields: ctx, collectionName, entity, entityId, methodName
-func (_m *IMongoRepository) Save(ctx context.Context, collectionName string, entity interface{}, entityId interface{}, methodName string) *errors.GenericError {
-	ret := _m.Called(ctx, collectionName, entity, entityId, methodName)
+// Ping provides a mock function with given fields: ctx
+func (_m *IMongoRepository) Ping(ctx context.Context) *errors.GenericError {
+	ret := _m.Called(ctx)
+
+	var r0 *errors.GenericError
+	if rf, ok := ret.Get(0).(func(context.Context) *errors.GenericError); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*errors.GenericError)
+		}
+	}
+
+	return r0
+}
+
+// Save provides a mock function with given fields: ctx, collectionName, entity, entityId, methodName, opts
+func (_m *IMongoRepository) Save(ctx context.Context, collectionName string, entity interface{}, entityId interface{}, methodName string, opts ...*mongo.SaveOption) *errors.GenericError {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, collectionName, entity, entityId, methodName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *errors.GenericError
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, interface{}, string, ...*mongo.SaveOption) *errors.GenericError); ok {
+		r0 = rf(ctx, collectionName, entity, entityId, methodName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*errors.GenericError)
+		}
+	}
+
+	return r0
+}
+
+// UpdateOne provides a mock function with given fields: ctx, collectionName, query, entity, methodName, opts
+func (_m *IMongoRepository) UpdateOne(ctx context.Context, collectionName string, query map[string]interface{}, entity interface{}, methodName string, opts ...*mongo.SaveOption) *errors.GenericError {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, collectionName, query, entity, methodName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 *errors.GenericError
-	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, interface{}, string) *errors.GenericError); ok {
-		r0 = rf(ctx, collectionName, entity, entityId, methodName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}, interface{}, string, ...*mongo.SaveOption) *errors.GenericError); ok {
+		r0 = rf(ctx, collectionName, query, entity, methodName, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*errors.GenericError)
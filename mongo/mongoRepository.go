@@ -3,22 +3,29 @@ package mongo
 import (
 	"context"
 	"reflect"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/skiprco/go-utils/v2/converters"
 	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/metrics"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
 )
 
 type IMongoRepository interface {
 	GetOne(ctx context.Context, collectionName string, query map[string]interface{}, acceptsEmptyResult bool, response interface{}, methodName string) *errors.GenericError
 	GetMultiple(ctx context.Context, collectionName string, query map[string]interface{}, responses interface{}, methodName string, opts ...*GetMultipleOption) *errors.GenericError
-	Save(ctx context.Context, collectionName string, entity interface{}, entityId interface{}, methodName string) *errors.GenericError
+	Save(ctx context.Context, collectionName string, entity interface{}, entityId interface{}, methodName string, opts ...*SaveOption) *errors.GenericError
+	Create(ctx context.Context, collectionName string, entity interface{}, methodName string, opts ...*SaveOption) *errors.GenericError
+	UpdateOne(ctx context.Context, collectionName string, query map[string]interface{}, entity interface{}, methodName string, opts ...*SaveOption) *errors.GenericError
 	Count(ctx context.Context, collectionName string, query map[string]interface{}, methodName string) (int64, *errors.GenericError)
 	Delete(ctx context.Context, collectionName string, entityId string, methodName string) *errors.GenericError
+	DeleteOne(ctx context.Context, collectionName string, query map[string]interface{}, methodName string) *errors.GenericError
+	Ping(ctx context.Context) *errors.GenericError
 }
 
 type mongoRepository struct {
@@ -39,6 +46,12 @@ type GetMultipleOptionSort struct {
 	Ascending bool
 }
 
+type SaveOption struct {
+	// SanitizePolicy is the name of the converters sanitization policy used for all strings
+	// which are not covered by a sanitize struct tag. Defaults to converters.SanitizePolicyStrict.
+	SanitizePolicy string
+}
+
 func NewMongoRepository(ctx context.Context, mongoURL string, dbName string, collectionNames []string) (IMongoRepository, *errors.GenericError) {
 	domain := "go-util"
 	client, err := createClient(ctx, domain, mongoURL)
@@ -82,22 +95,22 @@ func (r *mongoRepository) getCollection(collectionName string) (*mongo.Collectio
 // Save the entity. Try to find the entity by entityId on the field _id of the mongo collections.
 // If there is a match, the entity is updated. If not, the entity is create
 // the methodName parameter is used for logging / error
+// Before saving, the entity is sanitized with converters.SanitizeObjectWithPolicy.
 //
 // Raises
 //
+// - 500/only_one_opts_take_in_care: More than one option is provided
+//
+// - 500/unknown_sanitize_policy: Provided policy or a sanitize struct tag contains an unknown policy
+//
 // - 500/panic_during_sanitize_object: A panic occured during sanitation
 //
 // - 500/can_t_create_entity: Mongo library returned an error while doing an upsert
-func (r *mongoRepository) Save(ctx context.Context, collectionName string, entity interface{}, entityId interface{}, methodName string) *errors.GenericError {
+func (r *mongoRepository) Save(ctx context.Context, collectionName string, entity interface{}, entityId interface{}, methodName string, opts ...*SaveOption) (genErr *errors.GenericError) {
+	defer observeOperation(collectionName, "save", time.Now(), &genErr)
+
 	// Sanitize entity
-	if reflect.TypeOf(entity).Kind() != reflect.Ptr {
-		// Convert interface{obj} to interface{&obj}
-		// Based on https://stackoverflow.com/a/51219342
-		entityPtr := reflect.New(reflect.TypeOf(entity))
-		entityPtr.Elem().Set(reflect.ValueOf(entity))
-		entity = entityPtr.Interface()
-	}
-	genErr := converters.SanitizeObject(entity)
+	entity, genErr = r.sanitizeEntity(entity, methodName, opts)
 	if genErr != nil {
 		return genErr
 	}
@@ -106,10 +119,10 @@ func (r *mongoRepository) Save(ctx context.Context, collectionName string, entit
 	if genErr != nil {
 		return genErr
 	}
-	opts := options.Update().SetUpsert(true)
+	mongoOpts := options.Update().SetUpsert(true)
 	value := bson.M{"$set": entity}
 	query := bson.M{"_id": entityId}
-	_, err := collection.UpdateOne(ctx, query, value, opts)
+	_, err := collection.UpdateOne(ctx, query, value, mongoOpts)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error":       err,
@@ -122,9 +135,147 @@ func (r *mongoRepository) Save(ctx context.Context, collectionName string, entit
 	return nil
 }
 
+// Create inserts the entity. Fails if an entity with the same _id already exists,
+// which makes it usable as an atomic lock.
+// the methodName parameter is used for logging / error
+// Before saving, the entity is sanitized with converters.SanitizeObjectWithPolicy.
+//
+// Raises
+//
+// - 500/only_one_opts_take_in_care: More than one option is provided
+//
+// - 500/unknown_sanitize_policy: Provided policy or a sanitize struct tag contains an unknown policy
+//
+// - 500/panic_during_sanitize_object: A panic occured during sanitation
+//
+// - 409/entity_already_exists: An entity with the same _id already exists
+//
+// - 500/can_t_create_entity: Mongo library returned an error while doing an insert
+func (r *mongoRepository) Create(ctx context.Context, collectionName string, entity interface{}, methodName string, opts ...*SaveOption) (genErr *errors.GenericError) {
+	defer observeOperation(collectionName, "create", time.Now(), &genErr)
+
+	// Sanitize entity
+	entity, genErr = r.sanitizeEntity(entity, methodName, opts)
+	if genErr != nil {
+		return genErr
+	}
+
+	collection, genErr := r.getCollection(collectionName)
+	if genErr != nil {
+		return genErr
+	}
+	_, err := collection.InsertOne(ctx, entity)
+	if isDuplicateKeyError(err) {
+		return errors.NewGenericError(409, r.domain, methodName, "entity_already_exists", nil)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"method_name": methodName,
+			"entity":      entity,
+		}).Error("can't create entity")
+		return errors.NewGenericError(500, r.domain, methodName, "can_t_create_entity", nil)
+	}
+	return nil
+}
+
+// UpdateOne sets the fields of the entity on the first entity found by the query. No entity is inserted,
+// which makes it usable to only update an entity which is still in the expected state.
+// the methodName parameter is used for logging / error
+// Before saving, the entity is sanitized with converters.SanitizeObjectWithPolicy.
+//
+// Raises
+//
+// - 500/only_one_opts_take_in_care: More than one option is provided
+//
+// - 500/unknown_sanitize_policy: Provided policy or a sanitize struct tag contains an unknown policy
+//
+// - 500/panic_during_sanitize_object: A panic occured during sanitation
+//
+// - 404/no_entity: No entity matches the query
+//
+// - 500/can_t_update_entity: Mongo library returned an error while doing an update
+func (r *mongoRepository) UpdateOne(ctx context.Context, collectionName string, query map[string]interface{}, entity interface{}, methodName string, opts ...*SaveOption) (genErr *errors.GenericError) {
+	defer observeOperation(collectionName, "update_one", time.Now(), &genErr)
+
+	// Sanitize entity
+	entity, genErr = r.sanitizeEntity(entity, methodName, opts)
+	if genErr != nil {
+		return genErr
+	}
+
+	collection, genErr := r.getCollection(collectionName)
+	if genErr != nil {
+		return genErr
+	}
+	result, err := collection.UpdateOne(ctx, convertToBson(query), bson.M{"$set": entity})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"method_name": methodName,
+			"entity":      entity,
+			"query":       query,
+		}).Error("can't update entity")
+		return errors.NewGenericError(500, r.domain, methodName, "can_t_update_entity", nil)
+	}
+	if result.MatchedCount == 0 {
+		return errors.NewGenericError(404, r.domain, methodName, "no_entity", nil)
+	}
+	return nil
+}
+
+// sanitizeEntity parses the options and sanitizes the entity. Returns a pointer to the sanitized entity.
+//
+// Raises
+//
+// - 500/only_one_opts_take_in_care: More than one option is provided
+//
+// - 500/unknown_sanitize_policy: Provided policy or a sanitize struct tag contains an unknown policy
+//
+// - 500/panic_during_sanitize_object: A panic occured during sanitation
+func (r *mongoRepository) sanitizeEntity(entity interface{}, methodName string, opts []*SaveOption) (interface{}, *errors.GenericError) {
+	// Parse options
+	sanitizePolicy := converters.SanitizePolicyStrict
+	if len(opts) > 1 {
+		return nil, errors.NewGenericError(500, r.domain, methodName, "only_one_opts_take_in_care", nil)
+	} else if len(opts) > 0 && opts[0].SanitizePolicy != "" {
+		sanitizePolicy = opts[0].SanitizePolicy
+	}
+
+	// Sanitize entity
+	if reflect.TypeOf(entity).Kind() != reflect.Ptr {
+		// Convert interface{obj} to interface{&obj}
+		// Based on https://stackoverflow.com/a/51219342
+		entityPtr := reflect.New(reflect.TypeOf(entity))
+		entityPtr.Elem().Set(reflect.ValueOf(entity))
+		entity = entityPtr.Interface()
+	}
+	genErr := converters.SanitizeObjectWithPolicy(entity, sanitizePolicy)
+	if genErr != nil {
+		return nil, genErr
+	}
+	return entity, nil
+}
+
+// isDuplicateKeyError returns true if the error is caused by a duplicate _id or unique index
+func isDuplicateKeyError(err error) bool {
+	writeException, ok := err.(mongo.WriteException)
+	if !ok {
+		return false
+	}
+	for _, writeError := range writeException.WriteErrors {
+		if writeError.Code == 11000 {
+			return true
+		}
+	}
+	return false
+}
+
 // Count the number of entities found by the query
 // the methodName parameter is used for logging / error
-func (r *mongoRepository) Count(ctx context.Context, collectionName string, query map[string]interface{}, methodName string) (int64, *errors.GenericError) {
+func (r *mongoRepository) Count(ctx context.Context, collectionName string, query map[string]interface{}, methodName string) (count int64, genErr *errors.GenericError) {
+	defer observeOperation(collectionName, "count", time.Now(), &genErr)
+
 	collection, genErr := r.getCollection(collectionName)
 	if genErr != nil {
 		return 0, genErr
@@ -141,7 +292,9 @@ func (r *mongoRepository) Count(ctx context.Context, collectionName string, quer
 //		if acceptsEmptyResult == true, the function returns a nil error and response is not populate
 //		if acceptsEmptyResult == false, the function returns an error
 // the methodName parameter is used for logging / error
-func (r *mongoRepository) GetOne(ctx context.Context, collectionName string, query map[string]interface{}, acceptsEmptyResult bool, response interface{}, methodName string) *errors.GenericError {
+func (r *mongoRepository) GetOne(ctx context.Context, collectionName string, query map[string]interface{}, acceptsEmptyResult bool, response interface{}, methodName string) (genErr *errors.GenericError) {
+	defer observeOperation(collectionName, "get_one", time.Now(), &genErr)
+
 	collection, genErr := r.getCollection(collectionName)
 	if genErr != nil {
 		return genErr
@@ -181,7 +334,9 @@ func (r *mongoRepository) GetOne(ctx context.Context, collectionName string, que
 // the responses must be a list of pointer (like []MyEntity ). Can be define like that : var responses []MyEntity
 // opts contains a list of limits / sorting options
 // the methodName parameter is used for logging / error
-func (r *mongoRepository) GetMultiple(ctx context.Context, collectionName string, query map[string]interface{}, responses interface{}, methodName string, opts ...*GetMultipleOption) *errors.GenericError {
+func (r *mongoRepository) GetMultiple(ctx context.Context, collectionName string, query map[string]interface{}, responses interface{}, methodName string, opts ...*GetMultipleOption) (genErr *errors.GenericError) {
+	defer observeOperation(collectionName, "get_multiple", time.Now(), &genErr)
+
 	collection, genErr := r.getCollection(collectionName)
 	if genErr != nil {
 		return genErr
@@ -223,7 +378,9 @@ func (r *mongoRepository) GetMultiple(ctx context.Context, collectionName string
 
 // Delete removes entity by entityId
 // the methodName parameter is used for logging / error
-func (r *mongoRepository) Delete(ctx context.Context, collectionName string, entityId string, methodName string) *errors.GenericError {
+func (r *mongoRepository) Delete(ctx context.Context, collectionName string, entityId string, methodName string) (genErr *errors.GenericError) {
+	defer observeOperation(collectionName, "delete", time.Now(), &genErr)
+
 	collection, genErr := r.getCollection(collectionName)
 	if genErr != nil {
 		return genErr
@@ -241,6 +398,44 @@ func (r *mongoRepository) Delete(ctx context.Context, collectionName string, ent
 	return nil
 }
 
+// DeleteOne removes the first entity found by the query. No error is returned if no entity matches the query.
+// the methodName parameter is used for logging / error
+//
+// Raises
+//
+// - 500/delete_entity: Mongo library returned an error while doing a delete
+func (r *mongoRepository) DeleteOne(ctx context.Context, collectionName string, query map[string]interface{}, methodName string) (genErr *errors.GenericError) {
+	defer observeOperation(collectionName, "delete_one", time.Now(), &genErr)
+
+	collection, genErr := r.getCollection(collectionName)
+	if genErr != nil {
+		return genErr
+	}
+	_, err := collection.DeleteOne(ctx, convertToBson(query))
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"query": query,
+		}).Error("Failed to delete entity")
+		return errors.NewGenericError(500, r.domain, methodName, "delete_entity", nil)
+	}
+	return nil
+}
+
+// Ping checks if the primary of the database is reachable (e.g. for readiness checks)
+//
+// Raises
+//
+// - 503/can_t_ping_database: Database is not reachable
+func (r *mongoRepository) Ping(ctx context.Context) *errors.GenericError {
+	err := r.client.Ping(ctx, readpref.Primary())
+	if err != nil {
+		log.WithField("error", err).Warn("Failed to ping database")
+		return errors.NewGenericError(503, r.domain, "ping", "can_t_ping_database", map[string]string{"error": err.Error()})
+	}
+	return nil
+}
+
 func convertToBson(query map[string]interface{}) bson.M {
 	bs := bson.M{}
 	for k, v := range query {
@@ -250,3 +445,8 @@ func convertToBson(query map[string]interface{}) bson.M {
 	}
 	return bs
 }
+
+// observeOperation records the operation in the mongo metrics. Should be deferred with a pointer to the returned error.
+func observeOperation(collectionName string, operation string, start time.Time, genErr **errors.GenericError) {
+	metrics.ObserveMongoOperation(collectionName, operation, start, *genErr)
+}
@@ -0,0 +1,96 @@
+package mongo
+
+import (
+	"reflect"
+
+	"github.com/skiprco/go-utils/v2/collections"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ApplyPageRequest converts a page request into a query and an option for GetMultiple.
+// One item more than the requested limit is fetched, so collections.NewPage can detect if a next page exists.
+//
+// In offset mode, the provided sort is used (can be nil).
+// In cursor mode, the provided sort is ignored and the results are sorted on the cursor field.
+// Results are fetched in reverse order when paging backward. collections.NewPage restores the order.
+// Cursor values are converted to the type kept in the cursor (see collections.NewPageCursor),
+// so cursor fields of type string, int, double, date and ObjectID are supported.
+// For cursors without a type, values which are a valid ObjectID hex string are converted to a primitive.ObjectID.
+//
+// The provided query is not modified.
+func ApplyPageRequest(query map[string]interface{}, request collections.PageRequest, sort *GetMultipleOptionSort) (map[string]interface{}, *GetMultipleOption) {
+	// Copy query
+	pageQuery := make(map[string]interface{}, len(query)+1)
+	for key, value := range query {
+		pageQuery[key] = value
+	}
+
+	// Build option
+	opt := &GetMultipleOption{
+		Sort:  sort,
+		Limit: request.Limit + 1,
+	}
+	switch request.Mode {
+	case collections.PageModeCursor:
+		// Sort on cursor field
+		ascending := !request.CursorDescending
+		if request.Cursor != nil && request.Cursor.Backward {
+			ascending = !ascending
+		}
+		opt.Sort = &GetMultipleOptionSort{FieldName: request.CursorField, Ascending: ascending}
+
+		// Continue after cursor
+		if request.Cursor != nil {
+			operator := "$gt"
+			if !ascending {
+				operator = "$lt"
+			}
+			condition := map[string]interface{}{operator: cursorQueryValue(*request.Cursor)}
+			_, fieldExists := pageQuery[request.CursorField]
+			_, andExists := pageQuery["$and"]
+			if fieldExists || andExists {
+				// Keep existing conditions
+				appendAndConditions(pageQuery, map[string]interface{}{request.CursorField: condition})
+			} else {
+				pageQuery[request.CursorField] = condition
+			}
+		}
+
+	default:
+		opt.Skip = request.Offset
+	}
+
+	// Return result
+	return pageQuery, opt
+}
+
+// cursorQueryValue converts the cursor value to the type used in the database
+func cursorQueryValue(cursor collections.PageCursor) interface{} {
+	switch cursor.Type {
+	case "", collections.PageCursorTypeObjectID:
+		if objectID, err := primitive.ObjectIDFromHex(cursor.Value); err == nil {
+			return objectID
+		}
+	default:
+		if value, err := cursor.TypedValue(); err == nil {
+			return value
+		}
+	}
+	return cursor.Value // Invalid cursors are rejected by collections.DecodePageCursor
+}
+
+// appendAndConditions adds the conditions to the $and operator of the query, after the existing conditions
+func appendAndConditions(query map[string]interface{}, conditions ...interface{}) {
+	and := []interface{}{}
+	if existing, exists := query["$and"]; exists {
+		value := reflect.ValueOf(existing)
+		if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+			for i := 0; i < value.Len(); i++ {
+				and = append(and, value.Index(i).Interface())
+			}
+		} else {
+			and = append(and, existing) // Invalid, but let MongoDB raise the error
+		}
+	}
+	query["$and"] = append(and, conditions...)
+}
@@ -0,0 +1,115 @@
+package mongo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/collections"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_ApplyPageRequest_Offset_Success(t *testing.T) {
+	query := map[string]interface{}{"status": "active"}
+	request := collections.PageRequest{Mode: collections.PageModeOffset, Limit: 10, Offset: 20}
+	sort := &GetMultipleOptionSort{FieldName: "created_at"}
+
+	pageQuery, opt := ApplyPageRequest(query, request, sort)
+	assert.Equal(t, query, pageQuery)
+	assert.Equal(t, &GetMultipleOption{Sort: sort, Limit: 11, Skip: 20}, opt)
+}
+
+func Test_ApplyPageRequest_CursorObjectID_Success(t *testing.T) {
+	id := primitive.NewObjectID()
+	request := collections.PageRequest{
+		Mode:        collections.PageModeCursor,
+		Limit:       10,
+		CursorField: "_id",
+		Cursor:      &collections.PageCursor{Field: "_id", Value: id.Hex()},
+	}
+
+	pageQuery, opt := ApplyPageRequest(map[string]interface{}{"status": "active"}, request, nil)
+	expected := map[string]interface{}{
+		"status": "active",
+		"_id":    map[string]interface{}{"$gt": id},
+	}
+	assert.Equal(t, expected, pageQuery)
+	assert.Equal(t, &GetMultipleOptionSort{FieldName: "_id", Ascending: true}, opt.Sort)
+	assert.Equal(t, int64(11), opt.Limit)
+}
+
+func Test_ApplyPageRequest_CursorInt_Success(t *testing.T) {
+	// Paginate on an int field with the cursor of the next link
+	cursor, err := collections.DecodePageCursor(collections.NewPageCursor("seats", 42, false).Encode())
+	require.Nil(t, err)
+	request := collections.PageRequest{
+		Mode:        collections.PageModeCursor,
+		Limit:       10,
+		CursorField: "seats",
+		Cursor:      cursor,
+	}
+
+	pageQuery, opt := ApplyPageRequest(map[string]interface{}{}, request, nil)
+	assert.Equal(t, map[string]interface{}{"seats": map[string]interface{}{"$gt": int64(42)}}, pageQuery)
+	assert.Equal(t, &GetMultipleOptionSort{FieldName: "seats", Ascending: true}, opt.Sort)
+}
+
+func Test_ApplyPageRequest_CursorTyped_Success(t *testing.T) {
+	date := time.Date(2021, 3, 4, 5, 6, 7, 8000000, time.UTC)
+	id := primitive.NewObjectID()
+	testCases := []struct {
+		value    interface{}
+		expected interface{}
+	}{
+		{1.5, 1.5},
+		{date, date},
+		{id, id},
+		{id.Hex(), id.Hex()}, // Typed strings are not converted to an ObjectID
+	}
+	for _, testCase := range testCases {
+		cursor := collections.NewPageCursor("field", testCase.value, true)
+		request := collections.PageRequest{Mode: collections.PageModeCursor, Limit: 10, CursorField: "field", Cursor: &cursor}
+
+		pageQuery, _ := ApplyPageRequest(map[string]interface{}{}, request, nil)
+		assert.Equal(t, map[string]interface{}{"field": map[string]interface{}{"$lt": testCase.expected}}, pageQuery)
+	}
+}
+
+func Test_ApplyPageRequest_CursorBackward_Success(t *testing.T) {
+	request := collections.PageRequest{
+		Mode:        collections.PageModeCursor,
+		Limit:       10,
+		CursorField: "name",
+		Cursor:      &collections.PageCursor{Field: "name", Value: "bob", Backward: true},
+	}
+
+	pageQuery, opt := ApplyPageRequest(map[string]interface{}{}, request, nil)
+	assert.Equal(t, map[string]interface{}{"name": map[string]interface{}{"$lt": "bob"}}, pageQuery)
+	assert.Equal(t, &GetMultipleOptionSort{FieldName: "name", Ascending: false}, opt.Sort)
+}
+
+func Test_ApplyPageRequest_CursorExistingAnd_Success(t *testing.T) {
+	id := primitive.NewObjectID()
+	query := map[string]interface{}{
+		"_id":  map[string]interface{}{"$ne": "excluded"},
+		"$and": []map[string]interface{}{{"status": "active"}},
+	}
+	request := collections.PageRequest{
+		Mode:        collections.PageModeCursor,
+		Limit:       10,
+		CursorField: "_id",
+		Cursor:      &collections.PageCursor{Field: "_id", Value: id.Hex()},
+	}
+
+	pageQuery, _ := ApplyPageRequest(query, request, nil)
+	expected := map[string]interface{}{
+		"_id": map[string]interface{}{"$ne": "excluded"},
+		"$and": []interface{}{
+			map[string]interface{}{"status": "active"},
+			map[string]interface{}{"_id": map[string]interface{}{"$gt": id}},
+		},
+	}
+	assert.Equal(t, expected, pageQuery)
+	require.Len(t, query["$and"], 1) // Provided query is not modified
+}
@@ -0,0 +1,5 @@
+// Package tracing contains helpers for distributed tracing based on
+// W3C Trace Context (https://www.w3.org/TR/trace-context/).
+// Trace and span IDs are stored in metadata.Metadata, so they are
+// passed between services and included in each audit log.
+package tracing
@@ -0,0 +1,7 @@
+package tracing
+
+const errorDomain = "go_utils"
+const errorSubDomain = "tracing"
+
+// ErrorInvalidTraceParent indicates the provided traceparent header is not valid.
+const ErrorInvalidTraceParent = "invalid_traceparent"
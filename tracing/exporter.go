@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// SpanExporter sends ended spans to a tracing backend.
+// The interface matches the SpanExporter of OpenTelemetry, so an adapter
+// can convert the spans and forward them to an OpenTelemetry exporter.
+type SpanExporter interface {
+	// ExportSpans is called for each ended span which is sampled.
+	// Called synchronously when the span ends, so it should return quickly (e.g. by batching).
+	ExportSpans(ctx context.Context, spans []Span) error
+
+	// Shutdown flushes the pending spans and releases the resources of the exporter
+	Shutdown(ctx context.Context) error
+}
+
+var exporter SpanExporter
+var exporterLock sync.RWMutex
+
+// SetSpanExporter sets the exporter for all ended spans. Spans are not exported if nil.
+func SetSpanExporter(spanExporter SpanExporter) {
+	exporterLock.Lock()
+	defer exporterLock.Unlock()
+	exporter = spanExporter
+}
+
+// Shutdown shuts down the configured exporter. Should be called before the service stops.
+func Shutdown(ctx context.Context) error {
+	exporterLock.RLock()
+	defer exporterLock.RUnlock()
+	if exporter == nil {
+		return nil
+	}
+	return exporter.Shutdown(ctx)
+}
+
+// exportSpan sends the span to the configured exporter. Failures are logged, since tracing shouldn't break operations.
+func exportSpan(span Span) {
+	exporterLock.RLock()
+	defer exporterLock.RUnlock()
+	if exporter == nil {
+		return
+	}
+	if err := exporter.ExportSpans(context.Background(), []Span{span}); err != nil {
+		log.WithField("error", err).WithField("span_name", span.Name).Warn("Failed to export span")
+	}
+}
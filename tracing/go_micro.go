@@ -0,0 +1,93 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/micro/go-micro/v2/client"
+	microMetadata "github.com/micro/go-micro/v2/metadata"
+	"github.com/micro/go-micro/v2/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/skiprco/go-utils/v2/metadata"
+)
+
+// goMicroTraceParentKeys contains the possible keys of the traceparent header in go-micro metadata.
+// Keys are capitalised when sent over the transport.
+var goMicroTraceParentKeys = []string{"Traceparent", TraceParentHeader}
+
+// HandlerWrapper starts a server span for each incoming go-micro request.
+// The trace is continued from the metadata of the caller or from the traceparent header.
+// Register this wrapper before logging.AuditHandlerWrapper to include the trace in the audit logs.
+//
+// Usage:
+//
+//	service.Server().Init(
+//	    server.WrapHandler(tracing.HandlerWrapper),
+//	    server.WrapHandler(logging.AuditHandlerWrapper),
+//	)
+func HandlerWrapper(fn server.HandlerFunc) server.HandlerFunc {
+	return func(ctx context.Context, req server.Request, rsp interface{}) error {
+		// Start span
+		name := req.Service() + "/" + req.Endpoint()
+		meta, genErr := metadata.GetGoMicroMetadata(ctx)
+		parent, exists := TraceContextFromMetadata(meta)
+		var span *Span
+		if exists {
+			span = NewSpan(name, SpanKindServer, &parent)
+		} else {
+			span = NewSpanFromTraceParent(name, SpanKindServer, goMicroTraceParent(ctx))
+		}
+		span.SetAttribute("rpc.service", req.Service())
+		span.SetAttribute("rpc.method", req.Endpoint())
+
+		// Store span in context
+		if genErr == nil {
+			ctx, _, genErr = metadata.UpdateGoMicroMetadata(ctx, span.Metadata())
+		}
+		if genErr != nil {
+			log.WithField("error", genErr).Warn("Failed to store trace in go-micro metadata")
+		}
+
+		// Call handler
+		err := fn(ctx, req, rsp)
+		span.SetError(err)
+		span.End()
+		return err
+	}
+}
+
+// ClientWrapper starts a client span for each outgoing go-micro call and
+// adds the traceparent header, so services without go-utils can continue the trace.
+//
+// Usage:
+//
+//	service := micro.NewService(micro.WrapClient(tracing.ClientWrapper))
+func ClientWrapper(c client.Client) client.Client {
+	return &tracingClient{Client: c}
+}
+
+type tracingClient struct {
+	client.Client
+}
+
+// Call sends the request within a client span
+func (c *tracingClient) Call(ctx context.Context, req client.Request, rsp interface{}, opts ...client.CallOption) error {
+	ctx, span := StartSpan(ctx, req.Service()+"/"+req.Endpoint(), SpanKindClient)
+	span.SetAttribute("rpc.service", req.Service())
+	span.SetAttribute("rpc.method", req.Endpoint())
+	ctx = microMetadata.Set(ctx, "Traceparent", span.TraceContext().TraceParent())
+
+	err := c.Client.Call(ctx, req, rsp, opts...)
+	span.SetError(err)
+	span.End()
+	return err
+}
+
+// goMicroTraceParent returns the traceparent header from the go-micro metadata
+func goMicroTraceParent(ctx context.Context) string {
+	for _, key := range goMicroTraceParentKeys {
+		if value, exists := microMetadata.Get(ctx, key); exists {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,104 @@
+package tracing
+
+import (
+	"context"
+	goErrors "errors"
+	"testing"
+
+	"github.com/micro/go-micro/v2/client"
+	"github.com/micro/go-micro/v2/codec"
+	microMetadata "github.com/micro/go-micro/v2/metadata"
+	"github.com/micro/go-micro/v2/server"
+	"github.com/skiprco/go-utils/v2/metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testMicroRequest struct{}
+
+func (req testMicroRequest) Service() string           { return "test-service" }
+func (req testMicroRequest) Method() string            { return "Test.Method" }
+func (req testMicroRequest) Endpoint() string          { return "Test.Endpoint" }
+func (req testMicroRequest) ContentType() string       { return "application/json" }
+func (req testMicroRequest) Header() map[string]string { return nil }
+func (req testMicroRequest) Body() interface{}         { return nil }
+func (req testMicroRequest) Read() ([]byte, error)     { return nil, nil }
+func (req testMicroRequest) Codec() codec.Reader       { return nil }
+func (req testMicroRequest) Stream() bool              { return false }
+
+type testMicroClientRequest struct{ testMicroRequest }
+
+func (req testMicroClientRequest) Codec() codec.Writer { return nil }
+
+// testMicroClient records the context of the last call
+type testMicroClient struct {
+	client.Client
+	ctx context.Context
+}
+
+func (c *testMicroClient) Call(ctx context.Context, req client.Request, rsp interface{}, opts ...client.CallOption) error {
+	c.ctx = ctx
+	return goErrors.New("test-error")
+}
+
+func Test_HandlerWrapper_TraceParent_Success(t *testing.T) {
+	exporter := testSetupExporter(t)
+	var handlerCtx context.Context
+	handler := HandlerWrapper(func(ctx context.Context, req server.Request, rsp interface{}) error {
+		handlerCtx = ctx
+		return nil
+	})
+
+	ctx := microMetadata.Set(context.Background(), "Traceparent", testTraceParent)
+	require.Nil(t, handler(ctx, testMicroRequest{}, nil))
+
+	// Assert metadata
+	meta, genErr := metadata.GetGoMicroMetadata(handlerCtx)
+	require.Nil(t, genErr)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", meta.Get(MetadataKeyTraceID))
+	assert.Equal(t, "00f067aa0ba902b7", meta.Get(MetadataKeyParentSpanID))
+
+	// Assert span
+	require.Len(t, exporter.spans, 1)
+	assert.Equal(t, "test-service/Test.Endpoint", exporter.spans[0].Name)
+	assert.Equal(t, SpanKindServer, exporter.spans[0].Kind)
+	assert.Equal(t, meta.Get(MetadataKeySpanID), exporter.spans[0].SpanID)
+}
+
+func Test_HandlerWrapper_Metadata_Success(t *testing.T) {
+	testSetupExporter(t)
+	parentCtx, parent := StartSpan(context.Background(), "test-parent", SpanKindClient)
+	var handlerCtx context.Context
+	handler := HandlerWrapper(func(ctx context.Context, req server.Request, rsp interface{}) error {
+		handlerCtx = ctx
+		return nil
+	})
+	require.Nil(t, handler(parentCtx, testMicroRequest{}, nil))
+
+	meta, genErr := metadata.GetGoMicroMetadata(handlerCtx)
+	require.Nil(t, genErr)
+	assert.Equal(t, parent.TraceID, meta.Get(MetadataKeyTraceID))
+	assert.Equal(t, parent.SpanID, meta.Get(MetadataKeyParentSpanID))
+}
+
+func Test_ClientWrapper_Success(t *testing.T) {
+	exporter := testSetupExporter(t)
+	ctx, parent := StartSpan(context.Background(), "test-parent", SpanKindServer)
+	recorder := &testMicroClient{}
+	err := ClientWrapper(recorder).Call(ctx, testMicroClientRequest{}, nil)
+	assert.EqualError(t, err, "test-error")
+
+	// Assert span
+	require.Len(t, exporter.spans, 1)
+	span := exporter.spans[0]
+	assert.Equal(t, SpanKindClient, span.Kind)
+	assert.Equal(t, parent.SpanID, span.ParentSpanID)
+	assert.Equal(t, SpanStatusError, span.Status)
+
+	// Assert propagated trace
+	traceParent, _ := microMetadata.Get(recorder.ctx, "Traceparent")
+	assert.Equal(t, span.TraceContext().TraceParent(), traceParent)
+	meta, genErr := metadata.GetGoMicroMetadata(recorder.ctx)
+	require.Nil(t, genErr)
+	assert.Equal(t, span.SpanID, meta.Get(MetadataKeySpanID))
+}
@@ -0,0 +1,164 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/skiprco/go-utils/v2/metadata"
+)
+
+// SpanKind describes the relation of the span to remote calls (same values as OpenTelemetry)
+type SpanKind string
+
+const (
+	// SpanKindInternal is an operation within a service
+	SpanKindInternal SpanKind = "internal"
+
+	// SpanKindServer handles an incoming request
+	SpanKindServer SpanKind = "server"
+
+	// SpanKindClient sends an outgoing request
+	SpanKindClient SpanKind = "client"
+)
+
+// SpanStatus is the result of a span (same values as OpenTelemetry)
+type SpanStatus string
+
+const (
+	// SpanStatusUnset is the default status
+	SpanStatusUnset SpanStatus = "unset"
+
+	// SpanStatusOK indicates the operation was explicitly marked as successful
+	SpanStatusOK SpanStatus = "ok"
+
+	// SpanStatusError indicates the operation failed
+	SpanStatusError SpanStatus = "error"
+)
+
+// Span is a single operation within a trace. A span should only be used by a single goroutine.
+type Span struct {
+	TraceID      string
+	SpanID       string
+	ParentSpanID string // Empty for the root span of a trace
+	Sampled      bool
+
+	Name      string
+	Kind      SpanKind
+	StartTime time.Time
+	EndTime   time.Time // Zero value until End is called
+
+	// Attributes contains details of the operation. Use the OpenTelemetry
+	// semantic conventions for the keys where possible (e.g. http.method).
+	Attributes    map[string]string
+	Status        SpanStatus
+	StatusMessage string
+}
+
+// NewSpan starts a span. If parent is nil, a new trace is started.
+func NewSpan(name string, kind SpanKind, parent *TraceContext) *Span {
+	traceContext := NewTraceContext()
+	parentSpanID := ""
+	if parent != nil && parent.IsValid() {
+		traceContext = parent.NewChild()
+		parentSpanID = parent.SpanID
+	}
+	return &Span{
+		TraceID:      traceContext.TraceID,
+		SpanID:       traceContext.SpanID,
+		ParentSpanID: parentSpanID,
+		Sampled:      traceContext.Sampled,
+		Name:         name,
+		Kind:         kind,
+		StartTime:    time.Now(),
+		Attributes:   map[string]string{},
+		Status:       SpanStatusUnset,
+	}
+}
+
+// NewSpanFromTraceParent starts a span as child of the traceparent header.
+// If the header is missing or invalid, a new trace is started.
+func NewSpanFromTraceParent(name string, kind SpanKind, traceParent string) *Span {
+	parent, genErr := ParseTraceParent(traceParent)
+	if genErr != nil {
+		return NewSpan(name, kind, nil)
+	}
+	return NewSpan(name, kind, &parent)
+}
+
+// StartSpan starts a span as child of the span in the go-micro metadata of the context.
+// If the context has no span, a new trace is started.
+// Returns a context with the metadata of the new span, which should be used for calls within the span.
+func StartSpan(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	// Fetch parent
+	meta, genErr := metadata.GetGoMicroMetadata(ctx)
+	if genErr != nil {
+		log.WithField("error", genErr).Warn("Failed to extract metadata from go-micro context for tracing")
+	}
+	var span *Span
+	if parent, exists := TraceContextFromMetadata(meta); exists {
+		span = NewSpan(name, kind, &parent)
+	} else {
+		span = NewSpan(name, kind, nil)
+	}
+
+	// Update context
+	if genErr == nil {
+		ctx, _, _ = metadata.UpdateGoMicroMetadata(ctx, span.Metadata())
+	}
+	return ctx, span
+}
+
+// TraceContext returns the trace context of the span, to be propagated to child spans
+func (s *Span) TraceContext() TraceContext {
+	return TraceContext{TraceID: s.TraceID, SpanID: s.SpanID, Sampled: s.Sampled}
+}
+
+// Metadata returns the trace ID, span ID, trace flags and (if not a root span) parent span ID of the span
+func (s *Span) Metadata() metadata.Metadata {
+	meta := s.TraceContext().ToMetadata()
+	if s.ParentSpanID != "" {
+		meta[MetadataKeyParentSpanID] = s.ParentSpanID
+	}
+	return meta
+}
+
+// SetAttribute sets a single attribute
+func (s *Span) SetAttribute(key string, value string) {
+	s.Attributes[key] = value
+}
+
+// SetStatus sets the status of the span. The message is only kept for SpanStatusError.
+func (s *Span) SetStatus(status SpanStatus, message string) {
+	s.Status = status
+	s.StatusMessage = ""
+	if status == SpanStatusError {
+		s.StatusMessage = message
+	}
+}
+
+// SetError marks the span as failed if err is not nil
+func (s *Span) SetError(err error) {
+	if err != nil {
+		s.SetStatus(SpanStatusError, err.Error())
+	}
+}
+
+// End marks the end of the span and exports it if sampled. Subsequent calls are ignored.
+func (s *Span) End() {
+	if !s.EndTime.IsZero() {
+		return
+	}
+	s.EndTime = time.Now()
+	if s.Sampled {
+		exportSpan(*s)
+	}
+}
+
+// Duration returns the time between start and end. Returns 0 if the span is not ended.
+func (s *Span) Duration() time.Duration {
+	if s.EndTime.IsZero() {
+		return 0
+	}
+	return s.EndTime.Sub(s.StartTime)
+}
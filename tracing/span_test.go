@@ -0,0 +1,99 @@
+package tracing
+
+import (
+	"context"
+	goErrors "errors"
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSpanExporter keeps the exported spans in memory
+type testSpanExporter struct {
+	spans    []Span
+	shutdown bool
+}
+
+func (e *testSpanExporter) ExportSpans(ctx context.Context, spans []Span) error {
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *testSpanExporter) Shutdown(ctx context.Context) error {
+	e.shutdown = true
+	return nil
+}
+
+func testSetupExporter(t *testing.T) *testSpanExporter {
+	exporter := &testSpanExporter{}
+	SetSpanExporter(exporter)
+	t.Cleanup(func() { SetSpanExporter(nil) })
+	return exporter
+}
+
+func Test_NewSpan_Root_Success(t *testing.T) {
+	span := NewSpan("test-span", SpanKindInternal, nil)
+	assert.True(t, span.TraceContext().IsValid())
+	assert.Equal(t, "", span.ParentSpanID)
+	assert.Equal(t, SpanStatusUnset, span.Status)
+	assert.NotContains(t, span.Metadata(), MetadataKeyParentSpanID)
+}
+
+func Test_NewSpanFromTraceParent_Success(t *testing.T) {
+	span := NewSpanFromTraceParent("test-span", SpanKindServer, testTraceParent)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.TraceID)
+	assert.Equal(t, "00f067aa0ba902b7", span.ParentSpanID)
+	assert.Equal(t, "00f067aa0ba902b7", span.Metadata()[MetadataKeyParentSpanID])
+
+	// Invalid header starts a new trace
+	span = NewSpanFromTraceParent("test-span", SpanKindServer, "invalid")
+	assert.True(t, span.TraceContext().IsValid())
+	assert.Equal(t, "", span.ParentSpanID)
+}
+
+func Test_StartSpan_Success(t *testing.T) {
+	// Root span
+	ctx, root := StartSpan(context.Background(), "test-root", SpanKindInternal)
+	meta, genErr := metadata.GetGoMicroMetadata(ctx)
+	require.Nil(t, genErr)
+	assert.Equal(t, root.Metadata(), meta)
+
+	// Child span
+	ctx, child := StartSpan(ctx, "test-child", SpanKindClient)
+	assert.Equal(t, root.TraceID, child.TraceID)
+	assert.Equal(t, root.SpanID, child.ParentSpanID)
+	meta, genErr = metadata.GetGoMicroMetadata(ctx)
+	require.Nil(t, genErr)
+	assert.Equal(t, child.SpanID, meta.Get(MetadataKeySpanID))
+}
+
+func Test_Span_End_Exported(t *testing.T) {
+	exporter := testSetupExporter(t)
+	span := NewSpan("test-span", SpanKindInternal, nil)
+	span.SetAttribute("test-key", "test-value")
+	span.SetError(goErrors.New("test-error"))
+	span.End()
+	span.End() // Ignored
+
+	require.Len(t, exporter.spans, 1)
+	exported := exporter.spans[0]
+	assert.Equal(t, "test-span", exported.Name)
+	assert.Equal(t, "test-value", exported.Attributes["test-key"])
+	assert.Equal(t, SpanStatusError, exported.Status)
+	assert.Equal(t, "test-error", exported.StatusMessage)
+	assert.False(t, exported.EndTime.IsZero())
+	assert.True(t, span.Duration() >= 0)
+
+	require.Nil(t, Shutdown(context.Background()))
+	assert.True(t, exporter.shutdown)
+}
+
+func Test_Span_End_NotSampled(t *testing.T) {
+	exporter := testSetupExporter(t)
+	parent := TraceContext{TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", SpanID: "00f067aa0ba902b7", Sampled: false}
+	span := NewSpan("test-span", SpanKindInternal, &parent)
+	span.End()
+	assert.Len(t, exporter.spans, 0)
+}
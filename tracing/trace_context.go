@@ -0,0 +1,138 @@
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/metadata"
+)
+
+// TraceParentHeader is the name of the HTTP header containing the trace context
+const TraceParentHeader = "traceparent"
+
+// Keys used to store the trace context in metadata.Metadata
+const (
+	MetadataKeyTraceID      = "trace_id"
+	MetadataKeySpanID       = "span_id"
+	MetadataKeyParentSpanID = "parent_span_id"
+	MetadataKeyTraceFlags   = "trace_flags"
+)
+
+// Trace flags as hexadecimal string
+const (
+	traceFlagsSampled    = "01"
+	traceFlagsNotSampled = "00"
+)
+
+// traceParentRegex matches version, trace ID, span ID and flags.
+// Future versions may append fields, which are ignored.
+var traceParentRegex = regexp.MustCompile(`^([0-9a-f]{2})-([0-9a-f]{32})-([0-9a-f]{16})-([0-9a-f]{2})(-.*)?$`)
+
+// TraceContext identifies a span within a trace
+type TraceContext struct {
+	// TraceID is the ID of the whole trace as 32 lowercase hexadecimal characters
+	TraceID string
+
+	// SpanID is the ID of the current span as 16 lowercase hexadecimal characters
+	SpanID string
+
+	// Sampled is true if the spans of the trace should be exported
+	Sampled bool
+}
+
+// NewTraceContext starts a new sampled trace
+func NewTraceContext() TraceContext {
+	return TraceContext{TraceID: randomHex(16), SpanID: randomHex(8), Sampled: true}
+}
+
+// ParseTraceParent parses the value of a traceparent header (e.g. 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01)
+//
+// Raises
+//
+// - 400/invalid_traceparent: Provided header is not a valid traceparent
+func ParseTraceParent(header string) (TraceContext, *errors.GenericError) {
+	matches := traceParentRegex.FindStringSubmatch(strings.TrimSpace(header))
+	if matches == nil || matches[1] == "ff" || (matches[1] == "00" && matches[5] != "") {
+		return TraceContext{}, invalidTraceParentError(header)
+	}
+	flags, _ := hex.DecodeString(matches[4])
+	traceContext := TraceContext{TraceID: matches[2], SpanID: matches[3], Sampled: flags[0]&1 == 1}
+	if !traceContext.IsValid() {
+		return TraceContext{}, invalidTraceParentError(header)
+	}
+	return traceContext, nil
+}
+
+// TraceParent returns the value for the traceparent header
+func (tc TraceContext) TraceParent() string {
+	return "00-" + tc.TraceID + "-" + tc.SpanID + "-" + tc.traceFlags()
+}
+
+// IsValid returns true if trace ID and span ID have the correct length and are not all zeros
+func (tc TraceContext) IsValid() bool {
+	return isValidID(tc.TraceID, 32) && isValidID(tc.SpanID, 16)
+}
+
+// NewChild returns a new span in the same trace
+func (tc TraceContext) NewChild() TraceContext {
+	return TraceContext{TraceID: tc.TraceID, SpanID: randomHex(8), Sampled: tc.Sampled}
+}
+
+// ToMetadata converts the trace context to metadata
+func (tc TraceContext) ToMetadata() metadata.Metadata {
+	return metadata.Metadata{
+		MetadataKeyTraceID:    tc.TraceID,
+		MetadataKeySpanID:     tc.SpanID,
+		MetadataKeyTraceFlags: tc.traceFlags(),
+	}
+}
+
+// TraceContextFromMetadata extracts the trace context from the metadata.
+// Returns false if the metadata doesn't contain a valid trace context.
+func TraceContextFromMetadata(meta metadata.Metadata) (TraceContext, bool) {
+	traceContext := TraceContext{
+		TraceID: meta.Get(MetadataKeyTraceID),
+		SpanID:  meta.Get(MetadataKeySpanID),
+		Sampled: meta.Get(MetadataKeyTraceFlags) != traceFlagsNotSampled,
+	}
+	return traceContext, traceContext.IsValid()
+}
+
+func (tc TraceContext) traceFlags() string {
+	if tc.Sampled {
+		return traceFlagsSampled
+	}
+	return traceFlagsNotSampled
+}
+
+// isValidID checks if the ID contains the expected number of lowercase hexadecimal characters, which are not all zero
+func isValidID(id string, length int) bool {
+	if len(id) != length || strings.Trim(id, "0") == "" {
+		return false
+	}
+	for _, r := range id {
+		if !(r >= '0' && r <= '9') && !(r >= 'a' && r <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// randomHex returns a random non-zero ID with the provided number of bytes as hexadecimal string
+func randomHex(size int) string {
+	id := make([]byte, size)
+	for {
+		_, _ = rand.Read(id) // Only fails if the OS doesn't provide randomness
+		if result := hex.EncodeToString(id); strings.Trim(result, "0") != "" {
+			return result
+		}
+	}
+}
+
+func invalidTraceParentError(header string) *errors.GenericError {
+	meta := map[string]string{"traceparent": header}
+	return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidTraceParent, meta)
+}
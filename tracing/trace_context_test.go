@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/skiprco/go-utils/v2/metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func Test_NewTraceContext_Success(t *testing.T) {
+	traceContext := NewTraceContext()
+	assert.True(t, traceContext.IsValid())
+	assert.True(t, traceContext.Sampled)
+	assert.Len(t, traceContext.TraceID, 32)
+	assert.Len(t, traceContext.SpanID, 16)
+	assert.NotEqual(t, traceContext, NewTraceContext())
+}
+
+func Test_ParseTraceParent_Success(t *testing.T) {
+	traceContext, genErr := ParseTraceParent(testTraceParent)
+	require.Nil(t, genErr)
+	expected := TraceContext{TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", SpanID: "00f067aa0ba902b7", Sampled: true}
+	assert.Equal(t, expected, traceContext)
+	assert.Equal(t, testTraceParent, traceContext.TraceParent())
+
+	// Not sampled
+	traceContext, genErr = ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	require.Nil(t, genErr)
+	assert.False(t, traceContext.Sampled)
+
+	// Future version with additional fields
+	traceContext, genErr = ParseTraceParent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-03-future")
+	require.Nil(t, genErr)
+	assert.True(t, traceContext.Sampled)
+}
+
+func Test_ParseTraceParent_Invalid_Failure(t *testing.T) {
+	tests := []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+	}
+
+	for _, header := range tests {
+		_, genErr := ParseTraceParent(header)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidTraceParent, map[string]string{"traceparent": header})
+	}
+}
+
+func Test_TraceContext_NewChild_Success(t *testing.T) {
+	parent, _ := ParseTraceParent(testTraceParent)
+	child := parent.NewChild()
+	assert.Equal(t, parent.TraceID, child.TraceID)
+	assert.NotEqual(t, parent.SpanID, child.SpanID)
+	assert.True(t, child.IsValid())
+}
+
+func Test_TraceContext_Metadata_Success(t *testing.T) {
+	traceContext, _ := ParseTraceParent(testTraceParent)
+	meta := traceContext.ToMetadata()
+	expected := metadata.Metadata{
+		"trace_id":    "4bf92f3577b34da6a3ce929d0e0e4736",
+		"span_id":     "00f067aa0ba902b7",
+		"trace_flags": "01",
+	}
+	assert.Equal(t, expected, meta)
+
+	result, exists := TraceContextFromMetadata(meta)
+	assert.True(t, exists)
+	assert.Equal(t, traceContext, result)
+
+	_, exists = TraceContextFromMetadata(metadata.Metadata{"trace_id": "invalid"})
+	assert.False(t, exists)
+}
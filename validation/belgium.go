@@ -0,0 +1,58 @@
+package validation
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+var elevenDigitsRegex = regexp.MustCompile(`^\d{11}$`)
+var enterpriseNumberRegex = regexp.MustCompile(`^[01]\d{9}$`)
+
+// ValidateBelgianNationalNumber checks the check digits of the Belgian national register number
+// (rijksregisternummer / numéro de registre national), including BIS numbers.
+// Returns the number in the official format (e.g. 85.07.30-033.28).
+//
+// Raises
+//
+// - 400/invalid_national_number: Provided national number is not valid
+func ValidateBelgianNationalNumber(nationalNumber string) (string, *errors.GenericError) {
+	// Validate format
+	clean := cleanIdentifier(nationalNumber)
+	if !elevenDigitsRegex.MatchString(clean) {
+		return "", invalidIdentifierError(ErrorInvalidNationalNumber, "national_number", nationalNumber)
+	}
+
+	// Validate check digits. People born since 2000 have a 2 prepended before calculating.
+	base := atoi(clean[:9])
+	check := atoi(clean[9:])
+	if 97-base%97 != check && 97-(2000000000+base)%97 != check {
+		return "", invalidIdentifierError(ErrorInvalidNationalNumber, "national_number", nationalNumber)
+	}
+	return clean[0:2] + "." + clean[2:4] + "." + clean[4:6] + "-" + clean[6:9] + "." + clean[9:11], nil
+}
+
+// ValidateBelgianEnterpriseNumber checks the check digits of the Belgian enterprise number (KBO / BCE).
+// Old numbers with 9 digits and numbers prefixed with BE are accepted as well.
+// Returns the number in the official format (e.g. 0403.170.701).
+//
+// Raises
+//
+// - 400/invalid_enterprise_number: Provided enterprise number is not valid
+func ValidateBelgianEnterpriseNumber(enterpriseNumber string) (string, *errors.GenericError) {
+	// Validate format
+	clean := strings.TrimPrefix(cleanIdentifier(enterpriseNumber), "BE")
+	if len(clean) == 9 {
+		clean = "0" + clean
+	}
+	if !enterpriseNumberRegex.MatchString(clean) {
+		return "", invalidIdentifierError(ErrorInvalidEnterpriseNumber, "enterprise_number", enterpriseNumber)
+	}
+
+	// Validate check digits
+	if 97-atoi(clean[:8])%97 != atoi(clean[8:]) {
+		return "", invalidIdentifierError(ErrorInvalidEnterpriseNumber, "enterprise_number", enterpriseNumber)
+	}
+	return clean[0:4] + "." + clean[4:7] + "." + clean[7:10], nil
+}
@@ -0,0 +1,51 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateBelgianNationalNumber_Success(t *testing.T) {
+	tests := map[string]string{
+		"85073003328":     "85.07.30-033.28",
+		"85.07.30-033.28": "85.07.30-033.28",
+		"17 01 01 001 71": "17.01.01-001.71", // Born in 2017
+	}
+
+	for input, expected := range tests {
+		result, genErr := ValidateBelgianNationalNumber(input)
+		require.Nil(t, genErr, input)
+		assert.Equal(t, expected, result)
+	}
+}
+
+func Test_ValidateBelgianNationalNumber_Invalid_Failure(t *testing.T) {
+	for _, input := range []string{"", "85073003327", "8507300332", "8507300332A"} {
+		_, genErr := ValidateBelgianNationalNumber(input)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidNationalNumber, map[string]string{"national_number": input})
+	}
+}
+
+func Test_ValidateBelgianEnterpriseNumber_Success(t *testing.T) {
+	tests := map[string]string{
+		"0403170701":      "0403.170.701",
+		"BE 0403.170.701": "0403.170.701",
+		"403.170.701":     "0403.170.701",
+	}
+
+	for input, expected := range tests {
+		result, genErr := ValidateBelgianEnterpriseNumber(input)
+		require.Nil(t, genErr, input)
+		assert.Equal(t, expected, result)
+	}
+}
+
+func Test_ValidateBelgianEnterpriseNumber_Invalid_Failure(t *testing.T) {
+	for _, input := range []string{"", "0403170702", "2403170701", "04031707"} {
+		_, genErr := ValidateBelgianEnterpriseNumber(input)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidEnterpriseNumber, map[string]string{"enterprise_number": input})
+	}
+}
@@ -0,0 +1,313 @@
+package validation
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// Holiday is a public holiday
+type Holiday struct {
+	// Date is midnight UTC of the day of the holiday
+	Date time.Time
+
+	// Name is the English name of the holiday (e.g. Easter Monday)
+	Name string
+}
+
+// holidayRule calculates the date of a holiday in a year.
+// Returns false if the holiday doesn't exist in the year.
+type holidayRule struct {
+	name string
+	date func(year int, easter time.Time) (time.Time, bool)
+}
+
+// fixedHoliday returns a rule for a holiday on the same date each year
+func fixedHoliday(name string, month time.Month, day int) holidayRule {
+	return holidayRule{name: name, date: func(year int, _ time.Time) (time.Time, bool) {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), true
+	}}
+}
+
+// easterHoliday returns a rule for a holiday relative to Easter Sunday
+func easterHoliday(name string, offsetDays int) holidayRule {
+	return holidayRule{name: name, date: func(_ int, easter time.Time) (time.Time, bool) {
+		return easter.AddDate(0, 0, offsetDays), true
+	}}
+}
+
+// publicHolidayRules contains the national public holidays of the supported countries.
+// Regional holidays (e.g. of the German states or Alsace-Moselle) are not included.
+var publicHolidayRules = map[string][]holidayRule{
+	"BE": {
+		fixedHoliday("New Year's Day", time.January, 1),
+		easterHoliday("Easter Monday", 1),
+		fixedHoliday("Labour Day", time.May, 1),
+		easterHoliday("Ascension Day", 39),
+		easterHoliday("Whit Monday", 50),
+		fixedHoliday("National Day", time.July, 21),
+		fixedHoliday("Assumption Day", time.August, 15),
+		fixedHoliday("All Saints' Day", time.November, 1),
+		fixedHoliday("Armistice Day", time.November, 11),
+		fixedHoliday("Christmas Day", time.December, 25),
+	},
+	"DE": {
+		fixedHoliday("New Year's Day", time.January, 1),
+		easterHoliday("Good Friday", -2),
+		easterHoliday("Easter Monday", 1),
+		fixedHoliday("Labour Day", time.May, 1),
+		easterHoliday("Ascension Day", 39),
+		easterHoliday("Whit Monday", 50),
+		fixedHoliday("German Unity Day", time.October, 3),
+		fixedHoliday("Christmas Day", time.December, 25),
+		fixedHoliday("Second Day of Christmas", time.December, 26),
+	},
+	"FR": {
+		fixedHoliday("New Year's Day", time.January, 1),
+		easterHoliday("Easter Monday", 1),
+		fixedHoliday("Labour Day", time.May, 1),
+		fixedHoliday("Victory in Europe Day", time.May, 8),
+		easterHoliday("Ascension Day", 39),
+		easterHoliday("Whit Monday", 50),
+		fixedHoliday("Bastille Day", time.July, 14),
+		fixedHoliday("Assumption Day", time.August, 15),
+		fixedHoliday("All Saints' Day", time.November, 1),
+		fixedHoliday("Armistice Day", time.November, 11),
+		fixedHoliday("Christmas Day", time.December, 25),
+	},
+	"LU": {
+		fixedHoliday("New Year's Day", time.January, 1),
+		easterHoliday("Easter Monday", 1),
+		fixedHoliday("Labour Day", time.May, 1),
+		{name: "Europe Day", date: func(year int, _ time.Time) (time.Time, bool) {
+			return time.Date(year, time.May, 9, 0, 0, 0, 0, time.UTC), year >= 2019
+		}},
+		easterHoliday("Ascension Day", 39),
+		easterHoliday("Whit Monday", 50),
+		fixedHoliday("National Day", time.June, 23),
+		fixedHoliday("Assumption Day", time.August, 15),
+		fixedHoliday("All Saints' Day", time.November, 1),
+		fixedHoliday("Christmas Day", time.December, 25),
+		fixedHoliday("St. Stephen's Day", time.December, 26),
+	},
+	"NL": {
+		fixedHoliday("New Year's Day", time.January, 1),
+		easterHoliday("Easter Sunday", 0),
+		easterHoliday("Easter Monday", 1),
+		{name: "King's Day", date: dutchKingsDay},
+		fixedHoliday("Liberation Day", time.May, 5),
+		easterHoliday("Ascension Day", 39),
+		easterHoliday("Whit Sunday", 49),
+		easterHoliday("Whit Monday", 50),
+		fixedHoliday("Christmas Day", time.December, 25),
+		fixedHoliday("Second Day of Christmas", time.December, 26),
+	},
+}
+
+// dutchKingsDay is celebrated on April 27th since 2014 (Queen's Day on April 30th before).
+// If the day falls on a Sunday, it is celebrated the day before.
+func dutchKingsDay(year int, _ time.Time) (time.Time, bool) {
+	day := 27
+	if year < 2014 {
+		day = 30
+	}
+	date := time.Date(year, time.April, day, 0, 0, 0, 0, time.UTC)
+	if date.Weekday() == time.Sunday {
+		date = date.AddDate(0, 0, -1)
+	}
+	return date, true
+}
+
+// easterSunday calculates the date of Easter Sunday in the Gregorian calendar,
+// using the anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// PublicHolidays returns the national public holidays of the country in the year, sorted by date.
+// Supported countries are BE, NL, FR, DE and LU. Regional holidays are not included.
+//
+// Raises
+//
+// - 400/unsupported_country: No public holidays available for the provided country
+func PublicHolidays(countryCode string, year int) ([]Holiday, *errors.GenericError) {
+	// Fetch rules
+	rules, exists := publicHolidayRules[strings.ToUpper(countryCode)]
+	if !exists {
+		meta := map[string]string{"country_code": countryCode}
+		return nil, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorUnsupportedCountry, meta)
+	}
+
+	// Calculate dates
+	easter := easterSunday(year)
+	holidays := make([]Holiday, 0, len(rules))
+	for _, rule := range rules {
+		if date, exists := rule.date(year, easter); exists {
+			holidays = append(holidays, Holiday{Date: date, Name: rule.name})
+		}
+	}
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+	return holidays, nil
+}
+
+// IsPublicHoliday checks if the day of the provided time is a public holiday in the country.
+// The day is determined in the location of the provided time. See PublicHolidays for the supported countries.
+//
+// Raises
+//
+// - 400/unsupported_country: No public holidays available for the provided country
+func IsPublicHoliday(countryCode string, t time.Time) (bool, *errors.GenericError) {
+	holidays, genErr := PublicHolidays(countryCode, t.Year())
+	if genErr != nil {
+		return false, genErr
+	}
+	year, month, day := t.Date()
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	for _, holiday := range holidays {
+		if holiday.Date.Equal(date) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// BusinessCalendar defines which days are business days
+type BusinessCalendar struct {
+	// CountryCode is the country of which the public holidays are no business days.
+	// If empty, only weekends are taken into account. See PublicHolidays for the supported countries.
+	CountryCode string
+
+	// Weekend contains the days of the week which are no business days.
+	// Defaults to Saturday and Sunday if nil.
+	Weekend []time.Weekday
+}
+
+// IsBusinessDay checks if the day of the provided time is a business day.
+// The day is determined in the location of the provided time.
+//
+// Raises
+//
+// - 400/unsupported_country: No public holidays available for the country of the calendar
+func (c BusinessCalendar) IsBusinessDay(t time.Time) (bool, *errors.GenericError) {
+	// Check weekend
+	weekend := c.Weekend
+	if weekend == nil {
+		weekend = []time.Weekday{time.Saturday, time.Sunday}
+	}
+	for _, day := range weekend {
+		if t.Weekday() == day {
+			return false, nil
+		}
+	}
+
+	// Check public holidays
+	if c.CountryCode == "" {
+		return true, nil
+	}
+	isHoliday, genErr := IsPublicHoliday(c.CountryCode, t)
+	return !isHoliday, genErr
+}
+
+// AddBusinessDays adds the number of business days to the provided time, keeping the time of day.
+// Negative numbers subtract business days. If days is 0, the time is returned unchanged.
+//
+// Example: Adding 1 business day to Friday 10:00 returns Monday 10:00 (if Monday isn't a holiday)
+//
+// Raises
+//
+// - 400/unsupported_country: No public holidays available for the country of the calendar
+//
+// - 400/invalid_weekend: Weekend of the calendar covers every day of the week
+func (c BusinessCalendar) AddBusinessDays(t time.Time, days int) (time.Time, *errors.GenericError) {
+	// Validate weekend to prevent searching forever
+	if genErr := c.validateWeekend(); genErr != nil {
+		return time.Time{}, genErr
+	}
+
+	// Add days
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+	for offset := 0; days > 0; {
+		offset += step
+		candidate := t.AddDate(0, 0, offset)
+		isBusinessDay, genErr := c.IsBusinessDay(candidate)
+		if genErr != nil {
+			return time.Time{}, genErr
+		}
+		if isBusinessDay {
+			days--
+			if days == 0 {
+				return candidate, nil
+			}
+		}
+	}
+	return t, nil
+}
+
+// validateWeekend checks if at least one day of the week is not part of the weekend
+//
+// Raises
+//
+// - 400/invalid_weekend: Weekend of the calendar covers every day of the week
+func (c BusinessCalendar) validateWeekend() *errors.GenericError {
+	weekend := map[time.Weekday]bool{}
+	for _, day := range c.Weekend {
+		weekend[day] = true
+	}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if !weekend[day] {
+			return nil
+		}
+	}
+	return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidWeekend, nil)
+}
+
+// BusinessDaysBetween counts the business days from the day of start (including)
+// until the day of end (excluding). Returns a negative number if end is before start.
+//
+// Raises
+//
+// - 400/unsupported_country: No public holidays available for the country of the calendar
+func (c BusinessCalendar) BusinessDaysBetween(start time.Time, end time.Time) (int, *errors.GenericError) {
+	// Handle reversed order
+	if end.Before(start) {
+		count, genErr := c.BusinessDaysBetween(end, start)
+		return -count, genErr
+	}
+
+	// Count days
+	count := 0
+	day := StartOfDay(start, nil)
+	last := StartOfDay(end, start.Location())
+	for day.Before(last) {
+		isBusinessDay, genErr := c.IsBusinessDay(day)
+		if genErr != nil {
+			return 0, genErr
+		}
+		if isBusinessDay {
+			count++
+		}
+		day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, day.Location())
+	}
+	return count, nil
+}
@@ -0,0 +1,159 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func Test_easterSunday_Success(t *testing.T) {
+	assert.Equal(t, testDate(2019, time.April, 21), easterSunday(2019))
+	assert.Equal(t, testDate(2020, time.April, 12), easterSunday(2020))
+	assert.Equal(t, testDate(2021, time.April, 4), easterSunday(2021))
+	assert.Equal(t, testDate(2038, time.April, 25), easterSunday(2038))
+}
+
+func Test_PublicHolidays_BE_Success(t *testing.T) {
+	holidays, genErr := PublicHolidays("be", 2020)
+	require.Nil(t, genErr)
+	require.Len(t, holidays, 10)
+	assert.Equal(t, Holiday{Date: testDate(2020, time.January, 1), Name: "New Year's Day"}, holidays[0])
+	assert.Equal(t, Holiday{Date: testDate(2020, time.April, 13), Name: "Easter Monday"}, holidays[1])
+	assert.Equal(t, Holiday{Date: testDate(2020, time.May, 21), Name: "Ascension Day"}, holidays[3])
+	assert.Equal(t, Holiday{Date: testDate(2020, time.June, 1), Name: "Whit Monday"}, holidays[4])
+	assert.Equal(t, Holiday{Date: testDate(2020, time.December, 25), Name: "Christmas Day"}, holidays[9])
+}
+
+func Test_PublicHolidays_AllCountries_Success(t *testing.T) {
+	expectedCounts := map[string]int{"BE": 10, "DE": 9, "FR": 11, "LU": 11, "NL": 10}
+	for countryCode, count := range expectedCounts {
+		holidays, genErr := PublicHolidays(countryCode, 2020)
+		require.Nil(t, genErr)
+		assert.Len(t, holidays, count, countryCode)
+		for i := 1; i < len(holidays); i++ {
+			assert.True(t, holidays[i-1].Date.Before(holidays[i].Date), countryCode)
+		}
+	}
+}
+
+func Test_PublicHolidays_NLKingsDay_Success(t *testing.T) {
+	tests := map[int]time.Time{
+		2013: testDate(2013, time.April, 30),
+		2014: testDate(2014, time.April, 26), // April 27th is a Sunday
+		2020: testDate(2020, time.April, 27),
+	}
+
+	for year, expected := range tests {
+		isHoliday, genErr := IsPublicHoliday("NL", expected)
+		require.Nil(t, genErr)
+		assert.True(t, isHoliday, year)
+	}
+}
+
+func Test_PublicHolidays_LUEuropeDay_Success(t *testing.T) {
+	isHoliday, genErr := IsPublicHoliday("LU", testDate(2018, time.May, 9))
+	require.Nil(t, genErr)
+	assert.False(t, isHoliday)
+
+	isHoliday, genErr = IsPublicHoliday("LU", testDate(2019, time.May, 9))
+	require.Nil(t, genErr)
+	assert.True(t, isHoliday)
+}
+
+func Test_PublicHolidays_UnsupportedCountry_Failure(t *testing.T) {
+	_, genErr := PublicHolidays("US", 2020)
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedCountry, map[string]string{"country_code": "US"})
+}
+
+func Test_IsPublicHoliday_Location_Success(t *testing.T) {
+	brussels := testLocation(t)
+
+	// July 20th 23:30 UTC is National Day in Brussels
+	input := time.Date(2020, time.July, 20, 23, 30, 0, 0, time.UTC)
+	isHoliday, genErr := IsPublicHoliday("BE", input)
+	require.Nil(t, genErr)
+	assert.False(t, isHoliday)
+
+	isHoliday, genErr = IsPublicHoliday("BE", input.In(brussels))
+	require.Nil(t, genErr)
+	assert.True(t, isHoliday)
+}
+
+func Test_BusinessCalendar_IsBusinessDay_Success(t *testing.T) {
+	calendar := BusinessCalendar{CountryCode: "FR"}
+	tests := map[time.Time]bool{
+		testDate(2020, time.July, 13): true,  // Monday
+		testDate(2020, time.July, 14): false, // Bastille Day
+		testDate(2020, time.July, 18): false, // Saturday
+	}
+
+	for date, expected := range tests {
+		isBusinessDay, genErr := calendar.IsBusinessDay(date)
+		require.Nil(t, genErr)
+		assert.Equal(t, expected, isBusinessDay, date)
+	}
+
+	// Custom weekend without holidays
+	calendar = BusinessCalendar{Weekend: []time.Weekday{time.Friday}}
+	isBusinessDay, genErr := calendar.IsBusinessDay(testDate(2020, time.July, 18))
+	require.Nil(t, genErr)
+	assert.True(t, isBusinessDay)
+}
+
+func Test_BusinessCalendar_AddBusinessDays_Success(t *testing.T) {
+	calendar := BusinessCalendar{CountryCode: "BE"}
+	friday := time.Date(2020, time.April, 10, 10, 0, 0, 0, time.UTC) // Followed by Easter Monday
+
+	result, genErr := calendar.AddBusinessDays(friday, 1)
+	require.Nil(t, genErr)
+	assert.Equal(t, time.Date(2020, time.April, 14, 10, 0, 0, 0, time.UTC), result)
+
+	result, genErr = calendar.AddBusinessDays(result, -1)
+	require.Nil(t, genErr)
+	assert.Equal(t, friday, result)
+
+	result, genErr = calendar.AddBusinessDays(friday, 0)
+	require.Nil(t, genErr)
+	assert.Equal(t, friday, result)
+}
+
+func Test_BusinessCalendar_BusinessDaysBetween_Success(t *testing.T) {
+	calendar := BusinessCalendar{CountryCode: "DE"}
+	start := testDate(2020, time.December, 21) // Monday
+	end := testDate(2021, time.January, 4)     // Monday
+
+	// Excluded: 2 weekends, Christmas (Friday), New Year (Friday)
+	count, genErr := calendar.BusinessDaysBetween(start, end)
+	require.Nil(t, genErr)
+	assert.Equal(t, 8, count)
+
+	count, genErr = calendar.BusinessDaysBetween(end, start)
+	require.Nil(t, genErr)
+	assert.Equal(t, -8, count)
+}
+
+func Test_BusinessCalendar_UnsupportedCountry_Failure(t *testing.T) {
+	calendar := BusinessCalendar{CountryCode: "XX"}
+	_, genErr := calendar.AddBusinessDays(testDate(2020, time.July, 13), 1)
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedCountry, map[string]string{"country_code": "XX"})
+}
+
+func Test_BusinessCalendar_AddBusinessDays_InvalidWeekend_Failure(t *testing.T) {
+	everyDay := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday}
+	calendar := BusinessCalendar{Weekend: everyDay}
+	_, genErr := calendar.AddBusinessDays(testDate(2020, time.July, 13), 1)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidWeekend, nil)
+
+	// Only Sunday is a business day
+	calendar = BusinessCalendar{Weekend: everyDay[:6]}
+	result, genErr := calendar.AddBusinessDays(testDate(2020, time.July, 13), 1)
+	require.Nil(t, genErr)
+	assert.Equal(t, testDate(2020, time.July, 19), result)
+}
@@ -0,0 +1,58 @@
+package validation
+
+import "strings"
+
+// mod97 converts letters to numbers (A = 10, B = 11, ...) and returns the remainder of the division by 97.
+// Used by IBAN (ISO 7064 MOD 97-10) and several national identifiers. Input must only contain digits and upper case letters.
+func mod97(input string) int {
+	remainder := 0
+	for _, r := range input {
+		if r >= 'A' && r <= 'Z' {
+			remainder = (remainder*100 + int(r-'A'+10)) % 97
+		} else {
+			remainder = (remainder*10 + int(r-'0')) % 97
+		}
+	}
+	return remainder
+}
+
+// luhn checks if the digits pass the Luhn (mod 10) algorithm
+func luhn(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// weightedSum returns the sum of the digits multiplied by the weights
+func weightedSum(digits string, weights []int) int {
+	sum := 0
+	for i, weight := range weights {
+		sum += int(digits[i]-'0') * weight
+	}
+	return sum
+}
+
+// atoi converts a string of digits to an int. Input must only contain digits.
+func atoi(digits string) int {
+	result := 0
+	for _, r := range digits {
+		result = result*10 + int(r-'0')
+	}
+	return result
+}
+
+// cleanIdentifier converts the input to upper case and removes spaces, dots, dashes and slashes
+func cleanIdentifier(input string) string {
+	return strings.NewReplacer(" ", "", ".", "", "-", "", "/", "").Replace(strings.ToUpper(strings.TrimSpace(input)))
+}
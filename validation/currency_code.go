@@ -0,0 +1,15 @@
+package validation
+
+import "github.com/skiprco/go-utils/v2/money"
+
+// ValidateCurrencyCode checks if a currency code is a valid ISO 4217 code (e.g. EUR).
+// An empty code is considered valid as well.
+func ValidateCurrencyCode(code string) bool {
+	// Empty code is valid
+	if code == "" {
+		return true
+	}
+
+	// Validate code
+	return money.ValidateCurrencyCode(code) == nil
+}
@@ -0,0 +1,22 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ValidateCurrencyCode_Success(t *testing.T) {
+	result := ValidateCurrencyCode("EUR")
+	assert.True(t, result)
+}
+
+func Test_ValidateCurrencyCode_Empty_Success(t *testing.T) {
+	result := ValidateCurrencyCode("")
+	assert.True(t, result)
+}
+
+func Test_ValidateCurrencyCode_Failure(t *testing.T) {
+	result := ValidateCurrencyCode("XXX")
+	assert.False(t, result)
+}
@@ -0,0 +1,218 @@
+package validation
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/converters"
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// DrivingLicenceCategory is a category of the EU driving licence (Directive 2006/126/EC)
+type DrivingLicenceCategory string
+
+// Categories for mopeds (AM), motorcycles (A), cars (B), trucks (C) and buses (D).
+// Suffix E indicates a trailer is allowed.
+const (
+	DrivingLicenceCategoryAM  DrivingLicenceCategory = "AM"
+	DrivingLicenceCategoryA1  DrivingLicenceCategory = "A1"
+	DrivingLicenceCategoryA2  DrivingLicenceCategory = "A2"
+	DrivingLicenceCategoryA   DrivingLicenceCategory = "A"
+	DrivingLicenceCategoryB1  DrivingLicenceCategory = "B1"
+	DrivingLicenceCategoryB   DrivingLicenceCategory = "B"
+	DrivingLicenceCategoryBE  DrivingLicenceCategory = "BE"
+	DrivingLicenceCategoryC1  DrivingLicenceCategory = "C1"
+	DrivingLicenceCategoryC1E DrivingLicenceCategory = "C1E"
+	DrivingLicenceCategoryC   DrivingLicenceCategory = "C"
+	DrivingLicenceCategoryCE  DrivingLicenceCategory = "CE"
+	DrivingLicenceCategoryD1  DrivingLicenceCategory = "D1"
+	DrivingLicenceCategoryD1E DrivingLicenceCategory = "D1E"
+	DrivingLicenceCategoryD   DrivingLicenceCategory = "D"
+	DrivingLicenceCategoryDE  DrivingLicenceCategory = "DE"
+)
+
+// drivingLicenceEquivalences contains the categories which are implied by holding a category.
+// Holding CE implies DE only if D is held as well, which is handled in CoveredBy.
+var drivingLicenceEquivalences = map[DrivingLicenceCategory][]DrivingLicenceCategory{
+	DrivingLicenceCategoryAM:  {},
+	DrivingLicenceCategoryA1:  {DrivingLicenceCategoryAM},
+	DrivingLicenceCategoryA2:  {DrivingLicenceCategoryA1, DrivingLicenceCategoryAM},
+	DrivingLicenceCategoryA:   {DrivingLicenceCategoryA2, DrivingLicenceCategoryA1, DrivingLicenceCategoryAM},
+	DrivingLicenceCategoryB1:  {DrivingLicenceCategoryAM},
+	DrivingLicenceCategoryB:   {DrivingLicenceCategoryB1, DrivingLicenceCategoryAM},
+	DrivingLicenceCategoryBE:  {},
+	DrivingLicenceCategoryC1:  {},
+	DrivingLicenceCategoryC1E: {DrivingLicenceCategoryBE},
+	DrivingLicenceCategoryC:   {DrivingLicenceCategoryC1},
+	DrivingLicenceCategoryCE:  {DrivingLicenceCategoryC1E, DrivingLicenceCategoryBE},
+	DrivingLicenceCategoryD1:  {},
+	DrivingLicenceCategoryD1E: {DrivingLicenceCategoryBE},
+	DrivingLicenceCategoryD:   {DrivingLicenceCategoryD1},
+	DrivingLicenceCategoryDE:  {DrivingLicenceCategoryD1E, DrivingLicenceCategoryBE},
+}
+
+// ParseDrivingLicenceCategory converts the input to a driving licence category,
+// ignoring casing, spaces and plus signs (e.g. "c1+e" becomes C1E).
+//
+// Raises
+//
+// - 400/invalid_driving_licence_category: Provided category is not an EU driving licence category
+func ParseDrivingLicenceCategory(category string) (DrivingLicenceCategory, *errors.GenericError) {
+	clean := DrivingLicenceCategory(strings.NewReplacer(" ", "", "+", "").Replace(strings.ToUpper(category)))
+	if _, exists := drivingLicenceEquivalences[clean]; !exists {
+		meta := map[string]string{"category": category}
+		return "", errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidDrivingLicenceCategory, meta)
+	}
+	return clean, nil
+}
+
+// CoveredBy returns true if holding the categories allows to drive vehicles of this category
+func (required DrivingLicenceCategory) CoveredBy(held []DrivingLicenceCategory) bool {
+	holdsD := false
+	for _, category := range held {
+		holdsD = holdsD || category == DrivingLicenceCategoryD
+	}
+	for _, category := range held {
+		if category == required || (category == DrivingLicenceCategoryCE && required == DrivingLicenceCategoryDE && holdsD) {
+			return true
+		}
+		for _, equivalent := range drivingLicenceEquivalences[category] {
+			if equivalent == required {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DrivingLicence contains the data of a driving licence relevant for a booking
+type DrivingLicence struct {
+	// CountryCode is the ISO 3166-1 alpha-2 code of the issuing country
+	CountryCode string
+
+	// Categories contains the categories on the licence
+	Categories []DrivingLicenceCategory
+
+	// IssueDate is the date on which the licence was first issued.
+	// Required if a minimum number of licence years is set.
+	IssueDate time.Time
+
+	// ExpiryDate is the date until which the licence is valid (including).
+	// Zero value if the licence doesn't expire.
+	ExpiryDate time.Time
+
+	// BirthDate is the birth date of the holder.
+	// Required if a minimum driver age is set.
+	BirthDate time.Time
+}
+
+// DrivingLicenceRequirements contains the rules a driving licence should comply with
+type DrivingLicenceRequirements struct {
+	// Category is the required category to drive the vehicle
+	Category DrivingLicenceCategory
+
+	// MinimumLicenceYears is the number of years the licence should be held at the start of the booking
+	MinimumLicenceYears int
+
+	// MinimumDriverAge is the age the driver should have at the start of the booking
+	MinimumDriverAge int
+
+	// AllowedCountryCodes contains the countries which are allowed to issue the licence.
+	// All countries are allowed if empty.
+	AllowedCountryCodes []string
+}
+
+// ValidateDrivingLicence checks if the driving licence complies with the requirements for the booking.
+// Licence seniority and driver age are calculated in full years on the day the booking starts.
+// The licence should be valid for the whole booking.
+//
+// Raises
+//
+// - 400/invalid_country_code: Provided country code of the licence is invalid
+//
+// - 400/driving_licence_country_not_allowed: Licence is issued by a country which is not allowed
+//
+// - 400/driving_licence_category_missing: Licence doesn't cover the required category
+//
+// - 400/driving_licence_expired: Licence expires before the end of the booking
+//
+// - 400/driving_licence_issue_date_missing: Minimum licence years are required, but the licence has no issue date
+//
+// - 400/driving_licence_too_recent: Licence isn't held for the minimum number of years
+//
+// - 400/birth_date_missing: Minimum driver age is required, but the licence has no birth date
+//
+// - 400/driver_too_young: Driver doesn't have the minimum age
+func ValidateDrivingLicence(licence DrivingLicence, requirements DrivingLicenceRequirements, booking TimeRange) *errors.GenericError {
+	// Validate country
+	countryCode := strings.ToUpper(licence.CountryCode)
+	if _, genErr := converters.CountryCodeToCountryName(countryCode); genErr != nil {
+		meta := map[string]string{"country_code": licence.CountryCode}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidCountryCode, meta)
+	}
+	if len(requirements.AllowedCountryCodes) > 0 && !containsString(requirements.AllowedCountryCodes, countryCode) {
+		meta := map[string]string{"country_code": countryCode}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorDrivingLicenceCountryNotAllowed, meta)
+	}
+
+	// Validate category
+	if requirements.Category != "" && !requirements.Category.CoveredBy(licence.Categories) {
+		meta := map[string]string{"category": string(requirements.Category)}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorDrivingLicenceCategoryMissing, meta)
+	}
+
+	// Validate expiry
+	if !licence.ExpiryDate.IsZero() && completedDays(booking.End, licence.ExpiryDate) < 0 {
+		meta := map[string]string{"expiry_date": licence.ExpiryDate.Format("2006-01-02")}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorDrivingLicenceExpired, meta)
+	}
+
+	// Validate seniority
+	if requirements.MinimumLicenceYears > 0 && licence.IssueDate.IsZero() {
+		meta := map[string]string{"minimum_licence_years": strconv.Itoa(requirements.MinimumLicenceYears)}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorDrivingLicenceIssueDateMissing, meta)
+	}
+	if completedYears(licence.IssueDate, booking.Start) < requirements.MinimumLicenceYears {
+		meta := map[string]string{
+			"issue_date":            licence.IssueDate.Format("2006-01-02"),
+			"minimum_licence_years": strconv.Itoa(requirements.MinimumLicenceYears),
+		}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorDrivingLicenceTooRecent, meta)
+	}
+
+	// Validate age
+	if requirements.MinimumDriverAge > 0 && licence.BirthDate.IsZero() {
+		meta := map[string]string{"minimum_driver_age": strconv.Itoa(requirements.MinimumDriverAge)}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorBirthDateMissing, meta)
+	}
+	if completedYears(licence.BirthDate, booking.Start) < requirements.MinimumDriverAge {
+		meta := map[string]string{"minimum_driver_age": strconv.Itoa(requirements.MinimumDriverAge)}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorDriverTooYoung, meta)
+	}
+	return nil
+}
+
+// completedYears returns the number of full years between the dates of from and to.
+// The dates are taken in the location of each time, so a birth date stored as
+// UTC midnight is compared with the local date of the booking.
+// Returns a negative number if to is before from.
+func completedYears(from time.Time, to time.Time) int {
+	fromYear, fromMonth, fromDay := from.Date()
+	toYear, toMonth, toDay := to.Date()
+	years := toYear - fromYear
+	if toMonth < fromMonth || (toMonth == fromMonth && toDay < fromDay) {
+		years--
+	}
+	return years
+}
+
+// completedDays returns the number of days between the dates of from and to,
+// taking the date of each time in its own location.
+func completedDays(from time.Time, to time.Time) int {
+	fromYear, fromMonth, fromDay := from.Date()
+	toYear, toMonth, toDay := to.Date()
+	fromDate := time.Date(fromYear, fromMonth, fromDay, 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(toYear, toMonth, toDay, 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
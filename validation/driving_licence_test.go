@@ -0,0 +1,133 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDrivingLicence() DrivingLicence {
+	return DrivingLicence{
+		CountryCode: "BE",
+		Categories:  []DrivingLicenceCategory{DrivingLicenceCategoryB, DrivingLicenceCategoryA2},
+		IssueDate:   time.Date(2018, 6, 15, 0, 0, 0, 0, time.UTC),
+		ExpiryDate:  time.Date(2028, 6, 15, 0, 0, 0, 0, time.UTC),
+		BirthDate:   time.Date(2000, 6, 15, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func testBookingRange(start time.Time) TimeRange {
+	return TimeRange{Start: start, End: start.Add(48 * time.Hour)}
+}
+
+func Test_ParseDrivingLicenceCategory_Success(t *testing.T) {
+	for input, expected := range map[string]DrivingLicenceCategory{"b": "B", "C1+E": "C1E", " d e": "DE", "AM": "AM"} {
+		result, genErr := ParseDrivingLicenceCategory(input)
+		require.Nil(t, genErr)
+		assert.Equal(t, expected, result)
+	}
+}
+
+func Test_ParseDrivingLicenceCategory_Invalid_Failure(t *testing.T) {
+	_, genErr := ParseDrivingLicenceCategory("F")
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidDrivingLicenceCategory, map[string]string{"category": "F"})
+}
+
+func Test_DrivingLicenceCategory_CoveredBy(t *testing.T) {
+	held := []DrivingLicenceCategory{DrivingLicenceCategoryA2, DrivingLicenceCategoryB}
+	assert.True(t, DrivingLicenceCategoryB.CoveredBy(held))
+	assert.True(t, DrivingLicenceCategoryA1.CoveredBy(held))
+	assert.True(t, DrivingLicenceCategoryAM.CoveredBy(held))
+	assert.False(t, DrivingLicenceCategoryA.CoveredBy(held))
+	assert.False(t, DrivingLicenceCategoryBE.CoveredBy(held))
+
+	// CE covers DE only if D is held
+	assert.False(t, DrivingLicenceCategoryDE.CoveredBy([]DrivingLicenceCategory{DrivingLicenceCategoryCE}))
+	assert.True(t, DrivingLicenceCategoryDE.CoveredBy([]DrivingLicenceCategory{DrivingLicenceCategoryCE, DrivingLicenceCategoryD}))
+}
+
+func Test_ValidateDrivingLicence_Success(t *testing.T) {
+	requirements := DrivingLicenceRequirements{
+		Category:            DrivingLicenceCategoryB,
+		MinimumLicenceYears: 3,
+		MinimumDriverAge:    21,
+		AllowedCountryCodes: []string{"BE", "NL"},
+	}
+
+	// Licence held for exactly 3 years on the day of the booking
+	booking := testBookingRange(time.Date(2021, 6, 15, 0, 30, 0, 0, testLocation(t)))
+	assert.Nil(t, ValidateDrivingLicence(testDrivingLicence(), requirements, booking))
+}
+
+func Test_ValidateDrivingLicence_Failure(t *testing.T) {
+	requirements := DrivingLicenceRequirements{
+		Category:            DrivingLicenceCategoryB,
+		MinimumLicenceYears: 3,
+		MinimumDriverAge:    21,
+	}
+	booking := testBookingRange(time.Date(2022, 1, 10, 10, 0, 0, 0, testLocation(t)))
+
+	// Invalid country
+	licence := testDrivingLicence()
+	licence.CountryCode = "XX"
+	genErr := ValidateDrivingLicence(licence, requirements, booking)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidCountryCode, map[string]string{"country_code": "XX"})
+
+	// Country not allowed
+	withCountries := requirements
+	withCountries.AllowedCountryCodes = []string{"NL"}
+	genErr = ValidateDrivingLicence(testDrivingLicence(), withCountries, booking)
+	errors.AssertGenericError(t, genErr, 400, ErrorDrivingLicenceCountryNotAllowed, map[string]string{"country_code": "BE"})
+
+	// Category missing
+	withCategory := requirements
+	withCategory.Category = DrivingLicenceCategoryBE
+	genErr = ValidateDrivingLicence(testDrivingLicence(), withCategory, booking)
+	errors.AssertGenericError(t, genErr, 400, ErrorDrivingLicenceCategoryMissing, map[string]string{"category": "BE"})
+
+	// Expired during booking
+	lateBooking := testBookingRange(time.Date(2028, 6, 15, 10, 0, 0, 0, testLocation(t)))
+	genErr = ValidateDrivingLicence(testDrivingLicence(), requirements, lateBooking)
+	errors.AssertGenericError(t, genErr, 400, ErrorDrivingLicenceExpired, map[string]string{"expiry_date": "2028-06-15"})
+
+	// Too recent
+	withSeniority := requirements
+	withSeniority.MinimumLicenceYears = 4
+	genErr = ValidateDrivingLicence(testDrivingLicence(), withSeniority, booking)
+	expectedMeta := map[string]string{"issue_date": "2018-06-15", "minimum_licence_years": "4"}
+	errors.AssertGenericError(t, genErr, 400, ErrorDrivingLicenceTooRecent, expectedMeta)
+
+	// Too young
+	withAge := requirements
+	withAge.MinimumDriverAge = 25
+	genErr = ValidateDrivingLicence(testDrivingLicence(), withAge, booking)
+	errors.AssertGenericError(t, genErr, 400, ErrorDriverTooYoung, map[string]string{"minimum_driver_age": "25"})
+}
+
+func Test_ValidateDrivingLicence_MissingDates_Failure(t *testing.T) {
+	requirements := DrivingLicenceRequirements{MinimumLicenceYears: 3, MinimumDriverAge: 21}
+	booking := testBookingRange(time.Date(2022, 1, 10, 10, 0, 0, 0, testLocation(t)))
+
+	// Issue date missing
+	licence := testDrivingLicence()
+	licence.IssueDate = time.Time{}
+	genErr := ValidateDrivingLicence(licence, requirements, booking)
+	errors.AssertGenericError(t, genErr, 400, ErrorDrivingLicenceIssueDateMissing, map[string]string{"minimum_licence_years": "3"})
+
+	// Birth date missing
+	licence = testDrivingLicence()
+	licence.BirthDate = time.Time{}
+	genErr = ValidateDrivingLicence(licence, requirements, booking)
+	errors.AssertGenericError(t, genErr, 400, ErrorBirthDateMissing, map[string]string{"minimum_driver_age": "21"})
+}
+
+func Test_ValidateDrivingLicence_MissingDates_NotRequired_Success(t *testing.T) {
+	licence := testDrivingLicence()
+	licence.IssueDate = time.Time{}
+	licence.BirthDate = time.Time{}
+	booking := testBookingRange(time.Date(2022, 1, 10, 10, 0, 0, 0, testLocation(t)))
+	assert.Nil(t, ValidateDrivingLicence(licence, DrivingLicenceRequirements{Category: DrivingLicenceCategoryB}, booking))
+}
@@ -0,0 +1,35 @@
+package validation
+
+import (
+	"net/mail"
+	"strings"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// maxEmailLength is the maximum length of an email address according to RFC 5321
+const maxEmailLength = 254
+
+// ValidateEmail checks if the email address is valid according to RFC 5322 and has a domain with a dot.
+// Display names (e.g. John <john@example.com>) are not accepted.
+// Returns the email address trimmed and with the domain in lower case.
+//
+// Raises
+//
+// - 400/invalid_email: Provided email address is not valid
+func ValidateEmail(email string) (string, *errors.GenericError) {
+	// Parse address
+	clean := strings.TrimSpace(email)
+	address, err := mail.ParseAddress(clean)
+	if err != nil || address.Name != "" || address.Address != clean || len(clean) > maxEmailLength {
+		return "", invalidIdentifierError(ErrorInvalidEmail, "email", email)
+	}
+
+	// Validate domain
+	at := strings.LastIndex(clean, "@")
+	domain := strings.ToLower(clean[at+1:])
+	if !strings.Contains(domain, ".") || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") {
+		return "", invalidIdentifierError(ErrorInvalidEmail, "email", email)
+	}
+	return clean[:at+1] + domain, nil
+}
@@ -0,0 +1,31 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateEmail_Success(t *testing.T) {
+	tests := map[string]string{
+		"john@example.com":            "john@example.com",
+		" John.Doe+skipr@Example.BE ": "John.Doe+skipr@example.be",
+		"a@b.co":                      "a@b.co",
+	}
+
+	for input, expected := range tests {
+		result, genErr := ValidateEmail(input)
+		require.Nil(t, genErr, input)
+		assert.Equal(t, expected, result)
+	}
+}
+
+func Test_ValidateEmail_Invalid_Failure(t *testing.T) {
+	inputs := []string{"", "john", "john@", "@example.com", "john@localhost", "John <john@example.com>", "john@example.", "john doe@example.com"}
+	for _, input := range inputs {
+		_, genErr := ValidateEmail(input)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidEmail, map[string]string{"email": input})
+	}
+}
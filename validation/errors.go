@@ -19,3 +19,100 @@ const ErrorNotAMobilePhoneNumber = "not_a_mobile_phone_number"
 // ErrorEndTimeBeforeStartTime indicates the provided end time is before
 // the provided start time. End time should be equal to or after start time.
 const ErrorEndTimeBeforeStartTime = "end_time_before_start_time"
+
+// ErrorInvalidTimeRangeBounds indicates the provided bounds of a time range are not supported.
+const ErrorInvalidTimeRangeBounds = "invalid_time_range_bounds"
+
+// ErrorTimeRangesNotConnected indicates the time ranges can't be merged,
+// since they don't overlap or touch each other.
+const ErrorTimeRangesNotConnected = "time_ranges_not_connected"
+
+// ErrorInvalidTimeOfDay indicates the provided time of day is not in HH:MM format.
+const ErrorInvalidTimeOfDay = "invalid_time_of_day"
+
+// ErrorUnsupportedCountry indicates the provided country is not supported (e.g. no public holidays available).
+const ErrorUnsupportedCountry = "unsupported_country"
+
+// ErrorInvalidWeekend indicates the weekend of a business calendar covers every day of the week.
+const ErrorInvalidWeekend = "invalid_weekend"
+
+// ErrorValidationFailed indicates at least one validation rule failed.
+const ErrorValidationFailed = "validation_failed"
+
+// ErrorInputIsNotAStruct indicates a struct or pointer to a struct was expected as input.
+const ErrorInputIsNotAStruct = "input_is_not_a_struct"
+
+// ErrorUnknownValidationRule indicates the requested validation rule is not registered.
+const ErrorUnknownValidationRule = "unknown_validation_rule"
+
+// ErrorPhoneNumberTypeNotAllowed indicates the type of the provided phone number is not allowed.
+const ErrorPhoneNumberTypeNotAllowed = "phone_number_type_not_allowed"
+
+// ErrorPhoneNumberRegionNotAllowed indicates the region of the provided phone number is not allowed.
+const ErrorPhoneNumberRegionNotAllowed = "phone_number_region_not_allowed"
+
+// ErrorInvalidIBAN indicates the provided IBAN is not valid.
+const ErrorInvalidIBAN = "invalid_iban"
+
+// ErrorInvalidBIC indicates the provided BIC is not valid.
+const ErrorInvalidBIC = "invalid_bic"
+
+// ErrorInvalidVATNumber indicates the provided VAT number is not valid.
+const ErrorInvalidVATNumber = "invalid_vat_number"
+
+// ErrorInvalidNationalNumber indicates the provided national register number is not valid.
+const ErrorInvalidNationalNumber = "invalid_national_number"
+
+// ErrorInvalidEnterpriseNumber indicates the provided enterprise number is not valid.
+const ErrorInvalidEnterpriseNumber = "invalid_enterprise_number"
+
+// ErrorInvalidSIREN indicates the provided SIREN is not valid.
+const ErrorInvalidSIREN = "invalid_siren"
+
+// ErrorInvalidSIRET indicates the provided SIRET is not valid.
+const ErrorInvalidSIRET = "invalid_siret"
+
+// ErrorInvalidEmail indicates the provided email address is not valid.
+const ErrorInvalidEmail = "invalid_email"
+
+// ErrorInvalidLicencePlate indicates the provided licence plate is not valid for the country.
+const ErrorInvalidLicencePlate = "invalid_licence_plate"
+
+// ErrorInvalidVIN indicates the provided vehicle identification number doesn't have a valid format.
+const ErrorInvalidVIN = "invalid_vin"
+
+// ErrorInvalidVINCheckDigit indicates the check digit of the provided vehicle identification number is invalid.
+const ErrorInvalidVINCheckDigit = "invalid_vin_check_digit"
+
+// ErrorInvalidDrivingLicenceCategory indicates the provided category is not an EU driving licence category.
+const ErrorInvalidDrivingLicenceCategory = "invalid_driving_licence_category"
+
+// ErrorDrivingLicenceCountryNotAllowed indicates the driving licence is issued by a country which is not allowed.
+const ErrorDrivingLicenceCountryNotAllowed = "driving_licence_country_not_allowed"
+
+// ErrorDrivingLicenceCategoryMissing indicates the driving licence doesn't cover the required category.
+const ErrorDrivingLicenceCategoryMissing = "driving_licence_category_missing"
+
+// ErrorDrivingLicenceExpired indicates the driving licence expires before the end of the booking.
+const ErrorDrivingLicenceExpired = "driving_licence_expired"
+
+// ErrorDrivingLicenceTooRecent indicates the driving licence isn't held for the minimum number of years.
+const ErrorDrivingLicenceTooRecent = "driving_licence_too_recent"
+
+// ErrorDriverTooYoung indicates the driver doesn't have the minimum age.
+const ErrorDriverTooYoung = "driver_too_young"
+
+// ErrorDrivingLicenceIssueDateMissing indicates the issue date of the driving licence is required, but not provided.
+const ErrorDrivingLicenceIssueDateMissing = "driving_licence_issue_date_missing"
+
+// ErrorBirthDateMissing indicates the birth date of the driver is required, but not provided.
+const ErrorBirthDateMissing = "birth_date_missing"
+
+// ErrorInvalidCoordinate indicates the latitude or longitude of the provided coordinate is out of range.
+const ErrorInvalidCoordinate = "invalid_coordinate"
+
+// ErrorCoordinateOutsideGeofence indicates the provided coordinate is not inside any of the geofences.
+const ErrorCoordinateOutsideGeofence = "coordinate_outside_geofence"
+
+// ErrorCoordinateCountryMismatch indicates the provided coordinate is not located in the expected country.
+const ErrorCoordinateCountryMismatch = "coordinate_country_mismatch"
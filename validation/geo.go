@@ -0,0 +1,88 @@
+package validation
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/skiprco/go-utils/v2/converters"
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// ValidateCoordinate checks if the latitude is between -90 and 90 and the longitude between -180 and 180.
+// Null island (0, 0) is rejected as well, since it's usually caused by missing data.
+//
+// Raises
+//
+// - 400/invalid_coordinate: Provided latitude or longitude is out of range
+func ValidateCoordinate(latitude float64, longitude float64) (converters.Coordinate, *errors.GenericError) {
+	coordinate := converters.Coordinate{Latitude: latitude, Longitude: longitude}
+	if math.IsNaN(latitude) || math.IsNaN(longitude) || !coordinate.IsValid() || (latitude == 0 && longitude == 0) {
+		return converters.Coordinate{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidCoordinate, coordinateMeta(coordinate))
+	}
+	return coordinate, nil
+}
+
+// ValidateCoordinateInGeofences checks if the coordinate is inside at least one of the geofences
+//
+// Raises
+//
+// - 400/coordinate_outside_geofence: Provided coordinate is not inside any of the geofences
+func ValidateCoordinateInGeofences(coordinate converters.Coordinate, geofences []converters.Geofence) *errors.GenericError {
+	for _, geofence := range geofences {
+		if geofence.Contains(coordinate) {
+			return nil
+		}
+	}
+	return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorCoordinateOutsideGeofence, coordinateMeta(coordinate))
+}
+
+// ValidateCoordinateCountry checks if the coordinate is located in the country (e.g. to cross-check the country of an address).
+// See converters.CountryBoundaryCodes for the supported countries.
+//
+// Raises
+//
+// - 400/invalid_coordinate: Provided coordinate is out of range
+//
+// - 400/unsupported_country: Provided country has no boundaries available
+//
+// - 400/coordinate_country_mismatch: Provided coordinate is not located in the country
+//
+// - 500/country_boundaries_not_loaded: Loaded country boundaries don't contain any country (see converters.LoadCountryBoundaries)
+func ValidateCoordinateCountry(coordinate converters.Coordinate, countryCode string) *errors.GenericError {
+	// Resolve country of coordinate
+	actual, genErr := converters.CoordinateToCountryCode(coordinate)
+	if genErr != nil {
+		switch genErr.SubDomainCode {
+		case converters.ErrorInvalidCoordinate:
+			return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidCoordinate, coordinateMeta(coordinate))
+		case converters.ErrorCountryNotFound:
+			// Not located in a supported country, actual is empty
+		default:
+			return genErr
+		}
+	}
+
+	// Validate country
+	countryCode = strings.ToUpper(countryCode)
+	if !containsString(converters.CountryBoundaryCodes(), countryCode) {
+		meta := map[string]string{"country_code": countryCode}
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorUnsupportedCountry, meta)
+	}
+
+	// Compare country
+	if actual != countryCode {
+		meta := coordinateMeta(coordinate)
+		meta["country_code"] = countryCode
+		meta["actual_country_code"] = actual // Empty if not located in a supported country
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorCoordinateCountryMismatch, meta)
+	}
+	return nil
+}
+
+func coordinateMeta(coordinate converters.Coordinate) map[string]string {
+	return map[string]string{
+		"latitude":  strconv.FormatFloat(coordinate.Latitude, 'f', -1, 64),
+		"longitude": strconv.FormatFloat(coordinate.Longitude, 'f', -1, 64),
+	}
+}
@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/converters"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testBrussels = converters.Coordinate{Latitude: 50.8467, Longitude: 4.3525}
+
+func Test_ValidateCoordinate_Success(t *testing.T) {
+	coordinate, genErr := ValidateCoordinate(50.8467, 4.3525)
+	require.Nil(t, genErr)
+	assert.Equal(t, testBrussels, coordinate)
+}
+
+func Test_ValidateCoordinate_Invalid_Failure(t *testing.T) {
+	tests := []struct {
+		latitude  float64
+		longitude float64
+		meta      map[string]string
+	}{
+		{-91, 4, map[string]string{"latitude": "-91", "longitude": "4"}},
+		{50, 180.5, map[string]string{"latitude": "50", "longitude": "180.5"}},
+		{0, 0, map[string]string{"latitude": "0", "longitude": "0"}},
+	}
+
+	for _, test := range tests {
+		_, genErr := ValidateCoordinate(test.latitude, test.longitude)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidCoordinate, test.meta)
+	}
+}
+
+func Test_ValidateCoordinateInGeofences_Success(t *testing.T) {
+	geofences, genErr := converters.ParseGeofences([]byte(`{"type": "Polygon", "coordinates": [
+		[[4.24, 50.76], [4.48, 50.76], [4.48, 50.92], [4.24, 50.92], [4.24, 50.76]]
+	]}`))
+	require.Nil(t, genErr)
+	assert.Nil(t, ValidateCoordinateInGeofences(testBrussels, geofences))
+
+	antwerp := converters.Coordinate{Latitude: 51.2194, Longitude: 4.4025}
+	genErr = ValidateCoordinateInGeofences(antwerp, geofences)
+	expectedMeta := map[string]string{"latitude": "51.2194", "longitude": "4.4025"}
+	errors.AssertGenericError(t, genErr, 400, ErrorCoordinateOutsideGeofence, expectedMeta)
+}
+
+func Test_ValidateCoordinateCountry_Success(t *testing.T) {
+	assert.Nil(t, ValidateCoordinateCountry(testBrussels, "be"))
+}
+
+func Test_ValidateCoordinateCountry_Mismatch_Failure(t *testing.T) {
+	genErr := ValidateCoordinateCountry(testBrussels, "NL")
+	expectedMeta := map[string]string{
+		"latitude":            "50.8467",
+		"longitude":           "4.3525",
+		"country_code":        "NL",
+		"actual_country_code": "BE",
+	}
+	errors.AssertGenericError(t, genErr, 400, ErrorCoordinateCountryMismatch, expectedMeta)
+}
+
+func Test_ValidateCoordinateCountry_UnsupportedCountry_Failure(t *testing.T) {
+	genErr := ValidateCoordinateCountry(testBrussels, "US")
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedCountry, map[string]string{"country_code": "US"})
+}
+
+func Test_ValidateCoordinateCountry_InvalidCoordinate_Failure(t *testing.T) {
+	genErr := ValidateCoordinateCountry(converters.Coordinate{Latitude: 95, Longitude: 4}, "BE")
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidCoordinate, map[string]string{"latitude": "95", "longitude": "4"})
+}
@@ -0,0 +1,90 @@
+package validation
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/skiprco/go-utils/v2/converters"
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// ibanLengths contains the length of the IBAN per country
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BR": 29,
+	"CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DK": 18, "DO": 28, "EE": 20, "ES": 24, "FI": 18,
+	"FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28,
+	"IE": 22, "IL": 23, "IS": 26, "IT": 27, "JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LI": 21, "LT": 20,
+	"LU": 20, "LV": 21, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MR": 27, "MT": 31, "MU": 30, "NL": 18,
+	"NO": 15, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "SA": 24, "SE": 24,
+	"SI": 19, "SK": 24, "SM": 27, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20,
+}
+
+var ibanRegex = regexp.MustCompile(`^([A-Z]{2})(\d{2})([A-Z0-9]+)$`)
+var bicRegex = regexp.MustCompile(`^[A-Z]{4}([A-Z]{2})[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+// ValidateIBAN checks the country, length and check digits of the IBAN.
+// Returns the IBAN in electronic format (e.g. BE71096123456769).
+//
+// Raises
+//
+// - 400/invalid_iban: Provided IBAN is not valid
+func ValidateIBAN(iban string) (string, *errors.GenericError) {
+	// Validate format
+	clean := cleanIdentifier(iban)
+	matches := ibanRegex.FindStringSubmatch(clean)
+	if matches == nil || ibanLengths[matches[1]] != len(clean) {
+		return "", invalidIdentifierError(ErrorInvalidIBAN, "iban", iban)
+	}
+
+	// Validate check digits
+	if mod97(clean[4:]+clean[:4]) != 1 {
+		return "", invalidIdentifierError(ErrorInvalidIBAN, "iban", iban)
+	}
+	return clean, nil
+}
+
+// FormatIBAN validates the IBAN and returns it in print format,
+// in groups of 4 characters (e.g. BE71 0961 2345 6769).
+//
+// Raises
+//
+// - 400/invalid_iban: Provided IBAN is not valid
+func FormatIBAN(iban string) (string, *errors.GenericError) {
+	clean, genErr := ValidateIBAN(iban)
+	if genErr != nil {
+		return "", genErr
+	}
+	groups := []string{}
+	for i := 0; i < len(clean); i += 4 {
+		end := i + 4
+		if end > len(clean) {
+			end = len(clean)
+		}
+		groups = append(groups, clean[i:end])
+	}
+	return strings.Join(groups, " "), nil
+}
+
+// ValidateBIC checks the format and country of the BIC (SWIFT code).
+// Returns the BIC in upper case without spaces (e.g. GEBABEBB).
+//
+// Raises
+//
+// - 400/invalid_bic: Provided BIC is not valid
+func ValidateBIC(bic string) (string, *errors.GenericError) {
+	clean := cleanIdentifier(bic)
+	matches := bicRegex.FindStringSubmatch(clean)
+	if matches == nil {
+		return "", invalidIdentifierError(ErrorInvalidBIC, "bic", bic)
+	}
+	if _, genErr := converters.CountryCodeToCountryName(matches[1]); genErr != nil && matches[1] != "XK" {
+		return "", invalidIdentifierError(ErrorInvalidBIC, "bic", bic)
+	}
+	return clean, nil
+}
+
+// invalidIdentifierError creates a 400 error with the provided input as meta
+func invalidIdentifierError(code string, metaKey string, input string) *errors.GenericError {
+	meta := map[string]string{metaKey: input}
+	return errors.NewGenericError(400, errorDomain, errorSubDomain, code, meta)
+}
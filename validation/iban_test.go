@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateIBAN_Success(t *testing.T) {
+	tests := map[string]string{
+		"BE71 0961 2345 6769":               "BE71096123456769",
+		"nl91abna0417164300":                "NL91ABNA0417164300",
+		"FR14 2004 1010 0505 0001 3M02 606": "FR1420041010050500013M02606",
+		"DE89-3704-0044-0532-0130-00":       "DE89370400440532013000",
+		"LU28 0019 4006 4475 0000":          "LU280019400644750000",
+	}
+
+	for input, expected := range tests {
+		result, genErr := ValidateIBAN(input)
+		require.Nil(t, genErr, input)
+		assert.Equal(t, expected, result)
+	}
+}
+
+func Test_ValidateIBAN_Invalid_Failure(t *testing.T) {
+	inputs := []string{"", "BE71096123456768", "BE7109612345676", "XX71096123456769", "BE71 0961 2345 676!"}
+	for _, input := range inputs {
+		_, genErr := ValidateIBAN(input)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidIBAN, map[string]string{"iban": input})
+	}
+}
+
+func Test_FormatIBAN_Success(t *testing.T) {
+	result, genErr := FormatIBAN("FR1420041010050500013M02606")
+	require.Nil(t, genErr)
+	assert.Equal(t, "FR14 2004 1010 0505 0001 3M02 606", result)
+}
+
+func Test_ValidateBIC_Success(t *testing.T) {
+	tests := map[string]string{
+		"GEBABEBB":    "GEBABEBB",
+		"gebabebb36a": "GEBABEBB36A",
+		"ABNA NL 2A":  "ABNANL2A",
+	}
+
+	for input, expected := range tests {
+		result, genErr := ValidateBIC(input)
+		require.Nil(t, genErr, input)
+		assert.Equal(t, expected, result)
+	}
+}
+
+func Test_ValidateBIC_Invalid_Failure(t *testing.T) {
+	for _, input := range []string{"", "GEBABEB", "GEBABEBB3", "GEBAXXBB", "1EBABEBB"} {
+		_, genErr := ValidateBIC(input)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidBIC, map[string]string{"bic": input})
+	}
+}
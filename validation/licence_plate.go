@@ -0,0 +1,129 @@
+package validation
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/skiprco/go-utils/v2/converters"
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// licencePlateTemplate is a plate layout where X is a letter, 9 a digit and other characters are separators
+type licencePlateTemplate struct {
+	pattern  *regexp.Regexp
+	template string
+}
+
+// newLicencePlateTemplates converts the templates to patterns matching the plate without separators.
+// Letters can be restricted by providing the allowed letters.
+func newLicencePlateTemplates(letters string, templates ...string) []licencePlateTemplate {
+	result := make([]licencePlateTemplate, 0, len(templates))
+	for _, template := range templates {
+		var pattern strings.Builder
+		pattern.WriteString("^")
+		for _, r := range template {
+			switch r {
+			case 'X':
+				pattern.WriteString("[" + letters + "]")
+			case '9':
+				pattern.WriteString(`\d`)
+			}
+		}
+		pattern.WriteString("$")
+		result = append(result, licencePlateTemplate{pattern: regexp.MustCompile(pattern.String()), template: template})
+	}
+	return result
+}
+
+// licencePlateTemplates contains the current and previous plate layouts per country
+var licencePlateTemplates = map[string][]licencePlateTemplate{
+	// 1-ABC-123 since 2010, ABC-123 and 123-ABC before
+	"BE": newLicencePlateTemplates("A-Z", "9-XXX-999", "XXX-999", "999-XXX"),
+
+	// SIV system since 2009, letters I, O and U are not used
+	"FR": newLicencePlateTemplates("A-HJ-NP-TV-Z", "XX-999-XX"),
+
+	// Sidecodes 1 to 14
+	"NL": newLicencePlateTemplates("A-Z",
+		"XX-99-99", "99-99-XX", "99-XX-99", "XX-99-XX", "XX-XX-99", "99-XX-XX", "99-XXX-9",
+		"9-XXX-99", "XX-999-X", "X-999-XX", "XXX-99-X", "X-99-XXX", "9-XX-999", "999-XX-9"),
+
+	// Since 2000, only consonants
+	"ES": newLicencePlateTemplates("BCDFGHJKLMNPRSTVWXYZ", "9999 XXX"),
+
+	// Since 1994
+	"IT": newLicencePlateTemplates("A-HJ-NPR-TV-Z", "XX 999XX"),
+
+	"LU": newLicencePlateTemplates("A-Z", "XX 9999"),
+}
+
+// germanLicencePlateRegex matches the district (1-3 letters), 1-2 letters, 1-4 digits and an optional E or H suffix.
+// A separator between district and letters is required, since they can't be distinguished otherwise.
+var germanLicencePlateRegex = regexp.MustCompile(`^([A-ZÄÖÜ]{1,3})[- ]+([A-Z]{1,2})[- ]*(\d{1,4})([EH]?)$`)
+
+// genericLicencePlateRegex matches plates of countries without specific layout
+var genericLicencePlateRegex = regexp.MustCompile(`^[A-Z0-9]{2,10}$`)
+
+// ValidateLicencePlate checks if the licence plate has a valid layout for the country.
+// Layouts are validated for BE, DE, ES, FR, IT, LU and NL. Plates of other countries should
+// contain 2 to 10 letters and digits. Personalised plates are not supported.
+// Returns the plate in the official layout (e.g. 1-ABC-123 for BE, B-AB 1234 for DE).
+//
+// Raises
+//
+// - 400/invalid_country_code: Provided country code is invalid
+//
+// - 400/invalid_licence_plate: Provided licence plate is not valid for the country
+func ValidateLicencePlate(plate string, countryCode string) (string, *errors.GenericError) {
+	// Validate country
+	countryCode = strings.ToUpper(countryCode)
+	if _, genErr := converters.CountryCodeToCountryName(countryCode); genErr != nil {
+		meta := map[string]string{"country_code": countryCode}
+		return "", errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidCountryCode, meta)
+	}
+
+	// Validate German plate
+	upper := strings.ToUpper(strings.TrimSpace(plate))
+	if countryCode == "DE" {
+		if matches := germanLicencePlateRegex.FindStringSubmatch(upper); matches != nil {
+			return matches[1] + "-" + matches[2] + " " + matches[3] + matches[4], nil
+		}
+		return "", invalidLicencePlateError(plate, countryCode)
+	}
+
+	// Validate plate with templates
+	clean := cleanIdentifier(upper)
+	templates, exists := licencePlateTemplates[countryCode]
+	if !exists {
+		if genericLicencePlateRegex.MatchString(clean) {
+			return clean, nil
+		}
+		return "", invalidLicencePlateError(plate, countryCode)
+	}
+	for _, template := range templates {
+		if template.pattern.MatchString(clean) {
+			return applyLicencePlateTemplate(clean, template.template), nil
+		}
+	}
+	return "", invalidLicencePlateError(plate, countryCode)
+}
+
+// applyLicencePlateTemplate inserts the separators of the template in the plate
+func applyLicencePlateTemplate(plate string, template string) string {
+	var builder strings.Builder
+	index := 0
+	for _, r := range template {
+		if r == 'X' || r == '9' {
+			builder.WriteByte(plate[index])
+			index++
+		} else {
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+func invalidLicencePlateError(plate string, countryCode string) *errors.GenericError {
+	meta := map[string]string{"licence_plate": plate, "country_code": countryCode}
+	return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidLicencePlate, meta)
+}
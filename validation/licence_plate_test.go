@@ -0,0 +1,63 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateLicencePlate_Success(t *testing.T) {
+	tests := []struct {
+		plate       string
+		countryCode string
+		expected    string
+	}{
+		{"1-abc-234", "BE", "1-ABC-234"},
+		{"1ABC234", "be", "1-ABC-234"},
+		{"ABC 123", "BE", "ABC-123"},
+		{"ab-123-cd", "FR", "AB-123-CD"},
+		{"12ABC3", "NL", "12-ABC-3"},
+		{"XXXX99", "NL", "XX-XX-99"},
+		{"G-123-BB", "NL", "G-123-BB"},
+		{"b ab 1234", "DE", "B-AB 1234"},
+		{"M-X 12E", "DE", "M-X 12E"},
+		{"1234bcd", "ES", "1234 BCD"},
+		{"AB123CD", "IT", "AB 123CD"},
+		{"ab1234", "LU", "AB 1234"},
+		{"ab 12 cde", "GB", "AB12CDE"},
+	}
+
+	for _, test := range tests {
+		result, genErr := ValidateLicencePlate(test.plate, test.countryCode)
+		require.Nil(t, genErr, test.plate)
+		assert.Equal(t, test.expected, result)
+	}
+}
+
+func Test_ValidateLicencePlate_Invalid_Failure(t *testing.T) {
+	tests := []struct {
+		plate       string
+		countryCode string
+	}{
+		{"A-ABC-123", "BE"},
+		{"AB-123-CI", "FR"}, // I is not used
+		{"ABC123", "FR"},
+		{"ABCDEF", "NL"},
+		{"BAB1234", "DE"},  // Missing separator
+		{"1234 ABC", "ES"}, // Vowels are not used
+		{"A", "GB"},
+	}
+
+	for _, test := range tests {
+		_, genErr := ValidateLicencePlate(test.plate, test.countryCode)
+		expectedMeta := map[string]string{"licence_plate": test.plate, "country_code": test.countryCode}
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidLicencePlate, expectedMeta)
+	}
+}
+
+func Test_ValidateLicencePlate_InvalidCountryCode_Failure(t *testing.T) {
+	_, genErr := ValidateLicencePlate("1-ABC-234", "XX")
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidCountryCode, map[string]string{"country_code": "XX"})
+}
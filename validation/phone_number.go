@@ -1,7 +1,10 @@
 package validation
 
 import (
+	"strings"
+
 	"github.com/nyaruka/phonenumbers"
+	"github.com/skiprco/go-utils/v2/converters"
 	"github.com/skiprco/go-utils/v2/errors"
 )
 
@@ -9,6 +12,7 @@ const phoneNumberInvalidCountryCodeMessage = "invalid country code"
 
 // ValidateAndFormatPhoneNumber checks if the provided phone number is valid.
 // If yes, it will format it to its E164 representation (e.g +32...).
+// Fixed line numbers are rejected. Use ValidatePhoneNumber to allow other number types or restrict regions.
 //
 // Raises
 //
@@ -41,3 +45,100 @@ func ValidateAndFormatPhoneNumber(phoneNumber string, countryCode string) (strin
 	}
 	return phonenumbers.Format(parsedPhoneNumber, phonenumbers.E164), nil
 }
+
+// PhoneNumberOptions restricts the phone numbers accepted by ValidatePhoneNumber
+type PhoneNumberOptions struct {
+	// AllowedTypes contains the accepted number types. All types are accepted if empty.
+	AllowedTypes []converters.PhoneNumberType
+
+	// AllowedRegions contains the ISO 3166-1 alpha-2 codes of the accepted regions (e.g. BE).
+	// All regions are accepted if empty.
+	AllowedRegions []string
+}
+
+// MobilePhoneNumberOptions accepts numbers which can receive text messages
+var MobilePhoneNumberOptions = PhoneNumberOptions{
+	AllowedTypes: []converters.PhoneNumberType{
+		converters.PhoneNumberTypeMobile,
+		converters.PhoneNumberTypeFixedLineOrMobile,
+	},
+}
+
+// ContactPhoneNumberOptions accepts numbers which are commonly used as contact number of a company
+var ContactPhoneNumberOptions = PhoneNumberOptions{
+	AllowedTypes: []converters.PhoneNumberType{
+		converters.PhoneNumberTypeMobile,
+		converters.PhoneNumberTypeFixedLine,
+		converters.PhoneNumberTypeFixedLineOrMobile,
+		converters.PhoneNumberTypeVoIP,
+		converters.PhoneNumberTypeTollFree,
+	},
+}
+
+// PhoneNumberResult contains the details of a validated phone number
+type PhoneNumberResult struct {
+	converters.PhoneNumberInfo
+
+	// International is the number in international format (e.g. +32 468 30 04 31)
+	International string
+
+	// National is the number in national format (e.g. 0468 30 04 31)
+	National string
+}
+
+// ValidatePhoneNumber checks if the provided phone number is valid and matches the options.
+// The country code is only required if the number doesn't include the calling code (e.g. +32).
+//
+// Raises
+//
+// - 400/invalid_country_code: The provided country code is invalid
+//
+// - 400/not_a_phone_number: The provided phone number is not recognised as one
+//
+// - 400/invalid_phone_number: The provided phone number has the correct format, but is semantically incorrect
+//
+// - 400/phone_number_type_not_allowed: The type of the phone number is not in options.AllowedTypes
+//
+// - 400/phone_number_region_not_allowed: The region of the phone number is not in options.AllowedRegions
+func ValidatePhoneNumber(phoneNumber string, countryCode string, options PhoneNumberOptions) (PhoneNumberResult, *errors.GenericError) {
+	// Parse phone number
+	info, genErr := converters.ParsePhoneNumber(phoneNumber, countryCode)
+	if genErr != nil {
+		return PhoneNumberResult{}, errors.NewGenericError(genErr.Code, errorDomain, errorSubDomain, genErr.SubDomainCode, genErr.Meta)
+	}
+
+	// Validate type
+	if len(options.AllowedTypes) > 0 && !containsPhoneNumberType(options.AllowedTypes, info.Type) {
+		meta := map[string]string{"type": string(info.Type)}
+		return PhoneNumberResult{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorPhoneNumberTypeNotAllowed, meta)
+	}
+
+	// Validate region
+	if len(options.AllowedRegions) > 0 && !containsString(options.AllowedRegions, info.RegionCode) {
+		meta := map[string]string{"region": info.RegionCode}
+		return PhoneNumberResult{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorPhoneNumberRegionNotAllowed, meta)
+	}
+
+	// Build result
+	international, _ := converters.FormatPhoneNumber(info.E164, "", converters.PhoneNumberFormatInternational)
+	national, _ := converters.FormatPhoneNumber(info.E164, "", converters.PhoneNumberFormatNational)
+	return PhoneNumberResult{PhoneNumberInfo: info, International: international, National: national}, nil
+}
+
+func containsPhoneNumberType(types []converters.PhoneNumberType, numberType converters.PhoneNumberType) bool {
+	for _, allowed := range types {
+		if allowed == numberType {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, allowed := range values {
+		if strings.EqualFold(allowed, value) {
+			return true
+		}
+	}
+	return false
+}
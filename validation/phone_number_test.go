@@ -3,7 +3,10 @@ package validation
 import (
 	"testing"
 
+	"github.com/skiprco/go-utils/v2/converters"
+	"github.com/skiprco/go-utils/v2/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFormatPhoneNumber(t *testing.T) {
@@ -40,3 +43,37 @@ func TestFormatPhoneNumber(t *testing.T) {
 	}
 
 }
+
+func Test_ValidatePhoneNumber_Success(t *testing.T) {
+	result, genErr := ValidatePhoneNumber("0468 30 04 31", "BE", MobilePhoneNumberOptions)
+	require.Nil(t, genErr)
+	assert.Equal(t, "+32468300431", result.E164)
+	assert.Equal(t, "BE", result.RegionCode)
+	assert.Equal(t, converters.PhoneNumberTypeMobile, result.Type)
+	assert.Equal(t, "+32 468 30 04 31", result.International)
+	assert.Equal(t, "0468 30 04 31", result.National)
+}
+
+func Test_ValidatePhoneNumber_FixedLine_Success(t *testing.T) {
+	result, genErr := ValidatePhoneNumber("+3227896143", "", ContactPhoneNumberOptions)
+	require.Nil(t, genErr)
+	assert.Equal(t, converters.PhoneNumberTypeFixedLine, result.Type)
+	assert.Equal(t, "02 789 61 43", result.National)
+}
+
+func Test_ValidatePhoneNumber_TypeNotAllowed_Failure(t *testing.T) {
+	_, genErr := ValidatePhoneNumber("+3227896143", "", MobilePhoneNumberOptions)
+	errors.AssertGenericError(t, genErr, 400, ErrorPhoneNumberTypeNotAllowed, map[string]string{"type": "fixed_line"})
+}
+
+func Test_ValidatePhoneNumber_RegionNotAllowed_Failure(t *testing.T) {
+	options := PhoneNumberOptions{AllowedRegions: []string{"BE", "NL"}}
+	_, genErr := ValidatePhoneNumber("+33623839679", "", options)
+	errors.AssertGenericError(t, genErr, 400, ErrorPhoneNumberRegionNotAllowed, map[string]string{"region": "FR"})
+}
+
+func Test_ValidatePhoneNumber_InvalidPhoneNumber_Failure(t *testing.T) {
+	_, genErr := ValidatePhoneNumber("0461", "BE", PhoneNumberOptions{})
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidPhoneNumber, nil)
+	assert.Equal(t, "validation", genErr.SubDomain)
+}
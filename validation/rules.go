@@ -0,0 +1,166 @@
+package validation
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Names of the built-in validation rules
+const (
+	// RuleRequired fails if the value is the zero value (e.g. empty string, nil pointer or empty slice)
+	RuleRequired = "required"
+
+	// RuleOmitEmpty skips all other rules of the field if the value is the zero value
+	RuleOmitEmpty = "omitempty"
+
+	// RuleCountryCode fails if the string is not a valid country code. See ValidateCountryCode.
+	RuleCountryCode = "country_code"
+
+	// RuleCurrencyCode fails if the string is not a valid currency code. See ValidateCurrencyCode.
+	RuleCurrencyCode = "currency_code"
+
+	// RulePhone fails if the string is not a valid mobile phone number.
+	// The param is the default country code (e.g. phone=BE). See ValidateAndFormatPhoneNumber.
+	RulePhone = "phone"
+
+	// RuleEmail fails if the string is not an email address. See ValidateEmail.
+	RuleEmail = "email"
+
+	// RuleMin fails if the number is below the param. For strings, slices and maps the length is checked.
+	RuleMin = "min"
+
+	// RuleMax fails if the number is above the param. For strings, slices and maps the length is checked.
+	RuleMax = "max"
+
+	// RuleOneOf fails if the value is not one of the space separated values in the param (e.g. oneof=car bike)
+	RuleOneOf = "oneof"
+)
+
+// Rule validates a single value. Pointers are dereferenced before calling the rule and rules
+// (except required) are skipped for nil pointers. Param is the text after "=" in the tag (e.g. "BE" for phone=BE).
+// Returns true if the value is valid.
+type Rule func(value interface{}, param string) bool
+
+var rules = map[string]Rule{
+	RuleRequired:     func(value interface{}, _ string) bool { return !isZeroValue(reflect.ValueOf(value)) },
+	RuleCountryCode:  stringRule(ValidateCountryCode),
+	RuleCurrencyCode: stringRule(ValidateCurrencyCode),
+	RulePhone:        validatePhoneRule,
+	RuleEmail:        stringRule(validateEmailRule),
+	RuleMin:          func(value interface{}, param string) bool { return compareRule(value, param, 1) },
+	RuleMax:          func(value interface{}, param string) bool { return compareRule(value, param, -1) },
+	RuleOneOf:        validateOneOfRule,
+}
+var rulesLock sync.RWMutex
+
+// RegisterRule registers a custom rule, so it can be used in the validate struct tag.
+// A rule with the same name is replaced. Rules should be registered on startup and must be safe for concurrent use.
+func RegisterRule(name string, rule Rule) {
+	rulesLock.Lock()
+	defer rulesLock.Unlock()
+	rules[name] = rule
+}
+
+// getRule returns the registered rule with the provided name
+func getRule(name string) (Rule, bool) {
+	rulesLock.RLock()
+	defer rulesLock.RUnlock()
+	rule, exists := rules[name]
+	return rule, exists
+}
+
+// stringRule converts a string validation function to a rule. Non-string values are invalid.
+func stringRule(validate func(input string) bool) Rule {
+	return func(value interface{}, _ string) bool {
+		input := reflect.ValueOf(value)
+		return input.Kind() == reflect.String && validate(input.String())
+	}
+}
+
+// validateEmailRule validates an email address. Empty addresses are valid.
+func validateEmailRule(input string) bool {
+	if input == "" {
+		return true
+	}
+	_, genErr := ValidateEmail(input)
+	return genErr == nil
+}
+
+// validatePhoneRule validates a phone number. Empty numbers are valid.
+func validatePhoneRule(value interface{}, param string) bool {
+	input := reflect.ValueOf(value)
+	if input.Kind() != reflect.String {
+		return false
+	}
+	if input.String() == "" {
+		return true
+	}
+	_, genErr := ValidateAndFormatPhoneNumber(input.String(), param)
+	return genErr == nil
+}
+
+// compareRule compares the number or length of the value with the param.
+// Direction 1 checks value >= param, direction -1 checks value <= param.
+func compareRule(value interface{}, param string, direction float64) bool {
+	limit, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return false
+	}
+
+	// Fetch number to compare
+	var number float64
+	input := reflect.ValueOf(value)
+	switch input.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number = float64(input.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number = float64(input.Uint())
+	case reflect.Float32, reflect.Float64:
+		number = input.Float()
+	case reflect.String:
+		number = float64(utf8.RuneCountInString(input.String()))
+	case reflect.Slice, reflect.Array, reflect.Map:
+		number = float64(input.Len())
+	default:
+		return false
+	}
+	return (number-limit)*direction >= 0
+}
+
+// validateOneOfRule checks if the value is one of the space separated values in the param
+func validateOneOfRule(value interface{}, param string) bool {
+	input := reflect.ValueOf(value)
+	var formatted string
+	switch input.Kind() {
+	case reflect.String:
+		formatted = input.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		formatted = strconv.FormatInt(input.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		formatted = strconv.FormatUint(input.Uint(), 10)
+	default:
+		return false
+	}
+	for _, option := range strings.Fields(param) {
+		if formatted == option {
+			return true
+		}
+	}
+	return false
+}
+
+// isZeroValue checks if the value is invalid, the zero value of its type or an empty slice or map
+func isZeroValue(value reflect.Value) bool {
+	if !value.IsValid() {
+		return true
+	}
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	default:
+		return value.IsZero()
+	}
+}
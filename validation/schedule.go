@@ -0,0 +1,177 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// ScheduleExceptionDateFormat is the format of the keys of Schedule.Exceptions
+const ScheduleExceptionDateFormat = "2006-01-02"
+
+// scheduleSearchDays is the number of days NextOpening searches for an opening
+const scheduleSearchDays = 366
+
+var timeOfDayRegex = regexp.MustCompile(`^([01]\d|2[0-3]):([0-5]\d)$`)
+
+// TimeOfDay is a wall clock time (e.g. 08:30)
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+}
+
+// ParseTimeOfDay parses a time of day in HH:MM format (e.g. 08:30).
+// Midnight at the end of the day can be written as 24:00.
+//
+// Raises
+//
+// - 400/invalid_time_of_day: Provided time is not in HH:MM format
+func ParseTimeOfDay(input string) (TimeOfDay, *errors.GenericError) {
+	if input == "24:00" {
+		return TimeOfDay{Hour: 24}, nil
+	}
+	matches := timeOfDayRegex.FindStringSubmatch(input)
+	if matches == nil {
+		meta := map[string]string{"time_of_day": input}
+		return TimeOfDay{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidTimeOfDay, meta)
+	}
+	hour, _ := strconv.Atoi(matches[1])
+	minute, _ := strconv.Atoi(matches[2])
+	return TimeOfDay{Hour: hour, Minute: minute}, nil
+}
+
+// String formats the time of day in HH:MM format
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
+}
+
+// on returns the time of day on the provided date in the provided location
+func (t TimeOfDay) on(year int, month time.Month, day int, location *time.Location) time.Time {
+	return time.Date(year, month, day, t.Hour, t.Minute, 0, 0, location)
+}
+
+// OpeningHours is a period of a day during which a schedule is open (e.g. 08:30 - 12:00).
+// If Close is not after Open, the period ends on the next day (e.g. 22:00 - 02:00).
+type OpeningHours struct {
+	Open  TimeOfDay
+	Close TimeOfDay
+}
+
+// Schedule contains the opening hours per weekday, with exceptions for specific dates
+type Schedule struct {
+	// Location is the time zone of the opening hours. Defaults to UTC if nil.
+	Location *time.Location
+
+	// Weekdays contains the opening hours per day of the week.
+	// Days without opening hours are closed.
+	Weekdays map[time.Weekday][]OpeningHours
+
+	// Exceptions contains the opening hours of specific dates in ScheduleExceptionDateFormat (e.g. 2020-12-24).
+	// Exceptions take precedence over Weekdays and public holidays. An empty list means closed.
+	Exceptions map[string][]OpeningHours
+
+	// HolidayCountryCode is the country of which the public holidays are closed.
+	// If empty, public holidays are not taken into account. See PublicHolidays for the supported countries.
+	HolidayCountryCode string
+}
+
+// location returns the location of the schedule
+func (s Schedule) location() *time.Location {
+	if s.Location == nil {
+		return time.UTC
+	}
+	return s.Location
+}
+
+// OpeningRanges returns the periods during which the schedule is open on the day of the provided time.
+// The day is determined in the location of the schedule. Periods are merged and sorted by start.
+// Periods which cross midnight end on the next day.
+//
+// Raises
+//
+// - 400/unsupported_country: No public holidays available for the country of the schedule
+func (s Schedule) OpeningRanges(t time.Time) ([]TimeRange, *errors.GenericError) {
+	// Fetch opening hours
+	local := t.In(s.location())
+	hours, isException := s.Exceptions[local.Format(ScheduleExceptionDateFormat)]
+	if !isException {
+		if s.HolidayCountryCode != "" {
+			isHoliday, genErr := IsPublicHoliday(s.HolidayCountryCode, local)
+			if genErr != nil {
+				return nil, genErr
+			}
+			if isHoliday {
+				return []TimeRange{}, nil
+			}
+		}
+		hours = s.Weekdays[local.Weekday()]
+	}
+
+	// Convert to ranges
+	year, month, day := local.Date()
+	ranges := make([]TimeRange, 0, len(hours))
+	for _, period := range hours {
+		start := period.Open.on(year, month, day, local.Location())
+		end := period.Close.on(year, month, day, local.Location())
+		if !end.After(start) {
+			end = period.Close.on(year, month, day+1, local.Location())
+		}
+		ranges = append(ranges, TimeRange{Start: start, End: end, Bounds: TimeRangeBoundsInclusiveExclusive})
+	}
+	return MergeTimeRanges(ranges), nil
+}
+
+// IsOpen checks if the schedule is open at the provided time.
+// Periods of the previous day which cross midnight are taken into account.
+//
+// Raises
+//
+// - 400/unsupported_country: No public holidays available for the country of the schedule
+func (s Schedule) IsOpen(t time.Time) (bool, *errors.GenericError) {
+	local := t.In(s.location())
+	for _, day := range []time.Time{local.AddDate(0, 0, -1), local} {
+		ranges, genErr := s.OpeningRanges(day)
+		if genErr != nil {
+			return false, genErr
+		}
+		for _, r := range ranges {
+			if r.Contains(t) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// NextOpening returns the provided time if the schedule is open.
+// Otherwise the first time the schedule opens after the provided time is returned.
+// Returns false if the schedule doesn't open within a year.
+//
+// Raises
+//
+// - 400/unsupported_country: No public holidays available for the country of the schedule
+func (s Schedule) NextOpening(t time.Time) (time.Time, bool, *errors.GenericError) {
+	// Check if open
+	isOpen, genErr := s.IsOpen(t)
+	if genErr != nil || isOpen {
+		return t, isOpen, genErr
+	}
+
+	// Search next opening
+	local := t.In(s.location())
+	for offset := 0; offset <= scheduleSearchDays; offset++ {
+		ranges, genErr := s.OpeningRanges(local.AddDate(0, 0, offset))
+		if genErr != nil {
+			return time.Time{}, false, genErr
+		}
+		for _, r := range ranges {
+			if r.Start.After(t) {
+				return r.Start, true, nil
+			}
+		}
+	}
+	return time.Time{}, false, nil
+}
@@ -0,0 +1,128 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testOpeningHours(t *testing.T, open string, close string) OpeningHours {
+	openTime, genErr := ParseTimeOfDay(open)
+	require.Nil(t, genErr)
+	closeTime, genErr := ParseTimeOfDay(close)
+	require.Nil(t, genErr)
+	return OpeningHours{Open: openTime, Close: closeTime}
+}
+
+func testSchedule(t *testing.T) Schedule {
+	return Schedule{
+		Location: testLocation(t),
+		Weekdays: map[time.Weekday][]OpeningHours{
+			time.Monday:   {testOpeningHours(t, "08:00", "12:00"), testOpeningHours(t, "13:00", "17:00")},
+			time.Friday:   {testOpeningHours(t, "08:00", "12:00"), testOpeningHours(t, "22:00", "02:00")},
+			time.Saturday: {testOpeningHours(t, "10:00", "24:00")},
+		},
+		Exceptions: map[string][]OpeningHours{
+			"2020-12-28": {}, // Closed on Monday
+		},
+		HolidayCountryCode: "BE",
+	}
+}
+
+func Test_ParseTimeOfDay_Success(t *testing.T) {
+	tests := map[string]TimeOfDay{
+		"00:00": {Hour: 0, Minute: 0},
+		"08:30": {Hour: 8, Minute: 30},
+		"23:59": {Hour: 23, Minute: 59},
+		"24:00": {Hour: 24, Minute: 0},
+	}
+
+	for input, expected := range tests {
+		result, genErr := ParseTimeOfDay(input)
+		require.Nil(t, genErr)
+		assert.Equal(t, expected, result)
+		assert.Equal(t, input, result.String())
+	}
+}
+
+func Test_ParseTimeOfDay_Invalid_Failure(t *testing.T) {
+	for _, input := range []string{"", "8:30", "24:01", "12:60", "noon"} {
+		_, genErr := ParseTimeOfDay(input)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidTimeOfDay, map[string]string{"time_of_day": input})
+	}
+}
+
+func Test_Schedule_IsOpen_Success(t *testing.T) {
+	schedule := testSchedule(t)
+	brussels := schedule.Location
+	tests := []struct {
+		time     time.Time
+		expected bool
+	}{
+		{time.Date(2020, 12, 21, 8, 0, 0, 0, brussels), true},                        // Monday opening
+		{time.Date(2020, 12, 21, 12, 30, 0, 0, brussels), false},                     // Monday lunch break
+		{time.Date(2020, 12, 21, 7, 30, 0, 0, time.UTC), true},                       // Monday 08:30 in Brussels
+		{time.Date(2020, 12, 22, 10, 0, 0, 0, brussels), false},                      // Tuesday
+		{time.Date(2020, 12, 19, 1, 0, 0, 0, brussels), true},                        // Friday night after midnight
+		{time.Date(2020, 12, 25, 10, 0, 0, 0, brussels), false},                      // Christmas
+		{time.Date(2020, 12, 26, 23, 59, 0, 0, brussels), true},                      // Saturday until midnight
+		{time.Date(2020, 12, 28, 10, 0, 0, 0, brussels), false},                      // Exception
+		{time.Date(2020, 12, 21, 17, 0, 0, 0, brussels).Add(-time.Nanosecond), true}, // Just before closing
+	}
+
+	for i, test := range tests {
+		isOpen, genErr := schedule.IsOpen(test.time)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.expected, isOpen, i)
+	}
+}
+
+func Test_Schedule_OpeningRanges_Success(t *testing.T) {
+	schedule := testSchedule(t)
+	brussels := schedule.Location
+	ranges, genErr := schedule.OpeningRanges(time.Date(2020, 12, 21, 20, 0, 0, 0, brussels))
+	require.Nil(t, genErr)
+	expected := []TimeRange{
+		{Start: time.Date(2020, 12, 21, 8, 0, 0, 0, brussels), End: time.Date(2020, 12, 21, 12, 0, 0, 0, brussels), Bounds: TimeRangeBoundsInclusiveExclusive},
+		{Start: time.Date(2020, 12, 21, 13, 0, 0, 0, brussels), End: time.Date(2020, 12, 21, 17, 0, 0, 0, brussels), Bounds: TimeRangeBoundsInclusiveExclusive},
+	}
+	assert.Equal(t, expected, ranges)
+}
+
+func Test_Schedule_NextOpening_Success(t *testing.T) {
+	schedule := testSchedule(t)
+	brussels := schedule.Location
+
+	// Already open
+	now := time.Date(2020, 12, 21, 9, 0, 0, 0, brussels)
+	next, found, genErr := schedule.NextOpening(now)
+	require.Nil(t, genErr)
+	assert.True(t, found)
+	assert.Equal(t, now, next)
+
+	// Lunch break
+	next, found, genErr = schedule.NextOpening(time.Date(2020, 12, 21, 12, 30, 0, 0, brussels))
+	require.Nil(t, genErr)
+	assert.True(t, found)
+	assert.Equal(t, time.Date(2020, 12, 21, 13, 0, 0, 0, brussels), next)
+
+	// Christmas (Friday) => Saturday
+	next, found, genErr = schedule.NextOpening(time.Date(2020, 12, 25, 9, 0, 0, 0, brussels))
+	require.Nil(t, genErr)
+	assert.True(t, found)
+	assert.Equal(t, time.Date(2020, 12, 26, 10, 0, 0, 0, brussels), next)
+
+	// Never open
+	_, found, genErr = Schedule{}.NextOpening(now)
+	require.Nil(t, genErr)
+	assert.False(t, found)
+}
+
+func Test_Schedule_UnsupportedCountry_Failure(t *testing.T) {
+	schedule := Schedule{HolidayCountryCode: "XX"}
+	_, genErr := schedule.IsOpen(time.Now())
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedCountry, map[string]string{"country_code": "XX"})
+}
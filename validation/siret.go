@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"regexp"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// laPosteSIREN is the SIREN of La Poste, whose establishments don't follow the Luhn algorithm
+const laPosteSIREN = "356000000"
+
+var sirenRegex = regexp.MustCompile(`^\d{9}$`)
+var siretRegex = regexp.MustCompile(`^\d{14}$`)
+
+// ValidateSIREN checks the check digit of the French company number (SIREN).
+// Returns the number in the official format (e.g. 732 829 320).
+//
+// Raises
+//
+// - 400/invalid_siren: Provided SIREN is not valid
+func ValidateSIREN(siren string) (string, *errors.GenericError) {
+	clean := cleanIdentifier(siren)
+	if !sirenRegex.MatchString(clean) || !luhn(clean) {
+		return "", invalidIdentifierError(ErrorInvalidSIREN, "siren", siren)
+	}
+	return clean[0:3] + " " + clean[3:6] + " " + clean[6:9], nil
+}
+
+// ValidateSIRET checks the check digit of the French establishment number (SIRET).
+// Returns the number in the official format (e.g. 732 829 320 00074).
+//
+// Raises
+//
+// - 400/invalid_siret: Provided SIRET is not valid
+func ValidateSIRET(siret string) (string, *errors.GenericError) {
+	// Validate format
+	clean := cleanIdentifier(siret)
+	if !siretRegex.MatchString(clean) {
+		return "", invalidIdentifierError(ErrorInvalidSIRET, "siret", siret)
+	}
+
+	// Validate check digit. Establishments of La Poste have a digit sum which is a multiple of 5.
+	valid := luhn(clean)
+	if clean[:9] == laPosteSIREN {
+		sum := 0
+		for _, r := range clean {
+			sum += int(r - '0')
+		}
+		valid = sum%5 == 0
+	}
+	if !valid {
+		return "", invalidIdentifierError(ErrorInvalidSIRET, "siret", siret)
+	}
+	return clean[0:3] + " " + clean[3:6] + " " + clean[6:9] + " " + clean[9:14], nil
+}
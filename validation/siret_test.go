@@ -0,0 +1,43 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateSIREN_Success(t *testing.T) {
+	result, genErr := ValidateSIREN("732829320")
+	require.Nil(t, genErr)
+	assert.Equal(t, "732 829 320", result)
+}
+
+func Test_ValidateSIREN_Invalid_Failure(t *testing.T) {
+	for _, input := range []string{"", "732829321", "73282932"} {
+		_, genErr := ValidateSIREN(input)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidSIREN, map[string]string{"siren": input})
+	}
+}
+
+func Test_ValidateSIRET_Success(t *testing.T) {
+	tests := map[string]string{
+		"73282932000074":    "732 829 320 00074",
+		"732 829 320 00074": "732 829 320 00074",
+		"35600000049837":    "356 000 000 49837", // La Poste
+	}
+
+	for input, expected := range tests {
+		result, genErr := ValidateSIRET(input)
+		require.Nil(t, genErr, input)
+		assert.Equal(t, expected, result)
+	}
+}
+
+func Test_ValidateSIRET_Invalid_Failure(t *testing.T) {
+	for _, input := range []string{"", "73282932000075", "7328293200007", "35600000049838"} {
+		_, genErr := ValidateSIRET(input)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidSIRET, map[string]string{"siret": input})
+	}
+}
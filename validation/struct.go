@@ -0,0 +1,229 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// ValidateTag is the struct tag containing the comma separated validation rules of a field
+// (e.g. `validate:"required,country_code"`). Parameters are added after an equal sign (e.g. `validate:"min=1"`).
+const ValidateTag = "validate"
+
+// validationPlan contains the precalculated validation info of a struct type
+type validationPlan struct {
+	fields []validationPlanField
+}
+
+type validationPlanField struct {
+	index     int
+	name      string
+	embedded  bool
+	omitEmpty bool
+	rules     []validationPlanRule
+}
+
+type validationPlanRule struct {
+	name  string
+	param string
+}
+
+// validationPlans caches a *validationPlan per reflect.Type
+var validationPlans sync.Map
+
+// getValidationPlan returns the cached plan of the struct type or builds a new one
+func getValidationPlan(t reflect.Type) *validationPlan {
+	if plan, exists := validationPlans.Load(t); exists {
+		return plan.(*validationPlan)
+	}
+
+	plan := &validationPlan{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue // Unexported
+		}
+
+		// Parse name
+		planField := validationPlanField{index: i, name: field.Name}
+		if jsonName := strings.Split(field.Tag.Get("json"), ",")[0]; jsonName != "" && jsonName != "-" {
+			planField.name = jsonName
+		} else if field.Anonymous {
+			planField.embedded = true
+		}
+
+		// Parse rules
+		for _, rule := range strings.Split(field.Tag.Get(ValidateTag), ",") {
+			parts := strings.SplitN(strings.TrimSpace(rule), "=", 2)
+			switch {
+			case parts[0] == "":
+				continue
+			case parts[0] == RuleOmitEmpty:
+				planField.omitEmpty = true
+			case len(parts) == 2:
+				planField.rules = append(planField.rules, validationPlanRule{name: parts[0], param: parts[1]})
+			default:
+				planField.rules = append(planField.rules, validationPlanRule{name: parts[0]})
+			}
+		}
+		plan.fields = append(plan.fields, planField)
+	}
+
+	validationPlans.Store(t, plan)
+	return plan
+}
+
+// structValidator contains the state of a single Struct call
+type structValidator struct {
+	failures map[string]string
+	visited  map[uintptr]bool
+}
+
+// Struct validates the struct (or pointer to struct) based on the validate tags of the fields.
+// Nested structs, pointers, slices, arrays and maps are validated as well.
+// Field paths use the JSON name of the field if available (e.g. passengers[0].phone).
+//
+// Raises
+//
+// - 400/validation_failed: At least one rule failed. Meta contains the field path as key and the first failed rule as value.
+//
+// - 500/input_is_not_a_struct: Provided input is not a struct or pointer to a struct
+//
+// - 500/unknown_validation_rule: A tag contains a rule which is not registered
+func Struct(input interface{}) *errors.GenericError {
+	// Validate input
+	value := reflect.ValueOf(input)
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		meta := map[string]string{"type": fmt.Sprintf("%T", input)}
+		return errors.NewGenericError(500, errorDomain, errorSubDomain, ErrorInputIsNotAStruct, meta)
+	}
+
+	// Validate struct
+	v := structValidator{failures: map[string]string{}, visited: map[uintptr]bool{}}
+	if genErr := v.validateStruct(value, ""); genErr != nil {
+		return genErr
+	}
+	if len(v.failures) > 0 {
+		return errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorValidationFailed, v.failures)
+	}
+	return nil
+}
+
+// validateStruct checks the rules of all fields of the struct and traverses into the fields
+//
+// Raises
+//
+// - 500/unknown_validation_rule: A tag contains a rule which is not registered
+func (v structValidator) validateStruct(value reflect.Value, path string) *errors.GenericError {
+	for _, field := range getValidationPlan(value.Type()).fields {
+		// Build path
+		fieldValue := value.Field(field.index)
+		fieldPath := path
+		if !field.embedded {
+			fieldPath = joinFieldPath(path, field.name)
+		}
+
+		// Validate field
+		if genErr := v.validateField(fieldValue, fieldPath, field); genErr != nil {
+			return genErr
+		}
+		if genErr := v.traverse(fieldValue, fieldPath); genErr != nil {
+			return genErr
+		}
+	}
+	return nil
+}
+
+// validateField checks the rules of a single field. Only the first failed rule is recorded.
+//
+// Raises
+//
+// - 500/unknown_validation_rule: A tag contains a rule which is not registered
+func (v structValidator) validateField(value reflect.Value, path string, field validationPlanField) *errors.GenericError {
+	// Dereference pointers
+	isNil := false
+	for (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) && !isNil {
+		if isNil = value.IsNil(); !isNil {
+			value = value.Elem()
+		}
+	}
+	if field.omitEmpty && (isNil || isZeroValue(value)) {
+		return nil
+	}
+
+	// Check rules
+	for _, planRule := range field.rules {
+		rule, exists := getRule(planRule.name)
+		if !exists {
+			meta := map[string]string{"rule": planRule.name, "field": path}
+			return errors.NewGenericError(500, errorDomain, errorSubDomain, ErrorUnknownValidationRule, meta)
+		}
+		if isNil && planRule.name != RuleRequired {
+			continue
+		}
+		var input interface{}
+		if !isNil && value.CanInterface() {
+			input = value.Interface()
+		}
+		if !rule(input, planRule.param) {
+			v.failures[path] = planRule.name
+			return nil
+		}
+	}
+	return nil
+}
+
+// traverse validates the structs inside the value
+//
+// Raises
+//
+// - 500/unknown_validation_rule: A tag contains a rule which is not registered
+func (v structValidator) traverse(value reflect.Value, path string) *errors.GenericError {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() || v.visited[value.Pointer()] {
+			return nil
+		}
+		v.visited[value.Pointer()] = true
+		return v.traverse(value.Elem(), path)
+
+	case reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return v.traverse(value.Elem(), path)
+
+	case reflect.Struct:
+		return v.validateStruct(value, path)
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if genErr := v.traverse(value.Index(i), fmt.Sprintf("%s[%d]", path, i)); genErr != nil {
+				return genErr
+			}
+		}
+
+	case reflect.Map:
+		iter := value.MapRange()
+		for iter.Next() {
+			if genErr := v.traverse(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key().Interface())); genErr != nil {
+				return genErr
+			}
+		}
+	}
+	return nil
+}
+
+// joinFieldPath appends the field name to the path
+func joinFieldPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
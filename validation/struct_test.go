@@ -0,0 +1,150 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type testAddress struct {
+	City        string `json:"city" validate:"required"`
+	CountryCode string `json:"country_code" validate:"required,country_code"`
+}
+
+type testPassenger struct {
+	Name  string `json:"name" validate:"required,max=10"`
+	Email string `validate:"email"`
+	Age   *int   `json:"age" validate:"omitempty,min=18"`
+}
+
+type testAudit struct {
+	CreatedBy string `json:"created_by" validate:"required"`
+}
+
+type testBooking struct {
+	testAudit
+	Reference  string                 `json:"reference" validate:"required,min=3"`
+	Status     string                 `json:"status" validate:"oneof=pending confirmed"`
+	Currency   string                 `json:"currency" validate:"currency_code"`
+	Seats      int                    `json:"seats" validate:"min=1,max=9"`
+	Address    *testAddress           `json:"address" validate:"required"`
+	Passengers []testPassenger        `json:"passengers" validate:"min=1"`
+	Extras     map[string]testAddress `json:"extras"`
+	Notes      string                 `json:"-" validate:"max=5"`
+	internal   string                 `validate:"required"`
+}
+
+func testValidBooking() testBooking {
+	age := 30
+	return testBooking{
+		testAudit:  testAudit{CreatedBy: "admin"},
+		Reference:  "ABC123",
+		Status:     "pending",
+		Currency:   "EUR",
+		Seats:      2,
+		Address:    &testAddress{City: "Brussels", CountryCode: "BE"},
+		Passengers: []testPassenger{{Name: "John", Email: "john@example.com", Age: &age}, {Name: "Jane"}},
+	}
+}
+
+func Test_Struct_Valid_Success(t *testing.T) {
+	booking := testValidBooking()
+	assert.Nil(t, Struct(booking))
+	assert.Nil(t, Struct(&booking))
+}
+
+func Test_Struct_AllFailures_Failure(t *testing.T) {
+	age := 12
+	booking := testBooking{
+		Reference: "AB",
+		Status:    "cancelled",
+		Currency:  "eur",
+		Seats:     10,
+		Passengers: []testPassenger{
+			{Name: "John", Email: "john@example.com"},
+			{Name: "Jane with a long name", Email: "jane", Age: &age},
+		},
+		Extras: map[string]testAddress{"parking": {City: "Brussels", CountryCode: "XX"}},
+		Notes:  "Too long",
+	}
+
+	genErr := Struct(booking)
+	expected := map[string]string{
+		"created_by":                   RuleRequired,
+		"reference":                    RuleMin,
+		"status":                       RuleOneOf,
+		"currency":                     RuleCurrencyCode,
+		"seats":                        RuleMax,
+		"address":                      RuleRequired,
+		"passengers[1].name":           RuleMax,
+		"passengers[1].Email":          RuleEmail,
+		"passengers[1].age":            RuleMin,
+		"extras[parking].country_code": RuleCountryCode,
+		"Notes":                        RuleMax,
+	}
+	errors.AssertGenericError(t, genErr, 400, ErrorValidationFailed, expected)
+	assert.Len(t, genErr.Meta, len(expected))
+}
+
+func Test_Struct_NilSlice_Failure(t *testing.T) {
+	booking := testValidBooking()
+	booking.Passengers = nil
+	genErr := Struct(booking)
+	errors.AssertGenericError(t, genErr, 400, ErrorValidationFailed, map[string]string{"passengers": RuleMin})
+}
+
+func Test_Struct_Cycle_Success(t *testing.T) {
+	type node struct {
+		Name string `validate:"required"`
+		Next *node
+	}
+	first := &node{Name: "first"}
+	first.Next = &node{Next: first}
+
+	genErr := Struct(first)
+	errors.AssertGenericError(t, genErr, 400, ErrorValidationFailed, map[string]string{"Next.Name": RuleRequired})
+	assert.Len(t, genErr.Meta, 1)
+}
+
+func Test_Struct_CustomRule_Success(t *testing.T) {
+	RegisterRule("prefix", func(value interface{}, param string) bool {
+		input, ok := value.(string)
+		return ok && strings.HasPrefix(input, param)
+	})
+	type voucher struct {
+		Code string `json:"code" validate:"prefix=SKP-"`
+	}
+
+	assert.Nil(t, Struct(voucher{Code: "SKP-123"}))
+	genErr := Struct(voucher{Code: "ABC-123"})
+	errors.AssertGenericError(t, genErr, 400, ErrorValidationFailed, map[string]string{"code": "prefix"})
+}
+
+func Test_Struct_UnknownRule_Failure(t *testing.T) {
+	type invalid struct {
+		Code string `validate:"unknown_rule"`
+	}
+	genErr := Struct(invalid{})
+	errors.AssertGenericError(t, genErr, 500, ErrorUnknownValidationRule, map[string]string{"rule": "unknown_rule", "field": "Code"})
+}
+
+func Test_Struct_NotAStruct_Failure(t *testing.T) {
+	genErr := Struct("test")
+	errors.AssertGenericError(t, genErr, 500, ErrorInputIsNotAStruct, map[string]string{"type": "string"})
+
+	var booking *testBooking
+	genErr = Struct(booking)
+	errors.AssertGenericError(t, genErr, 500, ErrorInputIsNotAStruct, nil)
+}
+
+func Test_compareRule_Success(t *testing.T) {
+	assert.True(t, compareRule(5, "5", 1))
+	assert.False(t, compareRule(uint8(4), "5", 1))
+	assert.True(t, compareRule(2.5, "2.5", -1))
+	assert.True(t, compareRule("ééé", "3", -1)) // Counts runes
+	assert.False(t, compareRule([]int{1, 2}, "1", -1))
+	assert.False(t, compareRule(5, "invalid", 1))
+	assert.False(t, compareRule(true, "1", 1))
+}
@@ -22,5 +22,26 @@ func WithinTimeRange(nowTime time.Time, startTime time.Time, endTime time.Time)
 	}
 
 	// Check if within time range
-	return nowTime == startTime || (nowTime.After(startTime) && nowTime.Before(endTime)), nil
+	// Equal is used instead of == to ignore monotonic clock readings and locations
+	return nowTime.Equal(startTime) || (nowTime.After(startTime) && nowTime.Before(endTime)), nil
+}
+
+// StartOfDay returns midnight of the day of the provided time in the provided location.
+// Unlike Truncate(24 * time.Hour), this takes the time zone and daylight saving time into account.
+// If location is nil, the location of the provided time is used.
+func StartOfDay(t time.Time, location *time.Location) time.Time {
+	if location != nil {
+		t = t.In(location)
+	}
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// DayRange returns the range from midnight (including) until midnight of the next day (excluding)
+// of the day of the provided time in the provided location. Days on which daylight saving time
+// starts or ends last 23 or 25 hours. If location is nil, the location of the provided time is used.
+func DayRange(t time.Time, location *time.Location) TimeRange {
+	start := StartOfDay(t, location)
+	end := time.Date(start.Year(), start.Month(), start.Day()+1, 0, 0, 0, 0, start.Location())
+	return TimeRange{Start: start, End: end, Bounds: TimeRangeBoundsInclusiveExclusive}
 }
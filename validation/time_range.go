@@ -0,0 +1,210 @@
+package validation
+
+import (
+	"sort"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// TimeRangeBounds defines if the start and end of a TimeRange are included,
+// using the mathematical interval notation.
+type TimeRangeBounds string
+
+const (
+	// TimeRangeBoundsInclusiveExclusive includes the start, but excludes the end. This is the default.
+	TimeRangeBoundsInclusiveExclusive TimeRangeBounds = "[)"
+
+	// TimeRangeBoundsInclusive includes both the start and the end
+	TimeRangeBoundsInclusive TimeRangeBounds = "[]"
+
+	// TimeRangeBoundsExclusive excludes both the start and the end
+	TimeRangeBoundsExclusive TimeRangeBounds = "()"
+
+	// TimeRangeBoundsExclusiveInclusive excludes the start, but includes the end
+	TimeRangeBoundsExclusiveInclusive TimeRangeBounds = "(]"
+)
+
+// TimeRange is a period between 2 points in time.
+// Times are compared as instants, so the location of Start and End doesn't matter.
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+
+	// Bounds defines if Start and End are part of the range.
+	// Defaults to TimeRangeBoundsInclusiveExclusive if empty.
+	Bounds TimeRangeBounds
+}
+
+// NewTimeRange creates a new time range.
+// If bounds is empty, TimeRangeBoundsInclusiveExclusive is used.
+//
+// Raises
+//
+// - 400/end_time_before_start_time: Provided end is before start
+//
+// - 400/invalid_time_range_bounds: Provided bounds are not supported
+func NewTimeRange(start time.Time, end time.Time, bounds TimeRangeBounds) (TimeRange, *errors.GenericError) {
+	// Validate bounds
+	if bounds == "" {
+		bounds = TimeRangeBoundsInclusiveExclusive
+	}
+	switch bounds {
+	case TimeRangeBoundsInclusiveExclusive, TimeRangeBoundsInclusive, TimeRangeBoundsExclusive, TimeRangeBoundsExclusiveInclusive:
+	default:
+		meta := map[string]string{"bounds": string(bounds)}
+		return TimeRange{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorInvalidTimeRangeBounds, meta)
+	}
+
+	// Validate times
+	if end.Before(start) {
+		meta := map[string]string{
+			"start_time": start.Format(time.RFC3339),
+			"end_time":   end.Format(time.RFC3339),
+		}
+		return TimeRange{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorEndTimeBeforeStartTime, meta)
+	}
+	return TimeRange{Start: start, End: end, Bounds: bounds}, nil
+}
+
+// includesStart returns true if the start is part of the range
+func (r TimeRange) includesStart() bool {
+	return r.Bounds == "" || r.Bounds == TimeRangeBoundsInclusiveExclusive || r.Bounds == TimeRangeBoundsInclusive
+}
+
+// includesEnd returns true if the end is part of the range
+func (r TimeRange) includesEnd() bool {
+	return r.Bounds == TimeRangeBoundsInclusive || r.Bounds == TimeRangeBoundsExclusiveInclusive
+}
+
+// withBounds returns the bounds for the provided inclusion of start and end
+func withBounds(includeStart bool, includeEnd bool) TimeRangeBounds {
+	switch {
+	case includeStart && includeEnd:
+		return TimeRangeBoundsInclusive
+	case includeStart:
+		return TimeRangeBoundsInclusiveExclusive
+	case includeEnd:
+		return TimeRangeBoundsExclusiveInclusive
+	default:
+		return TimeRangeBoundsExclusive
+	}
+}
+
+// IsEmpty returns true if the range contains no points in time
+func (r TimeRange) IsEmpty() bool {
+	if r.End.Before(r.Start) {
+		return true
+	}
+	return r.Start.Equal(r.End) && !(r.includesStart() && r.includesEnd())
+}
+
+// Duration returns the time between start and end
+func (r TimeRange) Duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
+
+// Contains checks if the provided time is part of the range, taking the bounds into account
+func (r TimeRange) Contains(t time.Time) bool {
+	afterStart := t.After(r.Start) || (r.includesStart() && t.Equal(r.Start))
+	beforeEnd := t.Before(r.End) || (r.includesEnd() && t.Equal(r.End))
+	return afterStart && beforeEnd
+}
+
+// endsBefore checks if all points of the range are before the start of the other range
+func (r TimeRange) endsBefore(other TimeRange) bool {
+	if r.End.Equal(other.Start) {
+		return !(r.includesEnd() && other.includesStart())
+	}
+	return r.End.Before(other.Start)
+}
+
+// Overlaps checks if the ranges have at least one point in time in common
+func (r TimeRange) Overlaps(other TimeRange) bool {
+	if r.IsEmpty() || other.IsEmpty() {
+		return false
+	}
+	return !r.endsBefore(other) && !other.endsBefore(r)
+}
+
+// isConnected checks if the union of the ranges is a single range without gaps
+func (r TimeRange) isConnected(other TimeRange) bool {
+	if r.Overlaps(other) {
+		return true
+	}
+	touches := func(first TimeRange, second TimeRange) bool {
+		return first.End.Equal(second.Start) && (first.includesEnd() || second.includesStart())
+	}
+	return touches(r, other) || touches(other, r)
+}
+
+// Merge returns the union of the ranges.
+// Ranges can only be merged if they overlap or touch each other (e.g. [08:00, 12:00) and [12:00, 14:00)).
+//
+// Raises
+//
+// - 400/time_ranges_not_connected: Provided ranges have a gap between them
+func (r TimeRange) Merge(other TimeRange) (TimeRange, *errors.GenericError) {
+	// Handle empty ranges
+	if other.IsEmpty() {
+		return r, nil
+	}
+	if r.IsEmpty() {
+		return other, nil
+	}
+
+	// Validate ranges
+	if !r.isConnected(other) {
+		meta := map[string]string{
+			"first_end_time":  r.End.Format(time.RFC3339),
+			"second_end_time": other.End.Format(time.RFC3339),
+		}
+		return TimeRange{}, errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorTimeRangesNotConnected, meta)
+	}
+
+	// Take earliest start
+	start, includeStart := r.Start, r.includesStart()
+	if other.Start.Before(start) {
+		start, includeStart = other.Start, other.includesStart()
+	} else if other.Start.Equal(start) {
+		includeStart = includeStart || other.includesStart()
+	}
+
+	// Take latest end
+	end, includeEnd := r.End, r.includesEnd()
+	if other.End.After(end) {
+		end, includeEnd = other.End, other.includesEnd()
+	} else if other.End.Equal(end) {
+		includeEnd = includeEnd || other.includesEnd()
+	}
+	return TimeRange{Start: start, End: end, Bounds: withBounds(includeStart, includeEnd)}, nil
+}
+
+// MergeTimeRanges merges all overlapping and touching ranges.
+// Returns the merged ranges sorted by start, without empty ranges.
+func MergeTimeRanges(ranges []TimeRange) []TimeRange {
+	// Sort ranges
+	sorted := []TimeRange{}
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start) ||
+			(sorted[i].Start.Equal(sorted[j].Start) && sorted[i].includesStart() && !sorted[j].includesStart())
+	})
+
+	// Merge ranges
+	result := []TimeRange{}
+	for _, r := range sorted {
+		if last := len(result) - 1; last >= 0 {
+			if merged, genErr := result[last].Merge(r); genErr == nil {
+				result[last] = merged
+				continue
+			}
+		}
+		result = append(result, r)
+	}
+	return result
+}
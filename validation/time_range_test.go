@@ -0,0 +1,132 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTime(hour int, minute int) time.Time {
+	return time.Date(2020, 6, 1, hour, minute, 0, 0, time.UTC)
+}
+
+func testTimeRange(startHour int, endHour int, bounds TimeRangeBounds) TimeRange {
+	return TimeRange{Start: testTime(startHour, 0), End: testTime(endHour, 0), Bounds: bounds}
+}
+
+func Test_NewTimeRange_Success(t *testing.T) {
+	result, genErr := NewTimeRange(testTime(8, 0), testTime(12, 0), "")
+	require.Nil(t, genErr)
+	assert.Equal(t, testTimeRange(8, 12, TimeRangeBoundsInclusiveExclusive), result)
+}
+
+func Test_NewTimeRange_InvalidBounds_Failure(t *testing.T) {
+	_, genErr := NewTimeRange(testTime(8, 0), testTime(12, 0), "[[")
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidTimeRangeBounds, map[string]string{"bounds": "[["})
+}
+
+func Test_NewTimeRange_EndBeforeStart_Failure(t *testing.T) {
+	_, genErr := NewTimeRange(testTime(12, 0), testTime(8, 0), "")
+	errors.AssertGenericError(t, genErr, 400, ErrorEndTimeBeforeStartTime, nil)
+}
+
+func Test_TimeRange_Contains_Success(t *testing.T) {
+	tests := []struct {
+		bounds       TimeRangeBounds
+		containStart bool
+		containEnd   bool
+	}{
+		{"", true, false},
+		{TimeRangeBoundsInclusiveExclusive, true, false},
+		{TimeRangeBoundsInclusive, true, true},
+		{TimeRangeBoundsExclusive, false, false},
+		{TimeRangeBoundsExclusiveInclusive, false, true},
+	}
+
+	for _, test := range tests {
+		r := testTimeRange(8, 12, test.bounds)
+		assert.Equal(t, test.containStart, r.Contains(testTime(8, 0)), test.bounds)
+		assert.Equal(t, test.containEnd, r.Contains(testTime(12, 0)), test.bounds)
+		assert.True(t, r.Contains(testTime(10, 0)))
+		assert.False(t, r.Contains(testTime(7, 59)))
+		assert.False(t, r.Contains(testTime(12, 1)))
+	}
+}
+
+func Test_TimeRange_Contains_OtherLocation_Success(t *testing.T) {
+	r := testTimeRange(8, 12, "")
+	assert.True(t, r.Contains(testTime(8, 0).In(time.FixedZone("UTC+2", 2*60*60))))
+}
+
+func Test_TimeRange_IsEmpty_Success(t *testing.T) {
+	assert.False(t, testTimeRange(8, 12, "").IsEmpty())
+	assert.True(t, testTimeRange(8, 8, "").IsEmpty())
+	assert.False(t, testTimeRange(8, 8, TimeRangeBoundsInclusive).IsEmpty())
+	assert.True(t, testTimeRange(12, 8, TimeRangeBoundsInclusive).IsEmpty())
+}
+
+func Test_TimeRange_Overlaps_Success(t *testing.T) {
+	tests := []struct {
+		first    TimeRange
+		second   TimeRange
+		expected bool
+	}{
+		{testTimeRange(8, 12, ""), testTimeRange(10, 14, ""), true},
+		{testTimeRange(8, 12, ""), testTimeRange(9, 10, ""), true},
+		{testTimeRange(8, 12, ""), testTimeRange(12, 14, ""), false},
+		{testTimeRange(8, 12, TimeRangeBoundsInclusive), testTimeRange(12, 14, ""), true},
+		{testTimeRange(8, 12, TimeRangeBoundsInclusive), testTimeRange(12, 14, TimeRangeBoundsExclusive), false},
+		{testTimeRange(8, 10, ""), testTimeRange(12, 14, ""), false},
+		{testTimeRange(8, 12, ""), testTimeRange(10, 10, ""), false},
+	}
+
+	for i, test := range tests {
+		assert.Equal(t, test.expected, test.first.Overlaps(test.second), i)
+		assert.Equal(t, test.expected, test.second.Overlaps(test.first), i)
+	}
+}
+
+func Test_TimeRange_Merge_Success(t *testing.T) {
+	tests := []struct {
+		first    TimeRange
+		second   TimeRange
+		expected TimeRange
+	}{
+		{testTimeRange(8, 12, ""), testTimeRange(10, 14, ""), testTimeRange(8, 14, TimeRangeBoundsInclusiveExclusive)},
+		{testTimeRange(8, 12, ""), testTimeRange(12, 14, TimeRangeBoundsInclusive), testTimeRange(8, 14, TimeRangeBoundsInclusive)},
+		{testTimeRange(8, 12, TimeRangeBoundsExclusive), testTimeRange(8, 10, ""), testTimeRange(8, 12, TimeRangeBoundsInclusiveExclusive)},
+		{testTimeRange(8, 12, ""), testTimeRange(15, 15, ""), testTimeRange(8, 12, "")},
+	}
+
+	for i, test := range tests {
+		result, genErr := test.first.Merge(test.second)
+		require.Nil(t, genErr)
+		assert.Equal(t, test.expected, result, i)
+	}
+}
+
+func Test_TimeRange_Merge_NotConnected_Failure(t *testing.T) {
+	first := testTimeRange(8, 12, TimeRangeBoundsExclusive)
+	second := testTimeRange(12, 14, TimeRangeBoundsExclusive)
+	_, genErr := first.Merge(second)
+	errors.AssertGenericError(t, genErr, 400, ErrorTimeRangesNotConnected, nil)
+}
+
+func Test_MergeTimeRanges_Success(t *testing.T) {
+	input := []TimeRange{
+		testTimeRange(14, 18, ""),
+		testTimeRange(8, 10, ""),
+		testTimeRange(9, 12, ""),
+		testTimeRange(12, 13, ""),
+		testTimeRange(20, 20, ""),
+	}
+	expected := []TimeRange{
+		testTimeRange(8, 13, TimeRangeBoundsInclusiveExclusive),
+		testTimeRange(14, 18, ""),
+	}
+	assert.Equal(t, expected, MergeTimeRanges(input))
+	assert.Equal(t, []TimeRange{}, MergeTimeRanges(nil))
+}
@@ -53,3 +53,36 @@ func Test_WithinTimeRange_EndBeforeStart_Failure(t *testing.T) {
 	errors.AssertGenericError(t, genErr, 400, ErrorEndTimeBeforeStartTime, nil)
 	assert.False(t, within)
 }
+
+func Test_WithinTimeRange_SameAsStartOtherLocation_True_Success(t *testing.T) {
+	now := time.Now() // Contains monotonic clock reading
+	start := now.Round(0).In(time.FixedZone("UTC+2", 2*60*60))
+	end := now.Add(time.Hour)
+	within, genErr := WithinTimeRange(now, start, end)
+	require.Nil(t, genErr)
+	assert.True(t, within)
+}
+
+func testLocation(t *testing.T) *time.Location {
+	location, err := time.LoadLocation("Europe/Brussels")
+	require.Nil(t, err)
+	return location
+}
+
+func Test_StartOfDay_Success(t *testing.T) {
+	brussels := testLocation(t)
+	input := time.Date(2020, 6, 30, 23, 30, 0, 0, time.UTC) // July 1st 01:30 in Brussels
+	assert.Equal(t, time.Date(2020, 7, 1, 0, 0, 0, 0, brussels), StartOfDay(input, brussels))
+	assert.Equal(t, time.Date(2020, 6, 30, 0, 0, 0, 0, time.UTC), StartOfDay(input, nil))
+}
+
+func Test_DayRange_DaylightSavingTime_Success(t *testing.T) {
+	brussels := testLocation(t)
+	day := DayRange(time.Date(2020, 3, 29, 12, 0, 0, 0, brussels), brussels)
+	assert.Equal(t, 23*time.Hour, day.Duration())
+	assert.True(t, day.Contains(time.Date(2020, 3, 29, 0, 0, 0, 0, brussels)))
+	assert.False(t, day.Contains(time.Date(2020, 3, 30, 0, 0, 0, 0, brussels)))
+
+	day = DayRange(time.Date(2020, 10, 25, 12, 0, 0, 0, brussels), brussels)
+	assert.Equal(t, 25*time.Hour, day.Duration())
+}
@@ -0,0 +1,176 @@
+package validation
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// vatFormat validates the VAT number of a country. The checksum is optional.
+type vatFormat struct {
+	pattern  *regexp.Regexp
+	checksum func(number string) bool
+}
+
+// vatFormats contains the formats of the VAT numbers of all EU countries, without country prefix.
+// Checksums are only validated for the countries with a public algorithm.
+var vatFormats = map[string]vatFormat{
+	"AT": {regexp.MustCompile(`^U\d{8}$`), checksumVATAT},
+	"BE": {regexp.MustCompile(`^[01]\d{9}$`), checksumVATBE},
+	"BG": {regexp.MustCompile(`^\d{9,10}$`), nil},
+	"CY": {regexp.MustCompile(`^\d{8}[A-Z]$`), nil},
+	"CZ": {regexp.MustCompile(`^\d{8,10}$`), nil},
+	"DE": {regexp.MustCompile(`^\d{9}$`), checksumVATDE},
+	"DK": {regexp.MustCompile(`^\d{8}$`), checksumVATDK},
+	"EE": {regexp.MustCompile(`^\d{9}$`), nil},
+	"ES": {regexp.MustCompile(`^[A-Z0-9]\d{7}[A-Z0-9]$`), nil},
+	"FI": {regexp.MustCompile(`^\d{8}$`), checksumVATFI},
+	"FR": {regexp.MustCompile(`^[A-HJ-NP-Z0-9]{2}\d{9}$`), checksumVATFR},
+	"GR": {regexp.MustCompile(`^\d{9}$`), nil},
+	"HR": {regexp.MustCompile(`^\d{11}$`), nil},
+	"HU": {regexp.MustCompile(`^\d{8}$`), nil},
+	"IE": {regexp.MustCompile(`^(\d{7}[A-W][A-I]?|\d[A-Z+*]\d{5}[A-W])$`), nil},
+	"IT": {regexp.MustCompile(`^\d{11}$`), luhn},
+	"LT": {regexp.MustCompile(`^(\d{9}|\d{12})$`), nil},
+	"LU": {regexp.MustCompile(`^\d{8}$`), checksumVATLU},
+	"LV": {regexp.MustCompile(`^\d{11}$`), nil},
+	"MT": {regexp.MustCompile(`^\d{8}$`), nil},
+	"NL": {regexp.MustCompile(`^\d{9}B\d{2}$`), checksumVATNL},
+	"PL": {regexp.MustCompile(`^\d{10}$`), checksumVATPL},
+	"PT": {regexp.MustCompile(`^\d{9}$`), checksumVATPT},
+	"RO": {regexp.MustCompile(`^[1-9]\d{1,9}$`), nil},
+	"SE": {regexp.MustCompile(`^\d{10}01$`), func(number string) bool { return luhn(number[:10]) }},
+	"SI": {regexp.MustCompile(`^\d{8}$`), nil},
+	"SK": {regexp.MustCompile(`^\d{10}$`), nil},
+}
+
+// vatPrefix returns the prefix of VAT numbers of the country. Greece uses EL instead of GR.
+func vatPrefix(countryCode string) string {
+	if countryCode == "GR" {
+		return "EL"
+	}
+	return countryCode
+}
+
+// ValidateVATNumber checks the format and, if available, the checksum of an EU VAT number.
+// The country code can be empty if the VAT number is prefixed with the country (e.g. BE0403170701).
+// This is an offline check only: it doesn't verify the number is registered (see VIES).
+// Returns the VAT number with prefix and without separators (e.g. BE0403170701).
+//
+// Raises
+//
+// - 400/unsupported_country: Provided country is not an EU country
+//
+// - 400/invalid_vat_number: Provided VAT number is not valid
+func ValidateVATNumber(vatNumber string, countryCode string) (string, *errors.GenericError) {
+	// Detect country
+	clean := cleanIdentifier(vatNumber)
+	countryCode = strings.ToUpper(countryCode)
+	if countryCode == "EL" {
+		countryCode = "GR"
+	}
+	if countryCode == "" && len(clean) >= 2 {
+		countryCode = clean[:2]
+		if countryCode == "EL" {
+			countryCode = "GR"
+		}
+	}
+	format, supported := vatFormats[countryCode]
+	if !supported {
+		meta := map[string]string{"country_code": countryCode}
+		return "", errors.NewGenericError(400, errorDomain, errorSubDomain, ErrorUnsupportedCountry, meta)
+	}
+
+	// Clean number
+	prefix := vatPrefix(countryCode)
+	number := strings.TrimPrefix(clean, prefix)
+	if countryCode == "BE" && len(number) == 9 {
+		number = "0" + number
+	}
+
+	// Validate number
+	if !format.pattern.MatchString(number) || (format.checksum != nil && !format.checksum(number)) {
+		return "", invalidIdentifierError(ErrorInvalidVATNumber, "vat_number", vatNumber)
+	}
+	return prefix + number, nil
+}
+
+func checksumVATAT(number string) bool {
+	sum := 0
+	for i, r := range number[1:8] {
+		digit := int(r - '0')
+		if i%2 == 1 {
+			digit = digit*2/10 + digit*2%10
+		}
+		sum += digit
+	}
+	return (10-(sum+4)%10)%10 == int(number[8]-'0')
+}
+
+func checksumVATBE(number string) bool {
+	return 97-atoi(number[:8])%97 == atoi(number[8:])
+}
+
+func checksumVATDE(number string) bool {
+	// ISO 7064 MOD 11,10
+	product := 10
+	for _, r := range number[:8] {
+		sum := (int(r-'0') + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = (2 * sum) % 11
+	}
+	check := 11 - product
+	if check == 10 {
+		check = 0
+	}
+	return check == int(number[8]-'0')
+}
+
+func checksumVATDK(number string) bool {
+	return weightedSum(number, []int{2, 7, 6, 5, 4, 3, 2, 1})%11 == 0
+}
+
+func checksumVATFI(number string) bool {
+	check := 11 - weightedSum(number, []int{7, 9, 10, 5, 8, 4, 2})%11
+	if check == 11 {
+		check = 0
+	}
+	return check == int(number[7]-'0')
+}
+
+func checksumVATFR(number string) bool {
+	// Only numeric keys can be validated
+	if number[0] < '0' || number[0] > '9' || number[1] < '0' || number[1] > '9' {
+		return luhn(number[2:])
+	}
+	return (12+3*(atoi(number[2:])%97))%97 == atoi(number[:2])
+}
+
+func checksumVATLU(number string) bool {
+	return atoi(number[:6])%89 == atoi(number[6:])
+}
+
+func checksumVATNL(number string) bool {
+	// Numbers since 2020 use MOD 97-10 on the full number including prefix
+	if mod97("NL"+number) == 1 {
+		return true
+	}
+	// Older numbers use MOD 11 on the fiscal number
+	return (weightedSum(number, []int{9, 8, 7, 6, 5, 4, 3, 2})-int(number[8]-'0'))%11 == 0
+}
+
+func checksumVATPL(number string) bool {
+	check := weightedSum(number, []int{6, 5, 7, 2, 3, 4, 5, 6, 7}) % 11
+	return check != 10 && check == int(number[9]-'0')
+}
+
+func checksumVATPT(number string) bool {
+	check := 11 - weightedSum(number, []int{9, 8, 7, 6, 5, 4, 3, 2})%11
+	if check > 9 {
+		check = 0
+	}
+	return check == int(number[8]-'0')
+}
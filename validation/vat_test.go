@@ -0,0 +1,66 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/converters"
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateVATNumber_Success(t *testing.T) {
+	tests := []struct {
+		vatNumber   string
+		countryCode string
+		expected    string
+	}{
+		{"BE 0403.170.701", "", "BE0403170701"},
+		{"403170701", "BE", "BE0403170701"},
+		{"ATU13585627", "", "ATU13585627"},
+		{"DE136695976", "", "DE136695976"},
+		{"DK 13 58 56 28", "", "DK13585628"},
+		{"FI20774740", "", "FI20774740"},
+		{"FR 44 732 829 320", "", "FR44732829320"},
+		{"EL094259216", "", "EL094259216"},
+		{"094259216", "GR", "EL094259216"},
+		{"IT00743110157", "", "IT00743110157"},
+		{"LU26375245", "", "LU26375245"},
+		{"NL004495445B01", "", "NL004495445B01"},
+		{"NL000099998B57", "", "NL000099998B57"},
+		{"PL8567346215", "", "PL8567346215"},
+		{"PT501964843", "", "PT501964843"},
+		{"ESB58378431", "", "ESB58378431"},
+	}
+
+	for _, test := range tests {
+		result, genErr := ValidateVATNumber(test.vatNumber, test.countryCode)
+		require.Nil(t, genErr, test.vatNumber)
+		assert.Equal(t, test.expected, result)
+	}
+}
+
+func Test_ValidateVATNumber_AllEUCountries_Success(t *testing.T) {
+	for _, country := range converters.Countries() {
+		if country.EU {
+			_, exists := vatFormats[country.Alpha2]
+			assert.True(t, exists, country.Alpha2)
+		}
+	}
+}
+
+func Test_ValidateVATNumber_Invalid_Failure(t *testing.T) {
+	inputs := []string{"BE0403170702", "BE2403170701", "DE136695977", "FR45732829320", "LU26375246", "NL004495446B01", "ATU1358562", "IT00743110158"}
+	for _, input := range inputs {
+		_, genErr := ValidateVATNumber(input, "")
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidVATNumber, map[string]string{"vat_number": input})
+	}
+}
+
+func Test_ValidateVATNumber_UnsupportedCountry_Failure(t *testing.T) {
+	_, genErr := ValidateVATNumber("CHE123456789", "")
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedCountry, map[string]string{"country_code": "CH"})
+
+	_, genErr = ValidateVATNumber("123456789", "US")
+	errors.AssertGenericError(t, genErr, 400, ErrorUnsupportedCountry, map[string]string{"country_code": "US"})
+}
@@ -0,0 +1,59 @@
+package validation
+
+import (
+	"regexp"
+
+	"github.com/skiprco/go-utils/v2/errors"
+)
+
+// vinRegex matches 17 characters, excluding the letters I, O and Q
+var vinRegex = regexp.MustCompile(`^[A-HJ-NPR-Z0-9]{17}$`)
+
+// vinWeights contains the weight of each position to calculate the check digit
+var vinWeights = []int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// vinValues contains the value of each letter to calculate the check digit
+var vinValues = map[rune]int{
+	'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+	'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+	'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+}
+
+// ValidateVIN checks if the vehicle identification number (ISO 3779) has a valid format.
+// The check digit on position 9 is mandatory in North America, but optional in Europe.
+// Set verifyCheckDigit to validate the check digit as well.
+// Returns the VIN in upper case without separators.
+//
+// Raises
+//
+// - 400/invalid_vin: Provided VIN doesn't have 17 valid characters
+//
+// - 400/invalid_vin_check_digit: Provided VIN has an invalid check digit
+func ValidateVIN(vin string, verifyCheckDigit bool) (string, *errors.GenericError) {
+	// Validate format
+	clean := cleanIdentifier(vin)
+	if !vinRegex.MatchString(clean) {
+		return "", invalidIdentifierError(ErrorInvalidVIN, "vin", vin)
+	}
+	if !verifyCheckDigit {
+		return clean, nil
+	}
+
+	// Validate check digit
+	sum := 0
+	for i, r := range clean {
+		value, isLetter := vinValues[r]
+		if !isLetter {
+			value = int(r - '0')
+		}
+		sum += value * vinWeights[i]
+	}
+	expected := byte('0' + sum%11)
+	if sum%11 == 10 {
+		expected = 'X'
+	}
+	if clean[8] != expected {
+		return "", invalidIdentifierError(ErrorInvalidVINCheckDigit, "vin", vin)
+	}
+	return clean, nil
+}
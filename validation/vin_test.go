@@ -0,0 +1,32 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/skiprco/go-utils/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateVIN_Success(t *testing.T) {
+	result, genErr := ValidateVIN("1m8gdm9a-xkp042788", true)
+	require.Nil(t, genErr)
+	assert.Equal(t, "1M8GDM9AXKP042788", result)
+
+	// European VINs don't require a check digit
+	result, genErr = ValidateVIN("WVWZZZ1JZ3W386752", false)
+	require.Nil(t, genErr)
+	assert.Equal(t, "WVWZZZ1JZ3W386752", result)
+}
+
+func Test_ValidateVIN_Invalid_Failure(t *testing.T) {
+	for _, vin := range []string{"1M8GDM9AXKP04278", "1M8GDM9AXKP0427888", "1M8GDM9AXKP04278O"} {
+		_, genErr := ValidateVIN(vin, false)
+		errors.AssertGenericError(t, genErr, 400, ErrorInvalidVIN, map[string]string{"vin": vin})
+	}
+}
+
+func Test_ValidateVIN_InvalidCheckDigit_Failure(t *testing.T) {
+	_, genErr := ValidateVIN("1M8GDM9A1KP042788", true)
+	errors.AssertGenericError(t, genErr, 400, ErrorInvalidVINCheckDigit, map[string]string{"vin": "1M8GDM9A1KP042788"})
+}